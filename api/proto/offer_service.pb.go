@@ -0,0 +1,953 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: offer_service.proto
+
+package proto
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An offer to buy or an application to rent a property.
+type Offer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId     string                 `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Kind           uint32                 `protobuf:"varint,3,opt,name=kind,proto3" json:"kind,omitempty"` // 1 = offer to buy, 2 = application to rent.
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Email          string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Telephone      string                 `protobuf:"bytes,6,opt,name=telephone,proto3" json:"telephone,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"` // The rent per period of the listing for an application.
+	Conditions     []string               `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Message        string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	Status         uint32                 `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`                                   // 1 = pending, 2 = accepted, 3 = rejected, 4 = countered, 5 = declined.
+	CounterAmount  *Money                 `protobuf:"bytes,11,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount,omitempty"` // Set when the owner countered.
+	CounterMessage string                 `protobuf:"bytes,12,opt,name=counter_message,json=counterMessage,proto3" json:"counter_message,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	mi := &file_offer_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Offer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Offer) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *Offer) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *Offer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Offer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Offer) GetTelephone() string {
+	if x != nil {
+		return x.Telephone
+	}
+	return ""
+}
+
+func (x *Offer) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Offer) GetConditions() []string {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Offer) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Offer) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Offer) GetCounterAmount() *Money {
+	if x != nil {
+		return x.CounterAmount
+	}
+	return nil
+}
+
+func (x *Offer) GetCounterMessage() string {
+	if x != nil {
+		return x.CounterMessage
+	}
+	return ""
+}
+
+func (x *Offer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Offer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request and Response messages for the SubmitOffer operation.
+type SubmitOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // A new ID is generated when empty.
+	PropertyId    string                 `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Kind          uint32                 `protobuf:"varint,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Telephone     string                 `protobuf:"bytes,6,opt,name=telephone,proto3" json:"telephone,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Conditions    []string               `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitOfferRequest) Reset() {
+	*x = SubmitOfferRequest{}
+	mi := &file_offer_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitOfferRequest) ProtoMessage() {}
+
+func (x *SubmitOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitOfferRequest.ProtoReflect.Descriptor instead.
+func (*SubmitOfferRequest) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmitOfferRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *SubmitOfferRequest) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *SubmitOfferRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubmitOfferRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SubmitOfferRequest) GetTelephone() string {
+	if x != nil {
+		return x.Telephone
+	}
+	return ""
+}
+
+func (x *SubmitOfferRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SubmitOfferRequest) GetConditions() []string {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SubmitOfferRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitOfferResponse) Reset() {
+	*x = SubmitOfferResponse{}
+	mi := &file_offer_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitOfferResponse) ProtoMessage() {}
+
+func (x *SubmitOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitOfferResponse.ProtoReflect.Descriptor instead.
+func (*SubmitOfferResponse) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitOfferResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the GetOffer operation.
+type GetOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfferRequest) Reset() {
+	*x = GetOfferRequest{}
+	mi := &file_offer_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferRequest) ProtoMessage() {}
+
+func (x *GetOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferRequest.ProtoReflect.Descriptor instead.
+func (*GetOfferRequest) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *Offer                 `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfferResponse) Reset() {
+	*x = GetOfferResponse{}
+	mi := &file_offer_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferResponse) ProtoMessage() {}
+
+func (x *GetOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferResponse.ProtoReflect.Descriptor instead.
+func (*GetOfferResponse) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetOfferResponse) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// Request and Response messages for the ListOffers operation, no statuses are every status.
+type ListOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Statuses      []uint32               `protobuf:"varint,2,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOffersRequest) Reset() {
+	*x = ListOffersRequest{}
+	mi := &file_offer_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffersRequest) ProtoMessage() {}
+
+func (x *ListOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListOffersRequest) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOffersRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *ListOffersRequest) GetStatuses() []uint32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListOffersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offers        []*Offer               `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOffersResponse) Reset() {
+	*x = ListOffersResponse{}
+	mi := &file_offer_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffersResponse) ProtoMessage() {}
+
+func (x *ListOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListOffersResponse) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOffersResponse) GetOffers() []*Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+// Request and Response messages for the AcceptOffer operation, the other open offers on the
+// property are declined and the listing is put under offer.
+type AcceptOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOfferRequest) Reset() {
+	*x = AcceptOfferRequest{}
+	mi := &file_offer_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOfferRequest) ProtoMessage() {}
+
+func (x *AcceptOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOfferRequest) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AcceptOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOfferResponse) Reset() {
+	*x = AcceptOfferResponse{}
+	mi := &file_offer_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOfferResponse) ProtoMessage() {}
+
+func (x *AcceptOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOfferResponse) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptOfferResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the RejectOffer operation.
+type RejectOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOfferRequest) Reset() {
+	*x = RejectOfferRequest{}
+	mi := &file_offer_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOfferRequest) ProtoMessage() {}
+
+func (x *RejectOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOfferRequest.ProtoReflect.Descriptor instead.
+func (*RejectOfferRequest) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOfferResponse) Reset() {
+	*x = RejectOfferResponse{}
+	mi := &file_offer_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOfferResponse) ProtoMessage() {}
+
+func (x *RejectOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOfferResponse.ProtoReflect.Descriptor instead.
+func (*RejectOfferResponse) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RejectOfferResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the CounterOffer operation, the amount is in the currency
+// of the offer.
+type CounterOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterOfferRequest) Reset() {
+	*x = CounterOfferRequest{}
+	mi := &file_offer_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterOfferRequest) ProtoMessage() {}
+
+func (x *CounterOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterOfferRequest.ProtoReflect.Descriptor instead.
+func (*CounterOfferRequest) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CounterOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CounterOfferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CounterOfferRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CounterOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterOfferResponse) Reset() {
+	*x = CounterOfferResponse{}
+	mi := &file_offer_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterOfferResponse) ProtoMessage() {}
+
+func (x *CounterOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offer_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterOfferResponse.ProtoReflect.Descriptor instead.
+func (*CounterOfferResponse) Descriptor() ([]byte, []int) {
+	return file_offer_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CounterOfferResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_offer_service_proto protoreflect.FileDescriptor
+
+const file_offer_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13offer_service.proto\x12\rmygrpcservice\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x16property_service.proto\"\xf0\x03\n" +
+	"\x05Offer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproperty_id\x18\x02 \x01(\tR\n" +
+	"propertyId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\rR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1c\n" +
+	"\ttelephone\x18\x06 \x01(\tR\ttelephone\x12,\n" +
+	"\x06amount\x18\a \x01(\v2\x14.mygrpcservice.MoneyR\x06amount\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\b \x03(\tR\n" +
+	"conditions\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\rR\x06status\x12;\n" +
+	"\x0ecounter_amount\x18\v \x01(\v2\x14.mygrpcservice.MoneyR\rcounterAmount\x12'\n" +
+	"\x0fcounter_message\x18\f \x01(\tR\x0ecounterMessage\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x89\x02\n" +
+	"\x12SubmitOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproperty_id\x18\x02 \x01(\tR\n" +
+	"propertyId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\rR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1c\n" +
+	"\ttelephone\x18\x06 \x01(\tR\ttelephone\x12,\n" +
+	"\x06amount\x18\a \x01(\v2\x14.mygrpcservice.MoneyR\x06amount\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\b \x03(\tR\n" +
+	"conditions\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\"%\n" +
+	"\x13SubmitOfferResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"!\n" +
+	"\x0fGetOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x10GetOfferResponse\x12*\n" +
+	"\x05offer\x18\x01 \x01(\v2\x14.mygrpcservice.OfferR\x05offer\"P\n" +
+	"\x11ListOffersRequest\x12\x1f\n" +
+	"\vproperty_id\x18\x01 \x01(\tR\n" +
+	"propertyId\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\rR\bstatuses\"B\n" +
+	"\x12ListOffersResponse\x12,\n" +
+	"\x06offers\x18\x01 \x03(\v2\x14.mygrpcservice.OfferR\x06offers\"$\n" +
+	"\x12AcceptOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13AcceptOfferResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12RejectOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13RejectOfferResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x13CounterOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"&\n" +
+	"\x14CounterOfferResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xe0\x05\n" +
+	"\fOfferService\x12\x81\x01\n" +
+	"\vSubmitOffer\x12!.mygrpcservice.SubmitOfferRequest\x1a\".mygrpcservice.SubmitOfferResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/property/{property_id}/offer\x12c\n" +
+	"\bGetOffer\x12\x1e.mygrpcservice.GetOfferRequest\x1a\x1f.mygrpcservice.GetOfferResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/offer/{id}\x12{\n" +
+	"\n" +
+	"ListOffers\x12 .mygrpcservice.ListOffersRequest\x1a!.mygrpcservice.ListOffersResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/property/{property_id}/offer\x12v\n" +
+	"\vAcceptOffer\x12!.mygrpcservice.AcceptOfferRequest\x1a\".mygrpcservice.AcceptOfferResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/offer/{id}:accept\x12v\n" +
+	"\vRejectOffer\x12!.mygrpcservice.RejectOfferRequest\x1a\".mygrpcservice.RejectOfferResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/offer/{id}:reject\x12z\n" +
+	"\fCounterOffer\x12\".mygrpcservice.CounterOfferRequest\x1a#.mygrpcservice.CounterOfferResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/offer/{id}:counterB\"Z property-service/api/proto;protob\x06proto3"
+
+var (
+	file_offer_service_proto_rawDescOnce sync.Once
+	file_offer_service_proto_rawDescData []byte
+)
+
+func file_offer_service_proto_rawDescGZIP() []byte {
+	file_offer_service_proto_rawDescOnce.Do(func() {
+		file_offer_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_offer_service_proto_rawDesc), len(file_offer_service_proto_rawDesc)))
+	})
+	return file_offer_service_proto_rawDescData
+}
+
+var file_offer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_offer_service_proto_goTypes = []any{
+	(*Offer)(nil),                 // 0: mygrpcservice.Offer
+	(*SubmitOfferRequest)(nil),    // 1: mygrpcservice.SubmitOfferRequest
+	(*SubmitOfferResponse)(nil),   // 2: mygrpcservice.SubmitOfferResponse
+	(*GetOfferRequest)(nil),       // 3: mygrpcservice.GetOfferRequest
+	(*GetOfferResponse)(nil),      // 4: mygrpcservice.GetOfferResponse
+	(*ListOffersRequest)(nil),     // 5: mygrpcservice.ListOffersRequest
+	(*ListOffersResponse)(nil),    // 6: mygrpcservice.ListOffersResponse
+	(*AcceptOfferRequest)(nil),    // 7: mygrpcservice.AcceptOfferRequest
+	(*AcceptOfferResponse)(nil),   // 8: mygrpcservice.AcceptOfferResponse
+	(*RejectOfferRequest)(nil),    // 9: mygrpcservice.RejectOfferRequest
+	(*RejectOfferResponse)(nil),   // 10: mygrpcservice.RejectOfferResponse
+	(*CounterOfferRequest)(nil),   // 11: mygrpcservice.CounterOfferRequest
+	(*CounterOfferResponse)(nil),  // 12: mygrpcservice.CounterOfferResponse
+	(*Money)(nil),                 // 13: mygrpcservice.Money
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_offer_service_proto_depIdxs = []int32{
+	13, // 0: mygrpcservice.Offer.amount:type_name -> mygrpcservice.Money
+	13, // 1: mygrpcservice.Offer.counter_amount:type_name -> mygrpcservice.Money
+	14, // 2: mygrpcservice.Offer.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: mygrpcservice.Offer.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 4: mygrpcservice.SubmitOfferRequest.amount:type_name -> mygrpcservice.Money
+	0,  // 5: mygrpcservice.GetOfferResponse.offer:type_name -> mygrpcservice.Offer
+	0,  // 6: mygrpcservice.ListOffersResponse.offers:type_name -> mygrpcservice.Offer
+	1,  // 7: mygrpcservice.OfferService.SubmitOffer:input_type -> mygrpcservice.SubmitOfferRequest
+	3,  // 8: mygrpcservice.OfferService.GetOffer:input_type -> mygrpcservice.GetOfferRequest
+	5,  // 9: mygrpcservice.OfferService.ListOffers:input_type -> mygrpcservice.ListOffersRequest
+	7,  // 10: mygrpcservice.OfferService.AcceptOffer:input_type -> mygrpcservice.AcceptOfferRequest
+	9,  // 11: mygrpcservice.OfferService.RejectOffer:input_type -> mygrpcservice.RejectOfferRequest
+	11, // 12: mygrpcservice.OfferService.CounterOffer:input_type -> mygrpcservice.CounterOfferRequest
+	2,  // 13: mygrpcservice.OfferService.SubmitOffer:output_type -> mygrpcservice.SubmitOfferResponse
+	4,  // 14: mygrpcservice.OfferService.GetOffer:output_type -> mygrpcservice.GetOfferResponse
+	6,  // 15: mygrpcservice.OfferService.ListOffers:output_type -> mygrpcservice.ListOffersResponse
+	8,  // 16: mygrpcservice.OfferService.AcceptOffer:output_type -> mygrpcservice.AcceptOfferResponse
+	10, // 17: mygrpcservice.OfferService.RejectOffer:output_type -> mygrpcservice.RejectOfferResponse
+	12, // 18: mygrpcservice.OfferService.CounterOffer:output_type -> mygrpcservice.CounterOfferResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_offer_service_proto_init() }
+func file_offer_service_proto_init() {
+	if File_offer_service_proto != nil {
+		return
+	}
+	file_property_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_offer_service_proto_rawDesc), len(file_offer_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_offer_service_proto_goTypes,
+		DependencyIndexes: file_offer_service_proto_depIdxs,
+		MessageInfos:      file_offer_service_proto_msgTypes,
+	}.Build()
+	File_offer_service_proto = out.File
+	file_offer_service_proto_goTypes = nil
+	file_offer_service_proto_depIdxs = nil
+}
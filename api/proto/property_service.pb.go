@@ -31,13 +31,24 @@ type Property struct {
 	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	OwnerID         string                 `protobuf:"bytes,4,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
 	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Available       *wrapperspb.BoolValue  `protobuf:"bytes,6,opt,name=available,proto3" json:"available,omitempty"`
 	AvailableDate   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=available_date,json=availableDate,proto3" json:"available_date,omitempty"`
 	Address         *Address               `protobuf:"bytes,8,opt,name=address,proto3,oneof" json:"address,omitempty"`
 	SaleType        uint32                 `protobuf:"varint,9,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"`
-	PaginationToken string                 `protobuf:"bytes,10,opt,name=pagination_token,json=paginationToken,proto3" json:"pagination_token,omitempty"` // Token for pagination, if applicable.
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Distance        *float64               `protobuf:"fixed64,11,opt,name=distance,proto3,oneof" json:"distance,omitempty"`                                                               // Distance in metres from the search point, if applicable.
+	Highlights      []*Highlight           `protobuf:"bytes,12,rep,name=highlights,proto3" json:"highlights,omitempty"`                                                                   // Matched passages of a text search, if applicable.
+	SimilarityScore *float64               `protobuf:"fixed64,13,opt,name=similarity_score,json=similarityScore,proto3,oneof" json:"similarity_score,omitempty"`                          // Similarity to the source property, if applicable.
+	Areas           []string               `protobuf:"bytes,14,rep,name=areas,proto3" json:"areas,omitempty"`                                                                             // Keys of the named areas containing the property.
+	Nearby          map[string]*NearbyPOI  `protobuf:"bytes,15,rep,name=nearby,proto3" json:"nearby,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Nearest point of interest of every type, by type, e.g. "station".
+	Rent            *Rent                  `protobuf:"bytes,16,opt,name=rent,proto3,oneof" json:"rent,omitempty"`                                                                         // Set when the property is for rent.
+	AskingPrice     *Money                 `protobuf:"bytes,17,opt,name=asking_price,json=askingPrice,proto3,oneof" json:"asking_price,omitempty"`                                        // Set when the property is for sale.
+	TravelTime      *uint32                `protobuf:"varint,18,opt,name=travel_time,json=travelTime,proto3,oneof" json:"travel_time,omitempty"`                                          // Travel time in seconds from the commute destination, if applicable.
+	Attributes      *Attributes            `protobuf:"bytes,19,opt,name=attributes,proto3,oneof" json:"attributes,omitempty"`                                                             // Rooms, floor area and features, if described.
+	// Listing status, 1 = draft, 2 = published, 3 = under offer, 4 = let, 5 = sold,
+	// 6 = withdrawn, 7 = archived. Only published properties are listed unless other
+	// statuses are requested.
+	Status        uint32 `protobuf:"varint,20,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Property) Reset() {
@@ -105,13 +116,6 @@ func (x *Property) GetTitle() string {
 	return ""
 }
 
-func (x *Property) GetAvailable() *wrapperspb.BoolValue {
-	if x != nil {
-		return x.Available
-	}
-	return nil
-}
-
 func (x *Property) GetAvailableDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.AvailableDate
@@ -133,41 +137,109 @@ func (x *Property) GetSaleType() uint32 {
 	return 0
 }
 
-func (x *Property) GetPaginationToken() string {
+func (x *Property) GetDistance() float64 {
+	if x != nil && x.Distance != nil {
+		return *x.Distance
+	}
+	return 0
+}
+
+func (x *Property) GetHighlights() []*Highlight {
 	if x != nil {
-		return x.PaginationToken
+		return x.Highlights
 	}
-	return ""
+	return nil
 }
 
-type Address struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FirstLine     string                 `protobuf:"bytes,1,opt,name=first_line,json=firstLine,proto3" json:"first_line,omitempty"`
-	Street        string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
-	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	County        string                 `protobuf:"bytes,4,opt,name=county,proto3" json:"county,omitempty"`
-	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
-	Postcode      string                 `protobuf:"bytes,6,opt,name=postcode,proto3" json:"postcode,omitempty"`
-	Latitude      *float32               `protobuf:"fixed32,7,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
-	Longitude     *float32               `protobuf:"fixed32,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+func (x *Property) GetSimilarityScore() float64 {
+	if x != nil && x.SimilarityScore != nil {
+		return *x.SimilarityScore
+	}
+	return 0
+}
+
+func (x *Property) GetAreas() []string {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *Property) GetNearby() map[string]*NearbyPOI {
+	if x != nil {
+		return x.Nearby
+	}
+	return nil
+}
+
+func (x *Property) GetRent() *Rent {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *Property) GetAskingPrice() *Money {
+	if x != nil {
+		return x.AskingPrice
+	}
+	return nil
+}
+
+func (x *Property) GetTravelTime() uint32 {
+	if x != nil && x.TravelTime != nil {
+		return *x.TravelTime
+	}
+	return 0
+}
+
+func (x *Property) GetAttributes() *Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Property) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// Attributes are the structured facts of a property.
+type Attributes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Bedrooms       uint32                 `protobuf:"varint,1,opt,name=bedrooms,proto3" json:"bedrooms,omitempty"`
+	Bathrooms      uint32                 `protobuf:"varint,2,opt,name=bathrooms,proto3" json:"bathrooms,omitempty"`
+	ReceptionRooms uint32                 `protobuf:"varint,3,opt,name=reception_rooms,json=receptionRooms,proto3" json:"reception_rooms,omitempty"`
+	FloorArea      *FloorArea             `protobuf:"bytes,4,opt,name=floor_area,json=floorArea,proto3" json:"floor_area,omitempty"` // Optional.
+	Furnishing     uint32                 `protobuf:"varint,5,opt,name=furnishing,proto3" json:"furnishing,omitempty"`               // 0 = unknown, 1 = unfurnished, 2 = part furnished, 3 = furnished.
+	Parking        uint32                 `protobuf:"varint,6,opt,name=parking,proto3" json:"parking,omitempty"`                     // 0 = unknown, 1 = none, 2 = street, 3 = off street, 4 = garage.
+	Garden         bool                   `protobuf:"varint,7,opt,name=garden,proto3" json:"garden,omitempty"`
+	PetsAllowed    bool                   `protobuf:"varint,8,opt,name=pets_allowed,json=petsAllowed,proto3" json:"pets_allowed,omitempty"`
+	// One of step-free-access, lift, wheelchair-accessible, wide-doorways,
+	// level-access-shower and ground-floor-bedroom each.
+	Accessibility []string `protobuf:"bytes,9,rep,name=accessibility,proto3" json:"accessibility,omitempty"`
+	Amenities     []string `protobuf:"bytes,10,rep,name=amenities,proto3" json:"amenities,omitempty"` // e.g. "air-conditioning", names are stored as keys.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
+func (x *Attributes) Reset() {
+	*x = Attributes{}
 	mi := &file_property_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Address) String() string {
+func (x *Attributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*Attributes) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
+func (x *Attributes) ProtoReflect() protoreflect.Message {
 	mi := &file_property_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,97 +251,105 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attributes.ProtoReflect.Descriptor instead.
+func (*Attributes) Descriptor() ([]byte, []int) {
 	return file_property_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Address) GetFirstLine() string {
+func (x *Attributes) GetBedrooms() uint32 {
 	if x != nil {
-		return x.FirstLine
+		return x.Bedrooms
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetStreet() string {
+func (x *Attributes) GetBathrooms() uint32 {
 	if x != nil {
-		return x.Street
+		return x.Bathrooms
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetCity() string {
+func (x *Attributes) GetReceptionRooms() uint32 {
 	if x != nil {
-		return x.City
+		return x.ReceptionRooms
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetCounty() string {
+func (x *Attributes) GetFloorArea() *FloorArea {
 	if x != nil {
-		return x.County
+		return x.FloorArea
 	}
-	return ""
+	return nil
 }
 
-func (x *Address) GetCountry() string {
+func (x *Attributes) GetFurnishing() uint32 {
 	if x != nil {
-		return x.Country
+		return x.Furnishing
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetPostcode() string {
+func (x *Attributes) GetParking() uint32 {
 	if x != nil {
-		return x.Postcode
+		return x.Parking
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetLatitude() float32 {
-	if x != nil && x.Latitude != nil {
-		return *x.Latitude
+func (x *Attributes) GetGarden() bool {
+	if x != nil {
+		return x.Garden
 	}
-	return 0
+	return false
 }
 
-func (x *Address) GetLongitude() float32 {
-	if x != nil && x.Longitude != nil {
-		return *x.Longitude
+func (x *Attributes) GetPetsAllowed() bool {
+	if x != nil {
+		return x.PetsAllowed
 	}
-	return 0
+	return false
 }
 
-// Request and Response messages for the Create operation.
-type CreatePropertyRequest struct {
+func (x *Attributes) GetAccessibility() []string {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *Attributes) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+// FloorArea is the floor area of a property in its unit.
+type FloorArea struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	OwnerID       string                 `protobuf:"bytes,4,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Available     bool                   `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
-	AvailableDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=available_date,json=availableDate,proto3" json:"available_date,omitempty"`
-	Address       *Address               `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	SaleType      uint32                 `protobuf:"varint,9,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"`
+	Size          float64                `protobuf:"fixed64,1,opt,name=size,proto3" json:"size,omitempty"`
+	Unit          uint32                 `protobuf:"varint,2,opt,name=unit,proto3" json:"unit,omitempty"`                                      // 1 = square metres, 2 = square feet.
+	SquareMetres  float64                `protobuf:"fixed64,3,opt,name=square_metres,json=squareMetres,proto3" json:"square_metres,omitempty"` // Output only, the size floor areas are filtered by.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePropertyRequest) Reset() {
-	*x = CreatePropertyRequest{}
+func (x *FloorArea) Reset() {
+	*x = FloorArea{}
 	mi := &file_property_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePropertyRequest) String() string {
+func (x *FloorArea) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePropertyRequest) ProtoMessage() {}
+func (*FloorArea) ProtoMessage() {}
 
-func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
+func (x *FloorArea) ProtoReflect() protoreflect.Message {
 	mi := &file_property_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,96 +361,183 @@ func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePropertyRequest.ProtoReflect.Descriptor instead.
-func (*CreatePropertyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FloorArea.ProtoReflect.Descriptor instead.
+func (*FloorArea) Descriptor() ([]byte, []int) {
 	return file_property_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreatePropertyRequest) GetId() string {
+func (x *FloorArea) GetSize() float64 {
 	if x != nil {
-		return x.Id
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePropertyRequest) GetCategory() string {
+func (x *FloorArea) GetUnit() uint32 {
 	if x != nil {
-		return x.Category
+		return x.Unit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePropertyRequest) GetDescription() string {
+func (x *FloorArea) GetSquareMetres() float64 {
 	if x != nil {
-		return x.Description
+		return x.SquareMetres
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePropertyRequest) GetOwnerID() string {
+// AttributeFilter matches the properties with the rooms, floor area and features, every
+// criterion that is set must match.
+type AttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinBedrooms   uint32                 `protobuf:"varint,1,opt,name=min_bedrooms,json=minBedrooms,proto3" json:"min_bedrooms,omitempty"`
+	MaxBedrooms   *uint32                `protobuf:"varint,2,opt,name=max_bedrooms,json=maxBedrooms,proto3,oneof" json:"max_bedrooms,omitempty"` // Unset = no upper bound, 0 = studios.
+	MinBathrooms  uint32                 `protobuf:"varint,3,opt,name=min_bathrooms,json=minBathrooms,proto3" json:"min_bathrooms,omitempty"`
+	MinFloorArea  float64                `protobuf:"fixed64,4,opt,name=min_floor_area,json=minFloorArea,proto3" json:"min_floor_area,omitempty"`       // Square metres.
+	MaxFloorArea  *float64               `protobuf:"fixed64,5,opt,name=max_floor_area,json=maxFloorArea,proto3,oneof" json:"max_floor_area,omitempty"` // Square metres, unset = no upper bound.
+	Furnishing    uint32                 `protobuf:"varint,6,opt,name=furnishing,proto3" json:"furnishing,omitempty"`                                  // 0 = any furnishing.
+	Parking       bool                   `protobuf:"varint,7,opt,name=parking,proto3" json:"parking,omitempty"`                                        // Only properties with street, off street or garage parking.
+	Garden        *wrapperspb.BoolValue  `protobuf:"bytes,8,opt,name=garden,proto3" json:"garden,omitempty"`                                           // Unset = with or without a garden.
+	PetsAllowed   *wrapperspb.BoolValue  `protobuf:"bytes,9,opt,name=pets_allowed,json=petsAllowed,proto3" json:"pets_allowed,omitempty"`
+	Accessibility []string               `protobuf:"bytes,10,rep,name=accessibility,proto3" json:"accessibility,omitempty"` // Every feature is required.
+	Amenities     []string               `protobuf:"bytes,11,rep,name=amenities,proto3" json:"amenities,omitempty"`         // Every amenity is required.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_property_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[3]
 	if x != nil {
-		return x.OwnerID
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePropertyRequest) GetTitle() string {
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttributeFilter) GetMinBedrooms() uint32 {
 	if x != nil {
-		return x.Title
+		return x.MinBedrooms
 	}
-	return ""
+	return 0
+}
+
+func (x *AttributeFilter) GetMaxBedrooms() uint32 {
+	if x != nil && x.MaxBedrooms != nil {
+		return *x.MaxBedrooms
+	}
+	return 0
 }
 
-func (x *CreatePropertyRequest) GetAvailable() bool {
+func (x *AttributeFilter) GetMinBathrooms() uint32 {
 	if x != nil {
-		return x.Available
+		return x.MinBathrooms
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetMinFloorArea() float64 {
+	if x != nil {
+		return x.MinFloorArea
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetMaxFloorArea() float64 {
+	if x != nil && x.MaxFloorArea != nil {
+		return *x.MaxFloorArea
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetFurnishing() uint32 {
+	if x != nil {
+		return x.Furnishing
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetParking() bool {
+	if x != nil {
+		return x.Parking
 	}
 	return false
 }
 
-func (x *CreatePropertyRequest) GetAvailableDate() *timestamppb.Timestamp {
+func (x *AttributeFilter) GetGarden() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.AvailableDate
+		return x.Garden
 	}
 	return nil
 }
 
-func (x *CreatePropertyRequest) GetAddress() *Address {
+func (x *AttributeFilter) GetPetsAllowed() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Address
+		return x.PetsAllowed
 	}
 	return nil
 }
 
-func (x *CreatePropertyRequest) GetSaleType() uint32 {
+func (x *AttributeFilter) GetAccessibility() []string {
 	if x != nil {
-		return x.SaleType
+		return x.Accessibility
 	}
-	return 0
+	return nil
 }
 
-type CreatePropertyResponse struct {
+func (x *AttributeFilter) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+// Money is an amount in the minor units of its ISO 4217 currency, e.g. {amount: 125000,
+// currency: "GBP"} is 1,250.00 pounds.
+type Money struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePropertyResponse) Reset() {
-	*x = CreatePropertyResponse{}
-	mi := &file_property_service_proto_msgTypes[3]
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_property_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePropertyResponse) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePropertyResponse) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *CreatePropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[3]
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,41 +548,50 @@ func (x *CreatePropertyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePropertyResponse.ProtoReflect.Descriptor instead.
-func (*CreatePropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreatePropertyResponse) GetId() string {
+func (x *Money) GetAmount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-// Request and Response messages for the Read operation.
-type ReadPropertyRequest struct {
+// Rent is the price of a property for rent per period.
+type Rent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Period        uint32                 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`                                    // 1 = weekly, 2 = monthly.
+	MonthlyAmount int64                  `protobuf:"varint,3,opt,name=monthly_amount,json=monthlyAmount,proto3" json:"monthly_amount,omitempty"` // Output only, the price per month rents are filtered and sorted by.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadPropertyRequest) Reset() {
-	*x = ReadPropertyRequest{}
-	mi := &file_property_service_proto_msgTypes[4]
+func (x *Rent) Reset() {
+	*x = Rent{}
+	mi := &file_property_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadPropertyRequest) String() string {
+func (x *Rent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadPropertyRequest) ProtoMessage() {}
+func (*Rent) ProtoMessage() {}
 
-func (x *ReadPropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[4]
+func (x *Rent) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,48 +602,2749 @@ func (x *ReadPropertyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadPropertyRequest.ProtoReflect.Descriptor instead.
-func (*ReadPropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Rent.ProtoReflect.Descriptor instead.
+func (*Rent) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ReadPropertyRequest) GetId() string {
+func (x *Rent) GetPrice() *Money {
 	if x != nil {
-		return x.Id
+		return x.Price
 	}
-	return ""
+	return nil
 }
 
-// Request and Response messages for the Update operation.
-type UpdatePropertyRequest struct {
+func (x *Rent) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *Rent) GetMonthlyAmount() int64 {
+	if x != nil {
+		return x.MonthlyAmount
+	}
+	return 0
+}
+
+// PriceRange matches the prices from min to max in minor units.
+type PriceRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           int64                  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int64                  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`          // 0 = no upper bound.
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Empty matches any currency.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRange) Reset() {
+	*x = PriceRange{}
+	mi := &file_property_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRange) ProtoMessage() {}
+
+func (x *PriceRange) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRange.ProtoReflect.Descriptor instead.
+func (*PriceRange) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceRange) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PriceRange) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PriceRange) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// NearbyPOI is the nearest point of interest of a type to a property.
+type NearbyPOI struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Distance      float64                `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"` // Metres from the property.
+	Location      *Coordinate            `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPOI) Reset() {
+	*x = NearbyPOI{}
+	mi := &file_property_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPOI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPOI) ProtoMessage() {}
+
+func (x *NearbyPOI) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPOI.ProtoReflect.Descriptor instead.
+func (*NearbyPOI) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NearbyPOI) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NearbyPOI) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *NearbyPOI) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// Highlight is a passage of a property field matching a text search.
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Field the passage is from, e.g. "Address.City".
+	Texts         []*HighlightText       `protobuf:"bytes,2,rep,name=texts,proto3" json:"texts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_property_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Highlight) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Highlight) GetTexts() []*HighlightText {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+type HighlightText struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Hit           bool                   `protobuf:"varint,2,opt,name=hit,proto3" json:"hit,omitempty"` // The text matched the query.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightText) Reset() {
+	*x = HighlightText{}
+	mi := &file_property_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightText) ProtoMessage() {}
+
+func (x *HighlightText) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightText.ProtoReflect.Descriptor instead.
+func (*HighlightText) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HighlightText) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HighlightText) GetHit() bool {
+	if x != nil {
+		return x.Hit
+	}
+	return false
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstLine     string                 `protobuf:"bytes,1,opt,name=first_line,json=firstLine,proto3" json:"first_line,omitempty"`
+	Street        string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	County        string                 `protobuf:"bytes,4,opt,name=county,proto3" json:"county,omitempty"`
+	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Postcode      string                 `protobuf:"bytes,6,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	Latitude      *float32               `protobuf:"fixed32,7,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"` // Unset latitude or longitude geocodes the address.
+	Longitude     *float32               `protobuf:"fixed32,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_property_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Address) GetFirstLine() string {
+	if x != nil {
+		return x.FirstLine
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetCounty() string {
+	if x != nil {
+		return x.County
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+func (x *Address) GetLatitude() float32 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Address) GetLongitude() float32 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+// Request and Response messages for the Create operation.
+type CreatePropertyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OwnerID       string                 `protobuf:"bytes,4,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	AvailableDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=available_date,json=availableDate,proto3" json:"available_date,omitempty"`
+	Address       *Address               `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	SaleType      uint32                 `protobuf:"varint,9,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"`
+	Rent          *Rent                  `protobuf:"bytes,10,opt,name=rent,proto3" json:"rent,omitempty"`                                  // Required for rent, sale types 1 and 3.
+	AskingPrice   *Money                 `protobuf:"bytes,11,opt,name=asking_price,json=askingPrice,proto3" json:"asking_price,omitempty"` // Required for sale, sale types 2 and 3.
+	Attributes    *Attributes            `protobuf:"bytes,12,opt,name=attributes,proto3" json:"attributes,omitempty"`                      // Optional.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePropertyRequest) Reset() {
+	*x = CreatePropertyRequest{}
+	mi := &file_property_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePropertyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePropertyRequest) ProtoMessage() {}
+
+func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePropertyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePropertyRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePropertyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreatePropertyRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreatePropertyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePropertyRequest) GetOwnerID() string {
+	if x != nil {
+		return x.OwnerID
+	}
+	return ""
+}
+
+func (x *CreatePropertyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePropertyRequest) GetAvailableDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AvailableDate
+	}
+	return nil
+}
+
+func (x *CreatePropertyRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreatePropertyRequest) GetSaleType() uint32 {
+	if x != nil {
+		return x.SaleType
+	}
+	return 0
+}
+
+func (x *CreatePropertyRequest) GetRent() *Rent {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *CreatePropertyRequest) GetAskingPrice() *Money {
+	if x != nil {
+		return x.AskingPrice
+	}
+	return nil
+}
+
+func (x *CreatePropertyRequest) GetAttributes() *Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreatePropertyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePropertyResponse) Reset() {
+	*x = CreatePropertyResponse{}
+	mi := &file_property_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePropertyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePropertyResponse) ProtoMessage() {}
+
+func (x *CreatePropertyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePropertyResponse.ProtoReflect.Descriptor instead.
+func (*CreatePropertyResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreatePropertyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the Read operation.
+type ReadPropertyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadPropertyRequest) Reset() {
+	*x = ReadPropertyRequest{}
+	mi := &file_property_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPropertyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPropertyRequest) ProtoMessage() {}
+
+func (x *ReadPropertyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPropertyRequest.ProtoReflect.Descriptor instead.
+func (*ReadPropertyRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReadPropertyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the Update operation.
+type UpdatePropertyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AvailableDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=available_date,json=availableDate,proto3" json:"available_date,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Category      []string               `protobuf:"bytes,6,rep,name=category,proto3" json:"category,omitempty"`
+	Address       *Address               `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	SaleType      uint32                 `protobuf:"varint,8,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"` // Updated with the prices of the sale type, as in CreatePropertyRequest.
+	Rent          *Rent                  `protobuf:"bytes,9,opt,name=rent,proto3" json:"rent,omitempty"`
+	AskingPrice   *Money                 `protobuf:"bytes,10,opt,name=asking_price,json=askingPrice,proto3" json:"asking_price,omitempty"`
+	Attributes    *Attributes            `protobuf:"bytes,11,opt,name=attributes,proto3" json:"attributes,omitempty"` // Replaces the attributes when set.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePropertyRequest) Reset() {
+	*x = UpdatePropertyRequest{}
+	mi := &file_property_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePropertyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePropertyRequest) ProtoMessage() {}
+
+func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePropertyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePropertyRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdatePropertyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePropertyRequest) GetAvailableDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AvailableDate
+	}
+	return nil
+}
+
+func (x *UpdatePropertyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePropertyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePropertyRequest) GetCategory() []string {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *UpdatePropertyRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdatePropertyRequest) GetSaleType() uint32 {
+	if x != nil {
+		return x.SaleType
+	}
+	return 0
+}
+
+func (x *UpdatePropertyRequest) GetRent() *Rent {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *UpdatePropertyRequest) GetAskingPrice() *Money {
+	if x != nil {
+		return x.AskingPrice
+	}
+	return nil
+}
+
+func (x *UpdatePropertyRequest) GetAttributes() *Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdatePropertyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePropertyResponse) Reset() {
+	*x = UpdatePropertyResponse{}
+	mi := &file_property_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePropertyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePropertyResponse) ProtoMessage() {}
+
+func (x *UpdatePropertyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePropertyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePropertyResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePropertyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the Delete operation.
+type DeletePropertyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePropertyRequest) Reset() {
+	*x = DeletePropertyRequest{}
+	mi := &file_property_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePropertyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePropertyRequest) ProtoMessage() {}
+
+func (x *DeletePropertyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePropertyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePropertyRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeletePropertyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePropertyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePropertyResponse) Reset() {
+	*x = DeletePropertyResponse{}
+	mi := &file_property_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePropertyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePropertyResponse) ProtoMessage() {}
+
+func (x *DeletePropertyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePropertyResponse.ProtoReflect.Descriptor instead.
+func (*DeletePropertyResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeletePropertyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the listing status operations, e.g. PublishProperty.
+type ChangePropertyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePropertyStatusRequest) Reset() {
+	*x = ChangePropertyStatusRequest{}
+	mi := &file_property_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePropertyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePropertyStatusRequest) ProtoMessage() {}
+
+func (x *ChangePropertyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePropertyStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangePropertyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChangePropertyStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ChangePropertyStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePropertyStatusResponse) Reset() {
+	*x = ChangePropertyStatusResponse{}
+	mi := &file_property_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePropertyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePropertyStatusResponse) ProtoMessage() {}
+
+func (x *ChangePropertyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePropertyStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangePropertyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChangePropertyStatusResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DateRange holds unavailable dates of a property, from start up to but excluding end.
+type DateRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // Midnight UTC of the first unavailable date.
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // Midnight UTC of the first date after the range.
+	Kind          uint32                 `protobuf:"varint,3,opt,name=kind,proto3" json:"kind,omitempty"`  // 1 = blocked by the owner, 2 = booked.
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_property_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DateRange) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DateRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *DateRange) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *DateRange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// Request and Response messages for the availability calendar operations, the dates from
+// start up to but excluding end are blocked, freed or read. Only the date of a timestamp is
+// used, in UTC.
+type BlockDatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Kind          uint32                 `protobuf:"varint,4,opt,name=kind,proto3" json:"kind,omitempty"` // 1 = blocked (default), 2 = booked.
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`  // Reason for the owner, e.g. "maintenance" (optional).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockDatesRequest) Reset() {
+	*x = BlockDatesRequest{}
+	mi := &file_property_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDatesRequest) ProtoMessage() {}
+
+func (x *BlockDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDatesRequest.ProtoReflect.Descriptor instead.
+func (*BlockDatesRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockDatesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlockDatesRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *BlockDatesRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *BlockDatesRequest) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *BlockDatesRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type BlockDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockDatesResponse) Reset() {
+	*x = BlockDatesResponse{}
+	mi := &file_property_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDatesResponse) ProtoMessage() {}
+
+func (x *BlockDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDatesResponse.ProtoReflect.Descriptor instead.
+func (*BlockDatesResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BlockDatesResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnblockDatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockDatesRequest) Reset() {
+	*x = UnblockDatesRequest{}
+	mi := &file_property_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockDatesRequest) ProtoMessage() {}
+
+func (x *UnblockDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockDatesRequest.ProtoReflect.Descriptor instead.
+func (*UnblockDatesRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnblockDatesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnblockDatesRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *UnblockDatesRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type UnblockDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockDatesResponse) Reset() {
+	*x = UnblockDatesResponse{}
+	mi := &file_property_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockDatesResponse) ProtoMessage() {}
+
+func (x *UnblockDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockDatesResponse.ProtoReflect.Descriptor instead.
+func (*UnblockDatesResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnblockDatesResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityRequest) Reset() {
+	*x = GetAvailabilityRequest{}
+	mi := &file_property_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAvailabilityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAvailabilityRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetAvailabilityRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type GetAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"` // No date of the period is blocked or booked.
+	Ranges        []*DateRange           `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`        // The ranges with a date in the period, by start.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityResponse) Reset() {
+	*x = GetAvailabilityResponse{}
+	mi := &file_property_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAvailabilityResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *GetAvailabilityResponse) GetRanges() []*DateRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type PropertyListByCategoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`                                               // The category to filter properties.
+	Limit             uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // Maximum number of properties to return.
+	PaginationToken   string                 `protobuf:"bytes,5,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"`                                 // Page token from a previous response (optional).
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Also return total_count.
+	SortBy            string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                     // Comma separated sort fields, a leading minus sorts descending, e.g. "-available_date,title".
+	// Fields: title, available_date, sale_type, created_at, updated_at, rent, asking_price.
+	// Empty sorts by title, properties without the sorted price come first.
+	Area          string                 `protobuf:"bytes,8,opt,name=area,proto3" json:"area,omitempty"`                         // Optional key or name of a named area to filter properties.
+	FreeFrom      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=free_from,json=freeFrom,proto3" json:"free_from,omitempty"` // With free_to, only the properties without a blocked or
+	FreeTo        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=free_to,json=freeTo,proto3" json:"free_to,omitempty"`      // booked date from free_from up to but excluding free_to.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyListByCategoryRequest) Reset() {
+	*x = PropertyListByCategoryRequest{}
+	mi := &file_property_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyListByCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyListByCategoryRequest) ProtoMessage() {}
+
+func (x *PropertyListByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyListByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*PropertyListByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PropertyListByCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PropertyListByCategoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PropertyListByCategoryRequest) GetPaginationToken() string {
+	if x != nil {
+		return x.PaginationToken
+	}
+	return ""
+}
+
+func (x *PropertyListByCategoryRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *PropertyListByCategoryRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *PropertyListByCategoryRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *PropertyListByCategoryRequest) GetFreeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeFrom
+	}
+	return nil
+}
+
+func (x *PropertyListByCategoryRequest) GetFreeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeTo
+	}
+	return nil
+}
+
+type PropertyListByOwnerRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OwnerID           string                 `protobuf:"bytes,1,opt,name=ownerID,proto3" json:"ownerID,omitempty"`                                                 // The ownerID to filter properties.
+	Limit             uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // Maximum number of properties to return.
+	PaginationToken   string                 `protobuf:"bytes,5,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"`                                 // Page token from a previous response (optional).
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Also return total_count.
+	SortBy            string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                     // Comma separated sort fields, a leading minus sorts descending, e.g. "-available_date,title".
+	// Fields: title, available_date, sale_type, created_at, updated_at, rent, asking_price.
+	// Empty sorts by title, properties without the sorted price come first.
+	Area          string                 `protobuf:"bytes,8,opt,name=area,proto3" json:"area,omitempty"`                          // Optional key or name of a named area to filter properties.
+	Statuses      []uint32               `protobuf:"varint,9,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`          // Listing statuses to include, e.g. the drafts of the owner, empty = published.
+	FreeFrom      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=free_from,json=freeFrom,proto3" json:"free_from,omitempty"` // With free_to, only the properties without a blocked or
+	FreeTo        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=free_to,json=freeTo,proto3" json:"free_to,omitempty"`       // booked date from free_from up to but excluding free_to.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyListByOwnerRequest) Reset() {
+	*x = PropertyListByOwnerRequest{}
+	mi := &file_property_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyListByOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyListByOwnerRequest) ProtoMessage() {}
+
+func (x *PropertyListByOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyListByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*PropertyListByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PropertyListByOwnerRequest) GetOwnerID() string {
+	if x != nil {
+		return x.OwnerID
+	}
+	return ""
+}
+
+func (x *PropertyListByOwnerRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PropertyListByOwnerRequest) GetPaginationToken() string {
+	if x != nil {
+		return x.PaginationToken
+	}
+	return ""
+}
+
+func (x *PropertyListByOwnerRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *PropertyListByOwnerRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *PropertyListByOwnerRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *PropertyListByOwnerRequest) GetStatuses() []uint32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *PropertyListByOwnerRequest) GetFreeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeFrom
+	}
+	return nil
+}
+
+func (x *PropertyListByOwnerRequest) GetFreeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeTo
+	}
+	return nil
+}
+
+type PropertyListNearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`                // Latitude of the search point.
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`              // Longitude of the search point.
+	Radius        float64                `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`                    // Search radius in metres.
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`                  // Optional category to filter properties.
+	SaleType      uint32                 `protobuf:"varint,5,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"` // Optional sale type to filter properties.
+	Limit         uint32                 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`                       // Maximum number of properties to return.
+	Area          string                 `protobuf:"bytes,7,opt,name=area,proto3" json:"area,omitempty"`                          // Optional key or name of a named area to filter properties.
+	Attributes    *AttributeFilter       `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`              // Optional attributes to filter properties.
+	FreeFrom      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=free_from,json=freeFrom,proto3" json:"free_from,omitempty"`  // With free_to, only the properties without a blocked or
+	FreeTo        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=free_to,json=freeTo,proto3" json:"free_to,omitempty"`       // booked date from free_from up to but excluding free_to.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyListNearRequest) Reset() {
+	*x = PropertyListNearRequest{}
+	mi := &file_property_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyListNearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyListNearRequest) ProtoMessage() {}
+
+func (x *PropertyListNearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyListNearRequest.ProtoReflect.Descriptor instead.
+func (*PropertyListNearRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PropertyListNearRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *PropertyListNearRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *PropertyListNearRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PropertyListNearRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PropertyListNearRequest) GetSaleType() uint32 {
+	if x != nil {
+		return x.SaleType
+	}
+	return 0
+}
+
+func (x *PropertyListNearRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PropertyListNearRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *PropertyListNearRequest) GetAttributes() *AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *PropertyListNearRequest) GetFreeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeFrom
+	}
+	return nil
+}
+
+func (x *PropertyListNearRequest) GetFreeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeTo
+	}
+	return nil
+}
+
+type PropertyListSimilarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`        // The property to find similar properties for.
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Maximum number of properties to return.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyListSimilarRequest) Reset() {
+	*x = PropertyListSimilarRequest{}
+	mi := &file_property_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyListSimilarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyListSimilarRequest) ProtoMessage() {}
+
+func (x *PropertyListSimilarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyListSimilarRequest.ProtoReflect.Descriptor instead.
+func (*PropertyListSimilarRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PropertyListSimilarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PropertyListSimilarRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Coordinate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinate) Reset() {
+	*x = Coordinate{}
+	mi := &file_property_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinate) ProtoMessage() {}
+
+func (x *Coordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
+func (*Coordinate) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Coordinate) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Coordinate) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BottomLeft    *Coordinate            `protobuf:"bytes,1,opt,name=bottom_left,json=bottomLeft,proto3" json:"bottom_left,omitempty"` // South west corner.
+	TopRight      *Coordinate            `protobuf:"bytes,2,opt,name=top_right,json=topRight,proto3" json:"top_right,omitempty"`       // North east corner.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_property_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BoundingBox) GetBottomLeft() *Coordinate {
+	if x != nil {
+		return x.BottomLeft
+	}
+	return nil
+}
+
+func (x *BoundingBox) GetTopRight() *Coordinate {
+	if x != nil {
+		return x.TopRight
+	}
+	return nil
+}
+
+type LinearRing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*Coordinate          `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // Closed ring, the last point repeats the first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinearRing) Reset() {
+	*x = LinearRing{}
+	mi := &file_property_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinearRing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearRing) ProtoMessage() {}
+
+func (x *LinearRing) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinearRing.ProtoReflect.Descriptor instead.
+func (*LinearRing) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LinearRing) GetPoints() []*Coordinate {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type Polygon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rings         []*LinearRing          `protobuf:"bytes,1,rep,name=rings,proto3" json:"rings,omitempty"` // The first ring is the outer boundary, any others are holes.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Polygon) Reset() {
+	*x = Polygon{}
+	mi := &file_property_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polygon) ProtoMessage() {}
+
+func (x *Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Polygon) GetRings() []*LinearRing {
+	if x != nil {
+		return x.Rings
+	}
+	return nil
+}
+
+type PropertyListWithinAreaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Area:
+	//
+	//	*PropertyListWithinAreaRequest_BoundingBox
+	//	*PropertyListWithinAreaRequest_Polygon
+	Area              isPropertyListWithinAreaRequest_Area `protobuf_oneof:"area"`
+	Sort              uint32                               `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`                                                      // Sort flag/direction.
+	Limit             uint32                               `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // Maximum number of properties to return.
+	PaginationToken   string                               `protobuf:"bytes,6,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"`                                 // Page token from a previous response (optional).
+	IncludeTotalCount bool                                 `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Also return total_count.
+	FreeFrom          *timestamppb.Timestamp               `protobuf:"bytes,8,opt,name=free_from,json=freeFrom,proto3" json:"free_from,omitempty"`                               // With free_to, only the properties without a blocked or
+	FreeTo            *timestamppb.Timestamp               `protobuf:"bytes,9,opt,name=free_to,json=freeTo,proto3" json:"free_to,omitempty"`                                     // booked date from free_from up to but excluding free_to.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PropertyListWithinAreaRequest) Reset() {
+	*x = PropertyListWithinAreaRequest{}
+	mi := &file_property_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyListWithinAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyListWithinAreaRequest) ProtoMessage() {}
+
+func (x *PropertyListWithinAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyListWithinAreaRequest.ProtoReflect.Descriptor instead.
+func (*PropertyListWithinAreaRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PropertyListWithinAreaRequest) GetArea() isPropertyListWithinAreaRequest_Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+func (x *PropertyListWithinAreaRequest) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		if x, ok := x.Area.(*PropertyListWithinAreaRequest_BoundingBox); ok {
+			return x.BoundingBox
+		}
+	}
+	return nil
+}
+
+func (x *PropertyListWithinAreaRequest) GetPolygon() *Polygon {
+	if x != nil {
+		if x, ok := x.Area.(*PropertyListWithinAreaRequest_Polygon); ok {
+			return x.Polygon
+		}
+	}
+	return nil
+}
+
+func (x *PropertyListWithinAreaRequest) GetSort() uint32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *PropertyListWithinAreaRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PropertyListWithinAreaRequest) GetPaginationToken() string {
+	if x != nil {
+		return x.PaginationToken
+	}
+	return ""
+}
+
+func (x *PropertyListWithinAreaRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *PropertyListWithinAreaRequest) GetFreeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeFrom
+	}
+	return nil
+}
+
+func (x *PropertyListWithinAreaRequest) GetFreeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeTo
+	}
+	return nil
+}
+
+type isPropertyListWithinAreaRequest_Area interface {
+	isPropertyListWithinAreaRequest_Area()
+}
+
+type PropertyListWithinAreaRequest_BoundingBox struct {
+	BoundingBox *BoundingBox `protobuf:"bytes,1,opt,name=bounding_box,json=boundingBox,proto3,oneof"`
+}
+
+type PropertyListWithinAreaRequest_Polygon struct {
+	Polygon *Polygon `protobuf:"bytes,2,opt,name=polygon,proto3,oneof"`
+}
+
+func (*PropertyListWithinAreaRequest_BoundingBox) isPropertyListWithinAreaRequest_Area() {}
+
+func (*PropertyListWithinAreaRequest_Polygon) isPropertyListWithinAreaRequest_Area() {}
+
+type PropertyListWithinCommuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`                      // Latitude of the destination, e.g. a workplace.
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`                    // Longitude of the destination.
+	Departure     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`                      // Departure time of the journeys from the destination.
+	MaxMinutes    uint32                 `protobuf:"varint,4,opt,name=max_minutes,json=maxMinutes,proto3" json:"max_minutes,omitempty"` // Longest travel time in minutes, at most 120.
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                        // Optional category to filter properties.
+	SaleType      uint32                 `protobuf:"varint,6,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"`       // Optional sale type to filter properties.
+	Limit         uint32                 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                             // Maximum number of properties to return.
+	Attributes    *AttributeFilter       `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`                    // Optional attributes to filter properties.
+	FreeFrom      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=free_from,json=freeFrom,proto3" json:"free_from,omitempty"`        // With free_to, only the properties without a blocked or
+	FreeTo        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=free_to,json=freeTo,proto3" json:"free_to,omitempty"`             // booked date from free_from up to but excluding free_to.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyListWithinCommuteRequest) Reset() {
+	*x = PropertyListWithinCommuteRequest{}
+	mi := &file_property_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyListWithinCommuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyListWithinCommuteRequest) ProtoMessage() {}
+
+func (x *PropertyListWithinCommuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyListWithinCommuteRequest.ProtoReflect.Descriptor instead.
+func (*PropertyListWithinCommuteRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PropertyListWithinCommuteRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *PropertyListWithinCommuteRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *PropertyListWithinCommuteRequest) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *PropertyListWithinCommuteRequest) GetMaxMinutes() uint32 {
+	if x != nil {
+		return x.MaxMinutes
+	}
+	return 0
+}
+
+func (x *PropertyListWithinCommuteRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PropertyListWithinCommuteRequest) GetSaleType() uint32 {
+	if x != nil {
+		return x.SaleType
+	}
+	return 0
+}
+
+func (x *PropertyListWithinCommuteRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PropertyListWithinCommuteRequest) GetAttributes() *AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *PropertyListWithinCommuteRequest) GetFreeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeFrom
+	}
+	return nil
+}
+
+func (x *PropertyListWithinCommuteRequest) GetFreeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeTo
+	}
+	return nil
+}
+
+type ClusterPropertiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoundingBox   *BoundingBox           `protobuf:"bytes,1,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"` // The map viewport.
+	Zoom          uint32                 `protobuf:"varint,2,opt,name=zoom,proto3" json:"zoom,omitempty"`                                 // Map zoom level from 0, the whole world, to 22.
+	Threshold     uint32                 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`                       // Cells with fewer properties return them instead of a cluster, 0 = 10.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterPropertiesRequest) Reset() {
+	*x = ClusterPropertiesRequest{}
+	mi := &file_property_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterPropertiesRequest) ProtoMessage() {}
+
+func (x *ClusterPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*ClusterPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ClusterPropertiesRequest) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *ClusterPropertiesRequest) GetZoom() uint32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+func (x *ClusterPropertiesRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// PropertyCluster is a grid cell of the map with the number of properties inside it.
+type PropertyCluster struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Count            int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Centroid         *Coordinate            `protobuf:"bytes,2,opt,name=centroid,proto3" json:"centroid,omitempty"`                                           // Mean position of the properties in the cell.
+	SamplePropertyId string                 `protobuf:"bytes,3,opt,name=sample_property_id,json=samplePropertyId,proto3" json:"sample_property_id,omitempty"` // One of the properties in the cell.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PropertyCluster) Reset() {
+	*x = PropertyCluster{}
+	mi := &file_property_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyCluster) ProtoMessage() {}
+
+func (x *PropertyCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyCluster.ProtoReflect.Descriptor instead.
+func (*PropertyCluster) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PropertyCluster) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PropertyCluster) GetCentroid() *Coordinate {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *PropertyCluster) GetSamplePropertyId() string {
+	if x != nil {
+		return x.SamplePropertyId
+	}
+	return ""
+}
+
+type ClusterPropertiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*PropertyCluster     `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`     // The cells with at least threshold properties, the largest first.
+	Properties    []*Property            `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"` // The properties of the other cells.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterPropertiesResponse) Reset() {
+	*x = ClusterPropertiesResponse{}
+	mi := &file_property_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterPropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterPropertiesResponse) ProtoMessage() {}
+
+func (x *ClusterPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*ClusterPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ClusterPropertiesResponse) GetClusters() []*PropertyCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ClusterPropertiesResponse) GetProperties() []*Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// PropertyFilter holds the search criteria, every criterion that is set must match.
+type PropertyFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Categories     []string               `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`                            // Matches any of the categories.
+	SaleType       uint32                 `protobuf:"varint,2,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"`               // 0 = any sale type.
+	Statuses       []uint32               `protobuf:"varint,14,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`                       // Matches any of the listing statuses, empty = published.
+	AvailableFrom  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=available_from,json=availableFrom,proto3" json:"available_from,omitempty"` // Earliest available date (optional).
+	AvailableTo    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=available_to,json=availableTo,proto3" json:"available_to,omitempty"`       // Latest available date (optional).
+	City           string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	PostcodePrefix string                 `protobuf:"bytes,7,opt,name=postcode_prefix,json=postcodePrefix,proto3" json:"postcode_prefix,omitempty"`
+	Country        string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
+	Area           string                 `protobuf:"bytes,9,opt,name=area,proto3" json:"area,omitempty"`                          // Key or name of a named area.
+	NearPois       []*POIDistance         `protobuf:"bytes,10,rep,name=near_pois,json=nearPois,proto3" json:"near_pois,omitempty"` // Within a distance of a point of interest of every type.
+	Rent           *PriceRange            `protobuf:"bytes,11,opt,name=rent,proto3" json:"rent,omitempty"`                         // Monthly rent, a weekly rent is converted.
+	AskingPrice    *PriceRange            `protobuf:"bytes,12,opt,name=asking_price,json=askingPrice,proto3" json:"asking_price,omitempty"`
+	Attributes     *AttributeFilter       `protobuf:"bytes,13,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	FreeFrom       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=free_from,json=freeFrom,proto3" json:"free_from,omitempty"` // With free_to, only the properties without a blocked or
+	FreeTo         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=free_to,json=freeTo,proto3" json:"free_to,omitempty"`       // booked date from free_from up to but excluding free_to.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PropertyFilter) Reset() {
+	*x = PropertyFilter{}
+	mi := &file_property_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyFilter) ProtoMessage() {}
+
+func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyFilter.ProtoReflect.Descriptor instead.
+func (*PropertyFilter) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PropertyFilter) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetSaleType() uint32 {
+	if x != nil {
+		return x.SaleType
+	}
+	return 0
+}
+
+func (x *PropertyFilter) GetStatuses() []uint32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetAvailableFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AvailableFrom
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetAvailableTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AvailableTo
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetPostcodePrefix() string {
+	if x != nil {
+		return x.PostcodePrefix
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetNearPois() []*POIDistance {
+	if x != nil {
+		return x.NearPois
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetRent() *PriceRange {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetAskingPrice() *PriceRange {
+	if x != nil {
+		return x.AskingPrice
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetAttributes() *AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetFreeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeFrom
+	}
+	return nil
+}
+
+func (x *PropertyFilter) GetFreeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeTo
+	}
+	return nil
+}
+
+// POIDistance matches the properties within a distance of a point of interest of the type,
+// e.g. {type: "station", within: 500}. Points further than 2000 metres are not recorded.
+type POIDistance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Available     *wrapperspb.BoolValue  `protobuf:"bytes,2,opt,name=available,proto3" json:"available,omitempty"`
-	AvailableDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=available_date,json=availableDate,proto3" json:"available_date,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Category      []string               `protobuf:"bytes,6,rep,name=category,proto3" json:"category,omitempty"`
-	Address       *Address               `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	SaleType      uint32                 `protobuf:"varint,8,opt,name=sale_type,json=saleType,proto3" json:"sale_type,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Within        float64                `protobuf:"fixed64,2,opt,name=within,proto3" json:"within,omitempty"` // Metres.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePropertyRequest) Reset() {
-	*x = UpdatePropertyRequest{}
-	mi := &file_property_service_proto_msgTypes[5]
+func (x *POIDistance) Reset() {
+	*x = POIDistance{}
+	mi := &file_property_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *POIDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*POIDistance) ProtoMessage() {}
+
+func (x *POIDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use POIDistance.ProtoReflect.Descriptor instead.
+func (*POIDistance) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *POIDistance) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *POIDistance) GetWithin() float64 {
+	if x != nil {
+		return x.Within
+	}
+	return 0
+}
+
+type SearchPropertiesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Filter            *PropertyFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              uint32                 `protobuf:"varint,2,opt,name=sort,proto3" json:"sort,omitempty"`                                                      // Sort flag/direction.
+	Limit             uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // Maximum number of properties to return.
+	PaginationToken   string                 `protobuf:"bytes,5,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"`                                 // Page token from a previous response (optional).
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Also return total_count.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchPropertiesRequest) Reset() {
+	*x = SearchPropertiesRequest{}
+	mi := &file_property_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPropertiesRequest) ProtoMessage() {}
+
+func (x *SearchPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SearchPropertiesRequest) GetFilter() *PropertyFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchPropertiesRequest) GetSort() uint32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *SearchPropertiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchPropertiesRequest) GetPaginationToken() string {
+	if x != nil {
+		return x.PaginationToken
+	}
+	return ""
+}
+
+func (x *SearchPropertiesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type SearchPropertiesByTextRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Query             string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                                     // Text matched against the title, description and address.
+	SortBy            string                 `protobuf:"bytes,2,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                     // Same format as sort_by of the list requests, empty sorts by relevance.
+	Limit             uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // Maximum number of properties to return.
+	PaginationToken   string                 `protobuf:"bytes,4,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"`                                 // Page token from a previous response (optional).
+	IncludeTotalCount bool                   `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Also return total_count.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchPropertiesByTextRequest) Reset() {
+	*x = SearchPropertiesByTextRequest{}
+	mi := &file_property_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPropertiesByTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPropertiesByTextRequest) ProtoMessage() {}
+
+func (x *SearchPropertiesByTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPropertiesByTextRequest.ProtoReflect.Descriptor instead.
+func (*SearchPropertiesByTextRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchPropertiesByTextRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPropertiesByTextRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchPropertiesByTextRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchPropertiesByTextRequest) GetPaginationToken() string {
+	if x != nil {
+		return x.PaginationToken
+	}
+	return ""
+}
+
+func (x *SearchPropertiesByTextRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type GetPropertyFacetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *PropertyFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPropertyFacetsRequest) Reset() {
+	*x = GetPropertyFacetsRequest{}
+	mi := &file_property_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPropertyFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertyFacetsRequest) ProtoMessage() {}
+
+func (x *GetPropertyFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertyFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetPropertyFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPropertyFacetsRequest) GetFilter() *PropertyFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_property_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePropertyRequest) String() string {
+func (x *FacetBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePropertyRequest) ProtoMessage() {}
+func (*FacetBucket) ProtoMessage() {}
 
-func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[5]
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,89 +3355,117 @@ func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePropertyRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdatePropertyRequest) GetId() string {
+func (x *FacetBucket) GetValue() string {
 	if x != nil {
-		return x.Id
+		return x.Value
 	}
 	return ""
 }
 
-func (x *UpdatePropertyRequest) GetAvailable() *wrapperspb.BoolValue {
+func (x *FacetBucket) GetCount() int64 {
 	if x != nil {
-		return x.Available
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdatePropertyRequest) GetAvailableDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AvailableDate
-	}
-	return nil
+// PropertyFacets holds the number of matching properties per value, the largest buckets first.
+type PropertyFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*FacetBucket         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	SaleTypes     []*FacetBucket         `protobuf:"bytes,2,rep,name=sale_types,json=saleTypes,proto3" json:"sale_types,omitempty"`
+	Cities        []*FacetBucket         `protobuf:"bytes,3,rep,name=cities,proto3" json:"cities,omitempty"`
+	Statuses      []*FacetBucket         `protobuf:"bytes,5,rep,name=statuses,proto3" json:"statuses,omitempty"` // Values are the listing statuses, e.g. "2".
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePropertyRequest) GetDescription() string {
+func (x *PropertyFacets) Reset() {
+	*x = PropertyFacets{}
+	mi := &file_property_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyFacets) ProtoMessage() {}
+
+func (x *PropertyFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[46]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePropertyRequest) GetTitle() string {
+// Deprecated: Use PropertyFacets.ProtoReflect.Descriptor instead.
+func (*PropertyFacets) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PropertyFacets) GetCategories() []*FacetBucket {
 	if x != nil {
-		return x.Title
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePropertyRequest) GetCategory() []string {
+func (x *PropertyFacets) GetSaleTypes() []*FacetBucket {
 	if x != nil {
-		return x.Category
+		return x.SaleTypes
 	}
 	return nil
 }
 
-func (x *UpdatePropertyRequest) GetAddress() *Address {
+func (x *PropertyFacets) GetCities() []*FacetBucket {
 	if x != nil {
-		return x.Address
+		return x.Cities
 	}
 	return nil
 }
 
-func (x *UpdatePropertyRequest) GetSaleType() uint32 {
+func (x *PropertyFacets) GetStatuses() []*FacetBucket {
 	if x != nil {
-		return x.SaleType
+		return x.Statuses
 	}
-	return 0
+	return nil
 }
 
-type UpdatePropertyResponse struct {
+type GetPropertyFacetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filter        *PropertyFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"` // The filter the facets were counted for.
+	Facets        *PropertyFacets        `protobuf:"bytes,2,opt,name=facets,proto3" json:"facets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePropertyResponse) Reset() {
-	*x = UpdatePropertyResponse{}
-	mi := &file_property_service_proto_msgTypes[6]
+func (x *GetPropertyFacetsResponse) Reset() {
+	*x = GetPropertyFacetsResponse{}
+	mi := &file_property_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePropertyResponse) String() string {
+func (x *GetPropertyFacetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePropertyResponse) ProtoMessage() {}
+func (*GetPropertyFacetsResponse) ProtoMessage() {}
 
-func (x *UpdatePropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[6]
+func (x *GetPropertyFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,41 +3476,48 @@ func (x *UpdatePropertyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePropertyResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetPropertyFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPropertyFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdatePropertyResponse) GetId() string {
+func (x *GetPropertyFacetsResponse) GetFilter() *PropertyFilter {
 	if x != nil {
-		return x.Id
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-// Request and Response messages for the Delete operation.
-type DeletePropertyRequest struct {
+func (x *GetPropertyFacetsResponse) GetFacets() *PropertyFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type SuggestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"` // Start of a word of the suggested values, case insensitive.
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Maximum number of suggestions per field.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePropertyRequest) Reset() {
-	*x = DeletePropertyRequest{}
-	mi := &file_property_service_proto_msgTypes[7]
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_property_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePropertyRequest) String() string {
+func (x *SuggestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePropertyRequest) ProtoMessage() {}
+func (*SuggestRequest) ProtoMessage() {}
 
-func (x *DeletePropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[7]
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,40 +3528,51 @@ func (x *DeletePropertyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePropertyRequest.ProtoReflect.Descriptor instead.
-func (*DeletePropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DeletePropertyRequest) GetId() string {
+func (x *SuggestRequest) GetPrefix() string {
 	if x != nil {
-		return x.Id
+		return x.Prefix
 	}
 	return ""
 }
 
-type DeletePropertyResponse struct {
+func (x *SuggestRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SuggestResponse holds the distinct values starting with the prefix, the most common first.
+type SuggestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cities        []string               `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	Counties      []string               `protobuf:"bytes,2,rep,name=counties,proto3" json:"counties,omitempty"`
+	Postcodes     []string               `protobuf:"bytes,3,rep,name=postcodes,proto3" json:"postcodes,omitempty"`
+	Titles        []string               `protobuf:"bytes,4,rep,name=titles,proto3" json:"titles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePropertyResponse) Reset() {
-	*x = DeletePropertyResponse{}
-	mi := &file_property_service_proto_msgTypes[8]
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	mi := &file_property_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePropertyResponse) String() string {
+func (x *SuggestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePropertyResponse) ProtoMessage() {}
+func (*SuggestResponse) ProtoMessage() {}
 
-func (x *DeletePropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[8]
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,44 +3583,63 @@ func (x *DeletePropertyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePropertyResponse.ProtoReflect.Descriptor instead.
-func (*DeletePropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DeletePropertyResponse) GetId() string {
+func (x *SuggestResponse) GetCities() []string {
 	if x != nil {
-		return x.Id
+		return x.Cities
 	}
-	return ""
+	return nil
 }
 
-type PropertyListByCategoryRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Category        string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`               // The category to filter properties.
-	Sort            uint32                 `protobuf:"varint,2,opt,name=sort,proto3" json:"sort,omitempty"`                      // Sort flag/direction.
-	Search          uint32                 `protobuf:"varint,3,opt,name=search,proto3" json:"search,omitempty"`                  // 0 = no token, 1 = searchAfter, 2 = searchBefore.
-	Limit           uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                    // Maximum number of properties to return.
-	PaginationToken string                 `protobuf:"bytes,5,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"` // Pagination token from previous request (optional).
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SuggestResponse) GetCounties() []string {
+	if x != nil {
+		return x.Counties
+	}
+	return nil
 }
 
-func (x *PropertyListByCategoryRequest) Reset() {
-	*x = PropertyListByCategoryRequest{}
-	mi := &file_property_service_proto_msgTypes[9]
+func (x *SuggestResponse) GetPostcodes() []string {
+	if x != nil {
+		return x.Postcodes
+	}
+	return nil
+}
+
+func (x *SuggestResponse) GetTitles() []string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+// ReverseGeocodeRequest holds a location, e.g. of a device, to find the address of.
+type ReverseGeocodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseGeocodeRequest) Reset() {
+	*x = ReverseGeocodeRequest{}
+	mi := &file_property_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyListByCategoryRequest) String() string {
+func (x *ReverseGeocodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertyListByCategoryRequest) ProtoMessage() {}
+func (*ReverseGeocodeRequest) ProtoMessage() {}
 
-func (x *PropertyListByCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[9]
+func (x *ReverseGeocodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,72 +3650,50 @@ func (x *PropertyListByCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertyListByCategoryRequest.ProtoReflect.Descriptor instead.
-func (*PropertyListByCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *PropertyListByCategoryRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *PropertyListByCategoryRequest) GetSort() uint32 {
-	if x != nil {
-		return x.Sort
-	}
-	return 0
+// Deprecated: Use ReverseGeocodeRequest.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *PropertyListByCategoryRequest) GetSearch() uint32 {
+func (x *ReverseGeocodeRequest) GetLatitude() float64 {
 	if x != nil {
-		return x.Search
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *PropertyListByCategoryRequest) GetLimit() uint32 {
+func (x *ReverseGeocodeRequest) GetLongitude() float64 {
 	if x != nil {
-		return x.Limit
+		return x.Longitude
 	}
 	return 0
 }
 
-func (x *PropertyListByCategoryRequest) GetPaginationToken() string {
-	if x != nil {
-		return x.PaginationToken
-	}
-	return ""
-}
-
-type PropertyListByOwnerRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OwnerID         string                 `protobuf:"bytes,1,opt,name=ownerID,proto3" json:"ownerID,omitempty"`                 // The ownerID to filter properties.
-	Sort            uint32                 `protobuf:"varint,2,opt,name=sort,proto3" json:"sort,omitempty"`                      // Sort flag/direction.
-	Search          uint32                 `protobuf:"varint,3,opt,name=search,proto3" json:"search,omitempty"`                  // 0 = no token, 1 = searchAfter, 2 = searchBefore.
-	Limit           uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                    // Maximum number of properties to return.
-	PaginationToken string                 `protobuf:"bytes,5,opt,name=paginationToken,proto3" json:"paginationToken,omitempty"` // Pagination token from previous request (optional).
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// ImportAreasRequest holds named areas, e.g. neighbourhoods, to tag the properties inside them with.
+type ImportAreasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geojson       string                 `protobuf:"bytes,1,opt,name=geojson,proto3" json:"geojson,omitempty"`                               // FeatureCollection of Polygon and MultiPolygon features.
+	NameProperty  string                 `protobuf:"bytes,2,opt,name=name_property,json=nameProperty,proto3" json:"name_property,omitempty"` // Feature property holding the area name, "name" when empty.
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                                     // Kind of the areas, e.g. "neighbourhood" (optional).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PropertyListByOwnerRequest) Reset() {
-	*x = PropertyListByOwnerRequest{}
-	mi := &file_property_service_proto_msgTypes[10]
+func (x *ImportAreasRequest) Reset() {
+	*x = ImportAreasRequest{}
+	mi := &file_property_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyListByOwnerRequest) String() string {
+func (x *ImportAreasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertyListByOwnerRequest) ProtoMessage() {}
+func (*ImportAreasRequest) ProtoMessage() {}
 
-func (x *PropertyListByOwnerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[10]
+func (x *ImportAreasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,56 +3704,90 @@ func (x *PropertyListByOwnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertyListByOwnerRequest.ProtoReflect.Descriptor instead.
-func (*PropertyListByOwnerRequest) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportAreasRequest.ProtoReflect.Descriptor instead.
+func (*ImportAreasRequest) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *PropertyListByOwnerRequest) GetOwnerID() string {
+func (x *ImportAreasRequest) GetGeojson() string {
 	if x != nil {
-		return x.OwnerID
+		return x.Geojson
 	}
 	return ""
 }
 
-func (x *PropertyListByOwnerRequest) GetSort() uint32 {
+func (x *ImportAreasRequest) GetNameProperty() string {
 	if x != nil {
-		return x.Sort
+		return x.NameProperty
 	}
-	return 0
+	return ""
 }
 
-func (x *PropertyListByOwnerRequest) GetSearch() uint32 {
+func (x *ImportAreasRequest) GetKind() string {
 	if x != nil {
-		return x.Search
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *PropertyListByOwnerRequest) GetLimit() uint32 {
+type ImportAreasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // Keys of the imported areas, the value of the area filters.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAreasResponse) Reset() {
+	*x = ImportAreasResponse{}
+	mi := &file_property_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAreasResponse) ProtoMessage() {}
+
+func (x *ImportAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_service_proto_msgTypes[52]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PropertyListByOwnerRequest) GetPaginationToken() string {
+// Deprecated: Use ImportAreasResponse.ProtoReflect.Descriptor instead.
+func (*ImportAreasResponse) Descriptor() ([]byte, []int) {
+	return file_property_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportAreasResponse) GetKeys() []string {
 	if x != nil {
-		return x.PaginationToken
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
 type ListPropertyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Properties    []*Property            `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Token of the next page, empty on the last page.
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // Token of the previous page, empty on the first page.
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                    // More properties follow in the direction the page was read.
+	TotalCount    *int64                 `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // Number of matching properties, when requested.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPropertyResponse) Reset() {
 	*x = ListPropertyResponse{}
-	mi := &file_property_service_proto_msgTypes[11]
+	mi := &file_property_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +3799,7 @@ func (x *ListPropertyResponse) String() string {
 func (*ListPropertyResponse) ProtoMessage() {}
 
 func (x *ListPropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_service_proto_msgTypes[11]
+	mi := &file_property_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +3812,7 @@ func (x *ListPropertyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertyResponse.ProtoReflect.Descriptor instead.
 func (*ListPropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_service_proto_rawDescGZIP(), []int{11}
+	return file_property_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ListPropertyResponse) GetProperties() []*Property {
@@ -868,25 +3822,134 @@ func (x *ListPropertyResponse) GetProperties() []*Property {
 	return nil
 }
 
+func (x *ListPropertyResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPropertyResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListPropertyResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListPropertyResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 var File_property_service_proto protoreflect.FileDescriptor
 
 const file_property_service_proto_rawDesc = "" +
 	"\n" +
-	"\x16property_service.proto\x12\rmygrpcservice\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/api/annotations.proto\"\x90\x03\n" +
+	"\x16property_service.proto\x12\rmygrpcservice\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/api/annotations.proto\"\xaf\a\n" +
 	"\bProperty\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
 	"\aownerID\x18\x04 \x01(\tR\aownerID\x12\x14\n" +
-	"\x05title\x18\x05 \x01(\tR\x05title\x128\n" +
-	"\tavailable\x18\x06 \x01(\v2\x1a.google.protobuf.BoolValueR\tavailable\x12A\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12A\n" +
 	"\x0eavailable_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ravailableDate\x125\n" +
 	"\aaddress\x18\b \x01(\v2\x16.mygrpcservice.AddressH\x00R\aaddress\x88\x01\x01\x12\x1b\n" +
-	"\tsale_type\x18\t \x01(\rR\bsaleType\x12)\n" +
-	"\x10pagination_token\x18\n" +
-	" \x01(\tR\x0fpaginationTokenB\n" +
+	"\tsale_type\x18\t \x01(\rR\bsaleType\x12\x1f\n" +
+	"\bdistance\x18\v \x01(\x01H\x01R\bdistance\x88\x01\x01\x128\n" +
+	"\n" +
+	"highlights\x18\f \x03(\v2\x18.mygrpcservice.HighlightR\n" +
+	"highlights\x12.\n" +
+	"\x10similarity_score\x18\r \x01(\x01H\x02R\x0fsimilarityScore\x88\x01\x01\x12\x14\n" +
+	"\x05areas\x18\x0e \x03(\tR\x05areas\x12;\n" +
+	"\x06nearby\x18\x0f \x03(\v2#.mygrpcservice.Property.NearbyEntryR\x06nearby\x12,\n" +
+	"\x04rent\x18\x10 \x01(\v2\x13.mygrpcservice.RentH\x03R\x04rent\x88\x01\x01\x12<\n" +
+	"\fasking_price\x18\x11 \x01(\v2\x14.mygrpcservice.MoneyH\x04R\vaskingPrice\x88\x01\x01\x12$\n" +
+	"\vtravel_time\x18\x12 \x01(\rH\x05R\n" +
+	"travelTime\x88\x01\x01\x12>\n" +
+	"\n" +
+	"attributes\x18\x13 \x01(\v2\x19.mygrpcservice.AttributesH\x06R\n" +
+	"attributes\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x14 \x01(\rR\x06status\x1aS\n" +
+	"\vNearbyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.mygrpcservice.NearbyPOIR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_addressB\v\n" +
+	"\t_distanceB\x13\n" +
+	"\x11_similarity_scoreB\a\n" +
+	"\x05_rentB\x0f\n" +
+	"\r_asking_priceB\x0e\n" +
+	"\f_travel_timeB\r\n" +
+	"\v_attributesJ\x04\b\x06\x10\aJ\x04\b\n" +
+	"\x10\v\"\xe1\x02\n" +
+	"\n" +
+	"Attributes\x12\x1a\n" +
+	"\bbedrooms\x18\x01 \x01(\rR\bbedrooms\x12\x1c\n" +
+	"\tbathrooms\x18\x02 \x01(\rR\tbathrooms\x12'\n" +
+	"\x0freception_rooms\x18\x03 \x01(\rR\x0ereceptionRooms\x127\n" +
+	"\n" +
+	"floor_area\x18\x04 \x01(\v2\x18.mygrpcservice.FloorAreaR\tfloorArea\x12\x1e\n" +
 	"\n" +
-	"\b_address\"\x81\x02\n" +
+	"furnishing\x18\x05 \x01(\rR\n" +
+	"furnishing\x12\x18\n" +
+	"\aparking\x18\x06 \x01(\rR\aparking\x12\x16\n" +
+	"\x06garden\x18\a \x01(\bR\x06garden\x12!\n" +
+	"\fpets_allowed\x18\b \x01(\bR\vpetsAllowed\x12$\n" +
+	"\raccessibility\x18\t \x03(\tR\raccessibility\x12\x1c\n" +
+	"\tamenities\x18\n" +
+	" \x03(\tR\tamenities\"X\n" +
+	"\tFloorArea\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x01R\x04size\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\rR\x04unit\x12#\n" +
+	"\rsquare_metres\x18\x03 \x01(\x01R\fsquareMetres\"\xe7\x03\n" +
+	"\x0fAttributeFilter\x12!\n" +
+	"\fmin_bedrooms\x18\x01 \x01(\rR\vminBedrooms\x12&\n" +
+	"\fmax_bedrooms\x18\x02 \x01(\rH\x00R\vmaxBedrooms\x88\x01\x01\x12#\n" +
+	"\rmin_bathrooms\x18\x03 \x01(\rR\fminBathrooms\x12$\n" +
+	"\x0emin_floor_area\x18\x04 \x01(\x01R\fminFloorArea\x12)\n" +
+	"\x0emax_floor_area\x18\x05 \x01(\x01H\x01R\fmaxFloorArea\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"furnishing\x18\x06 \x01(\rR\n" +
+	"furnishing\x12\x18\n" +
+	"\aparking\x18\a \x01(\bR\aparking\x122\n" +
+	"\x06garden\x18\b \x01(\v2\x1a.google.protobuf.BoolValueR\x06garden\x12=\n" +
+	"\fpets_allowed\x18\t \x01(\v2\x1a.google.protobuf.BoolValueR\vpetsAllowed\x12$\n" +
+	"\raccessibility\x18\n" +
+	" \x03(\tR\raccessibility\x12\x1c\n" +
+	"\tamenities\x18\v \x03(\tR\tamenitiesB\x0f\n" +
+	"\r_max_bedroomsB\x11\n" +
+	"\x0f_max_floor_area\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"q\n" +
+	"\x04Rent\x12*\n" +
+	"\x05price\x18\x01 \x01(\v2\x14.mygrpcservice.MoneyR\x05price\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\rR\x06period\x12%\n" +
+	"\x0emonthly_amount\x18\x03 \x01(\x03R\rmonthlyAmount\"L\n" +
+	"\n" +
+	"PriceRange\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x03R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x03R\x03max\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"r\n" +
+	"\tNearbyPOI\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x01R\bdistance\x125\n" +
+	"\blocation\x18\x03 \x01(\v2\x19.mygrpcservice.CoordinateR\blocation\"S\n" +
+	"\tHighlight\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x122\n" +
+	"\x05texts\x18\x02 \x03(\v2\x1c.mygrpcservice.HighlightTextR\x05texts\"7\n" +
+	"\rHighlightText\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x10\n" +
+	"\x03hit\x18\x02 \x01(\bR\x03hit\"\x81\x02\n" +
 	"\aAddress\x12\x1d\n" +
 	"\n" +
 	"first_line\x18\x01 \x01(\tR\tfirstLine\x12\x16\n" +
@@ -899,59 +3962,273 @@ const file_property_service_proto_rawDesc = "" +
 	"\tlongitude\x18\b \x01(\x02H\x01R\tlongitude\x88\x01\x01B\v\n" +
 	"\t_latitudeB\f\n" +
 	"\n" +
-	"_longitude\"\xc5\x02\n" +
+	"_longitude\"\xca\x03\n" +
 	"\x15CreatePropertyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
 	"\aownerID\x18\x04 \x01(\tR\aownerID\x12\x14\n" +
-	"\x05title\x18\x05 \x01(\tR\x05title\x12\x1c\n" +
-	"\tavailable\x18\x06 \x01(\bR\tavailable\x12A\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12A\n" +
 	"\x0eavailable_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ravailableDate\x120\n" +
 	"\aaddress\x18\b \x01(\v2\x16.mygrpcservice.AddressR\aaddress\x12\x1b\n" +
-	"\tsale_type\x18\t \x01(\rR\bsaleType\"(\n" +
+	"\tsale_type\x18\t \x01(\rR\bsaleType\x12'\n" +
+	"\x04rent\x18\n" +
+	" \x01(\v2\x13.mygrpcservice.RentR\x04rent\x127\n" +
+	"\fasking_price\x18\v \x01(\v2\x14.mygrpcservice.MoneyR\vaskingPrice\x129\n" +
+	"\n" +
+	"attributes\x18\f \x01(\v2\x19.mygrpcservice.AttributesR\n" +
+	"attributesJ\x04\b\x06\x10\a\"(\n" +
 	"\x16CreatePropertyResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
 	"\x13ReadPropertyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xc7\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb0\x03\n" +
 	"\x15UpdatePropertyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
-	"\tavailable\x18\x02 \x01(\v2\x1a.google.protobuf.BoolValueR\tavailable\x12A\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12A\n" +
 	"\x0eavailable_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ravailableDate\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12\x1a\n" +
 	"\bcategory\x18\x06 \x03(\tR\bcategory\x120\n" +
 	"\aaddress\x18\a \x01(\v2\x16.mygrpcservice.AddressR\aaddress\x12\x1b\n" +
-	"\tsale_type\x18\b \x01(\rR\bsaleType\"(\n" +
+	"\tsale_type\x18\b \x01(\rR\bsaleType\x12'\n" +
+	"\x04rent\x18\t \x01(\v2\x13.mygrpcservice.RentR\x04rent\x127\n" +
+	"\fasking_price\x18\n" +
+	" \x01(\v2\x14.mygrpcservice.MoneyR\vaskingPrice\x129\n" +
+	"\n" +
+	"attributes\x18\v \x01(\v2\x19.mygrpcservice.AttributesR\n" +
+	"attributesJ\x04\b\x02\x10\x03\"(\n" +
 	"\x16UpdatePropertyResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
 	"\x15DeletePropertyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
 	"\x16DeletePropertyResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xa7\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x1bChangePropertyStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x1cChangePropertyStatusResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x01\n" +
+	"\tDateRange\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\rR\x04kind\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xab\x01\n" +
+	"\x11BlockDatesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\rR\x04kind\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"$\n" +
+	"\x12BlockDatesResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x85\x01\n" +
+	"\x13UnblockDatesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"&\n" +
+	"\x14UnblockDatesResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x88\x01\n" +
+	"\x16GetAvailabilityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"y\n" +
+	"\x17GetAvailabilityResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x120\n" +
+	"\x06ranges\x18\x03 \x03(\v2\x18.mygrpcservice.DateRangeR\x06ranges\"\xd2\x02\n" +
 	"\x1dPropertyListByCategoryRequest\x12\x1a\n" +
-	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
-	"\x04sort\x18\x02 \x01(\rR\x04sort\x12\x16\n" +
-	"\x06search\x18\x03 \x01(\rR\x06search\x12\x14\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\rR\x05limit\x12(\n" +
-	"\x0fpaginationToken\x18\x05 \x01(\tR\x0fpaginationToken\"\xa2\x01\n" +
+	"\x0fpaginationToken\x18\x05 \x01(\tR\x0fpaginationToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCount\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04area\x18\b \x01(\tR\x04area\x127\n" +
+	"\tfree_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bfreeFrom\x123\n" +
+	"\afree_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06freeToJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"\xe9\x02\n" +
 	"\x1aPropertyListByOwnerRequest\x12\x18\n" +
-	"\aownerID\x18\x01 \x01(\tR\aownerID\x12\x12\n" +
-	"\x04sort\x18\x02 \x01(\rR\x04sort\x12\x16\n" +
-	"\x06search\x18\x03 \x01(\rR\x06search\x12\x14\n" +
+	"\aownerID\x18\x01 \x01(\tR\aownerID\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\x12(\n" +
+	"\x0fpaginationToken\x18\x05 \x01(\tR\x0fpaginationToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCount\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04area\x18\b \x01(\tR\x04area\x12\x1a\n" +
+	"\bstatuses\x18\t \x03(\rR\bstatuses\x127\n" +
+	"\tfree_from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bfreeFrom\x123\n" +
+	"\afree_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06freeToJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"\xfc\x02\n" +
+	"\x17PropertyListNearRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1b\n" +
+	"\tsale_type\x18\x05 \x01(\rR\bsaleType\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04area\x18\a \x01(\tR\x04area\x12>\n" +
+	"\n" +
+	"attributes\x18\b \x01(\v2\x1e.mygrpcservice.AttributeFilterR\n" +
+	"attributes\x127\n" +
+	"\tfree_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bfreeFrom\x123\n" +
+	"\afree_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06freeTo\"B\n" +
+	"\x1aPropertyListSimilarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"F\n" +
+	"\n" +
+	"Coordinate\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\x81\x01\n" +
+	"\vBoundingBox\x12:\n" +
+	"\vbottom_left\x18\x01 \x01(\v2\x19.mygrpcservice.CoordinateR\n" +
+	"bottomLeft\x126\n" +
+	"\ttop_right\x18\x02 \x01(\v2\x19.mygrpcservice.CoordinateR\btopRight\"?\n" +
+	"\n" +
+	"LinearRing\x121\n" +
+	"\x06points\x18\x01 \x03(\v2\x19.mygrpcservice.CoordinateR\x06points\":\n" +
+	"\aPolygon\x12/\n" +
+	"\x05rings\x18\x01 \x03(\v2\x19.mygrpcservice.LinearRingR\x05rings\"\x94\x03\n" +
+	"\x1dPropertyListWithinAreaRequest\x12?\n" +
+	"\fbounding_box\x18\x01 \x01(\v2\x1a.mygrpcservice.BoundingBoxH\x00R\vboundingBox\x122\n" +
+	"\apolygon\x18\x02 \x01(\v2\x16.mygrpcservice.PolygonH\x00R\apolygon\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\rR\x04sort\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\x12(\n" +
+	"\x0fpaginationToken\x18\x06 \x01(\tR\x0fpaginationToken\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCount\x127\n" +
+	"\tfree_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bfreeFrom\x123\n" +
+	"\afree_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06freeToB\x06\n" +
+	"\x04areaJ\x04\b\x04\x10\x05\"\xb4\x03\n" +
+	" PropertyListWithinCommuteRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x128\n" +
+	"\tdeparture\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeparture\x12\x1f\n" +
+	"\vmax_minutes\x18\x04 \x01(\rR\n" +
+	"maxMinutes\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1b\n" +
+	"\tsale_type\x18\x06 \x01(\rR\bsaleType\x12\x14\n" +
+	"\x05limit\x18\a \x01(\rR\x05limit\x12>\n" +
+	"\n" +
+	"attributes\x18\b \x01(\v2\x1e.mygrpcservice.AttributeFilterR\n" +
+	"attributes\x127\n" +
+	"\tfree_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bfreeFrom\x123\n" +
+	"\afree_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06freeTo\"\x8b\x01\n" +
+	"\x18ClusterPropertiesRequest\x12=\n" +
+	"\fbounding_box\x18\x01 \x01(\v2\x1a.mygrpcservice.BoundingBoxR\vboundingBox\x12\x12\n" +
+	"\x04zoom\x18\x02 \x01(\rR\x04zoom\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\rR\tthreshold\"\x8c\x01\n" +
+	"\x0fPropertyCluster\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x125\n" +
+	"\bcentroid\x18\x02 \x01(\v2\x19.mygrpcservice.CoordinateR\bcentroid\x12,\n" +
+	"\x12sample_property_id\x18\x03 \x01(\tR\x10samplePropertyId\"\x90\x01\n" +
+	"\x19ClusterPropertiesResponse\x12:\n" +
+	"\bclusters\x18\x01 \x03(\v2\x1e.mygrpcservice.PropertyClusterR\bclusters\x127\n" +
+	"\n" +
+	"properties\x18\x02 \x03(\v2\x17.mygrpcservice.PropertyR\n" +
+	"properties\"\xb0\x05\n" +
+	"\x0ePropertyFilter\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\tR\n" +
+	"categories\x12\x1b\n" +
+	"\tsale_type\x18\x02 \x01(\rR\bsaleType\x12\x1a\n" +
+	"\bstatuses\x18\x0e \x03(\rR\bstatuses\x12A\n" +
+	"\x0eavailable_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ravailableFrom\x12=\n" +
+	"\favailable_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vavailableTo\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12'\n" +
+	"\x0fpostcode_prefix\x18\a \x01(\tR\x0epostcodePrefix\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\x12\x12\n" +
+	"\x04area\x18\t \x01(\tR\x04area\x127\n" +
+	"\tnear_pois\x18\n" +
+	" \x03(\v2\x1a.mygrpcservice.POIDistanceR\bnearPois\x12-\n" +
+	"\x04rent\x18\v \x01(\v2\x19.mygrpcservice.PriceRangeR\x04rent\x12<\n" +
+	"\fasking_price\x18\f \x01(\v2\x19.mygrpcservice.PriceRangeR\vaskingPrice\x12>\n" +
+	"\n" +
+	"attributes\x18\r \x01(\v2\x1e.mygrpcservice.AttributeFilterR\n" +
+	"attributes\x127\n" +
+	"\tfree_from\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\bfreeFrom\x123\n" +
+	"\afree_to\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x06freeToJ\x04\b\x03\x10\x04\"9\n" +
+	"\vPOIDistance\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06within\x18\x02 \x01(\x01R\x06within\"\xda\x01\n" +
+	"\x17SearchPropertiesRequest\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.mygrpcservice.PropertyFilterR\x06filter\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\rR\x04sort\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\rR\x05limit\x12(\n" +
-	"\x0fpaginationToken\x18\x05 \x01(\tR\x0fpaginationToken\"O\n" +
+	"\x0fpaginationToken\x18\x05 \x01(\tR\x0fpaginationToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCountJ\x04\b\x03\x10\x04\"\xbe\x01\n" +
+	"\x1dSearchPropertiesByTextRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x17\n" +
+	"\asort_by\x18\x02 \x01(\tR\x06sortBy\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12(\n" +
+	"\x0fpaginationToken\x18\x04 \x01(\tR\x0fpaginationToken\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\"Q\n" +
+	"\x18GetPropertyFacetsRequest\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.mygrpcservice.PropertyFilterR\x06filter\"9\n" +
+	"\vFacetBucket\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xf9\x01\n" +
+	"\x0ePropertyFacets\x12:\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1a.mygrpcservice.FacetBucketR\n" +
+	"categories\x129\n" +
+	"\n" +
+	"sale_types\x18\x02 \x03(\v2\x1a.mygrpcservice.FacetBucketR\tsaleTypes\x122\n" +
+	"\x06cities\x18\x03 \x03(\v2\x1a.mygrpcservice.FacetBucketR\x06cities\x126\n" +
+	"\bstatuses\x18\x05 \x03(\v2\x1a.mygrpcservice.FacetBucketR\bstatusesJ\x04\b\x04\x10\x05\"\x89\x01\n" +
+	"\x19GetPropertyFacetsResponse\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.mygrpcservice.PropertyFilterR\x06filter\x125\n" +
+	"\x06facets\x18\x02 \x01(\v2\x1d.mygrpcservice.PropertyFacetsR\x06facets\">\n" +
+	"\x0eSuggestRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"{\n" +
+	"\x0fSuggestResponse\x12\x16\n" +
+	"\x06cities\x18\x01 \x03(\tR\x06cities\x12\x1a\n" +
+	"\bcounties\x18\x02 \x03(\tR\bcounties\x12\x1c\n" +
+	"\tpostcodes\x18\x03 \x03(\tR\tpostcodes\x12\x16\n" +
+	"\x06titles\x18\x04 \x03(\tR\x06titles\"Q\n" +
+	"\x15ReverseGeocodeRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"g\n" +
+	"\x12ImportAreasRequest\x12\x18\n" +
+	"\ageojson\x18\x01 \x01(\tR\ageojson\x12#\n" +
+	"\rname_property\x18\x02 \x01(\tR\fnameProperty\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\")\n" +
+	"\x13ImportAreasResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"\xf0\x01\n" +
 	"\x14ListPropertyResponse\x127\n" +
 	"\n" +
 	"properties\x18\x01 \x03(\v2\x17.mygrpcservice.PropertyR\n" +
-	"properties2\xf4\x05\n" +
+	"properties\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12$\n" +
+	"\vtotal_count\x18\x05 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count2\xc4\x1b\n" +
 	"\x0fPropertyService\x12f\n" +
 	"\fReadProperty\x12\".mygrpcservice.ReadPropertyRequest\x1a\x17.mygrpcservice.Property\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/property/{id}\x12v\n" +
 	"\x0eCreateProperty\x12$.mygrpcservice.CreatePropertyRequest\x1a%.mygrpcservice.CreatePropertyResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/property\x12{\n" +
 	"\x0eUpdateProperty\x12$.mygrpcservice.UpdatePropertyRequest\x1a%.mygrpcservice.UpdatePropertyResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/property/{id}\x12x\n" +
-	"\x0eDeleteProperty\x12$.mygrpcservice.DeletePropertyRequest\x1a%.mygrpcservice.DeletePropertyResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/property/{id}\x12\x81\x01\n" +
+	"\x0eDeleteProperty\x12$.mygrpcservice.DeletePropertyRequest\x1a%.mygrpcservice.DeletePropertyResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/property/{id}\x12\x8d\x01\n" +
+	"\x0fPublishProperty\x12*.mygrpcservice.ChangePropertyStatusRequest\x1a+.mygrpcservice.ChangePropertyStatusResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x19/v1/property/{id}:publish\x12\x9b\x01\n" +
+	"\x16MarkPropertyUnderOffer\x12*.mygrpcservice.ChangePropertyStatusRequest\x1a+.mygrpcservice.ChangePropertyStatusResponse\"(\x82\xd3\xe4\x93\x02\"\" /v1/property/{id}:markUnderOffer\x12\x8d\x01\n" +
+	"\x0fMarkPropertyLet\x12*.mygrpcservice.ChangePropertyStatusRequest\x1a+.mygrpcservice.ChangePropertyStatusResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x19/v1/property/{id}:markLet\x12\x8f\x01\n" +
+	"\x10MarkPropertySold\x12*.mygrpcservice.ChangePropertyStatusRequest\x1a+.mygrpcservice.ChangePropertyStatusResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/property/{id}:markSold\x12\x8f\x01\n" +
+	"\x10WithdrawProperty\x12*.mygrpcservice.ChangePropertyStatusRequest\x1a+.mygrpcservice.ChangePropertyStatusResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/property/{id}:withdraw\x12\x8d\x01\n" +
+	"\x0fArchiveProperty\x12*.mygrpcservice.ChangePropertyStatusRequest\x1a+.mygrpcservice.ChangePropertyStatusResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x19/v1/property/{id}:archive\x12~\n" +
+	"\n" +
+	"BlockDates\x12 .mygrpcservice.BlockDatesRequest\x1a!.mygrpcservice.BlockDatesResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/property/{id}/calendar:block\x12\x86\x01\n" +
+	"\fUnblockDates\x12\".mygrpcservice.UnblockDatesRequest\x1a#.mygrpcservice.UnblockDatesResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/property/{id}/calendar:unblock\x12\x84\x01\n" +
+	"\x0fGetAvailability\x12%.mygrpcservice.GetAvailabilityRequest\x1a&.mygrpcservice.GetAvailabilityResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/property/{id}/calendar\x12\x81\x01\n" +
 	"\x16ListPropertyByCategory\x12,.mygrpcservice.PropertyListByCategoryRequest\x1a#.mygrpcservice.ListPropertyResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/property\x12\x85\x01\n" +
-	"\x13ListPropertyByOwner\x12).mygrpcservice.PropertyListByOwnerRequest\x1a#.mygrpcservice.ListPropertyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/property/{ownerID}B\"Z property-service/api/proto;protob\x06proto3"
+	"\x13ListPropertyByOwner\x12).mygrpcservice.PropertyListByOwnerRequest\x1a#.mygrpcservice.ListPropertyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/property/{ownerID}\x12\x83\x01\n" +
+	"\x12ListPropertiesNear\x12&.mygrpcservice.PropertyListNearRequest\x1a#.mygrpcservice.ListPropertyResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/property/search/near\x12\x8a\x01\n" +
+	"\x15ListSimilarProperties\x12).mygrpcservice.PropertyListSimilarRequest\x1a#.mygrpcservice.ListPropertyResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/property/{id}/similar\x12\x92\x01\n" +
+	"\x18ListPropertiesWithinArea\x12,.mygrpcservice.PropertyListWithinAreaRequest\x1a#.mygrpcservice.ListPropertyResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/property/search/area\x12\x9b\x01\n" +
+	"\x1bListPropertiesWithinCommute\x12/.mygrpcservice.PropertyListWithinCommuteRequest\x1a#.mygrpcservice.ListPropertyResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/property/search/commute\x12\x8f\x01\n" +
+	"\x11ClusterProperties\x12'.mygrpcservice.ClusterPropertiesRequest\x1a(.mygrpcservice.ClusterPropertiesResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/property/search/clusters\x12\x7f\n" +
+	"\x10SearchProperties\x12&.mygrpcservice.SearchPropertiesRequest\x1a#.mygrpcservice.ListPropertyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/property/search\x12\x8d\x01\n" +
+	"\x16SearchPropertiesByText\x12,.mygrpcservice.SearchPropertiesByTextRequest\x1a#.mygrpcservice.ListPropertyResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/property/search/text\x12\x8d\x01\n" +
+	"\x11GetPropertyFacets\x12'.mygrpcservice.GetPropertyFacetsRequest\x1a(.mygrpcservice.GetPropertyFacetsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/property/search/facets\x12m\n" +
+	"\aSuggest\x12\x1d.mygrpcservice.SuggestRequest\x1a\x1e.mygrpcservice.SuggestResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/property/search/suggest\x12k\n" +
+	"\x0eReverseGeocode\x12$.mygrpcservice.ReverseGeocodeRequest\x1a\x16.mygrpcservice.Address\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/address/reverse\x12w\n" +
+	"\vImportAreas\x12!.mygrpcservice.ImportAreasRequest\x1a\".mygrpcservice.ImportAreasResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/admin/areas:importB\"Z property-service/api/proto;protob\x06proto3"
 
 var (
 	file_property_service_proto_rawDescOnce sync.Once
@@ -965,50 +4242,197 @@ func file_property_service_proto_rawDescGZIP() []byte {
 	return file_property_service_proto_rawDescData
 }
 
-var file_property_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_property_service_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_property_service_proto_goTypes = []any{
-	(*Property)(nil),                      // 0: mygrpcservice.Property
-	(*Address)(nil),                       // 1: mygrpcservice.Address
-	(*CreatePropertyRequest)(nil),         // 2: mygrpcservice.CreatePropertyRequest
-	(*CreatePropertyResponse)(nil),        // 3: mygrpcservice.CreatePropertyResponse
-	(*ReadPropertyRequest)(nil),           // 4: mygrpcservice.ReadPropertyRequest
-	(*UpdatePropertyRequest)(nil),         // 5: mygrpcservice.UpdatePropertyRequest
-	(*UpdatePropertyResponse)(nil),        // 6: mygrpcservice.UpdatePropertyResponse
-	(*DeletePropertyRequest)(nil),         // 7: mygrpcservice.DeletePropertyRequest
-	(*DeletePropertyResponse)(nil),        // 8: mygrpcservice.DeletePropertyResponse
-	(*PropertyListByCategoryRequest)(nil), // 9: mygrpcservice.PropertyListByCategoryRequest
-	(*PropertyListByOwnerRequest)(nil),    // 10: mygrpcservice.PropertyListByOwnerRequest
-	(*ListPropertyResponse)(nil),          // 11: mygrpcservice.ListPropertyResponse
-	(*wrapperspb.BoolValue)(nil),          // 12: google.protobuf.BoolValue
-	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
+	(*Property)(nil),                         // 0: mygrpcservice.Property
+	(*Attributes)(nil),                       // 1: mygrpcservice.Attributes
+	(*FloorArea)(nil),                        // 2: mygrpcservice.FloorArea
+	(*AttributeFilter)(nil),                  // 3: mygrpcservice.AttributeFilter
+	(*Money)(nil),                            // 4: mygrpcservice.Money
+	(*Rent)(nil),                             // 5: mygrpcservice.Rent
+	(*PriceRange)(nil),                       // 6: mygrpcservice.PriceRange
+	(*NearbyPOI)(nil),                        // 7: mygrpcservice.NearbyPOI
+	(*Highlight)(nil),                        // 8: mygrpcservice.Highlight
+	(*HighlightText)(nil),                    // 9: mygrpcservice.HighlightText
+	(*Address)(nil),                          // 10: mygrpcservice.Address
+	(*CreatePropertyRequest)(nil),            // 11: mygrpcservice.CreatePropertyRequest
+	(*CreatePropertyResponse)(nil),           // 12: mygrpcservice.CreatePropertyResponse
+	(*ReadPropertyRequest)(nil),              // 13: mygrpcservice.ReadPropertyRequest
+	(*UpdatePropertyRequest)(nil),            // 14: mygrpcservice.UpdatePropertyRequest
+	(*UpdatePropertyResponse)(nil),           // 15: mygrpcservice.UpdatePropertyResponse
+	(*DeletePropertyRequest)(nil),            // 16: mygrpcservice.DeletePropertyRequest
+	(*DeletePropertyResponse)(nil),           // 17: mygrpcservice.DeletePropertyResponse
+	(*ChangePropertyStatusRequest)(nil),      // 18: mygrpcservice.ChangePropertyStatusRequest
+	(*ChangePropertyStatusResponse)(nil),     // 19: mygrpcservice.ChangePropertyStatusResponse
+	(*DateRange)(nil),                        // 20: mygrpcservice.DateRange
+	(*BlockDatesRequest)(nil),                // 21: mygrpcservice.BlockDatesRequest
+	(*BlockDatesResponse)(nil),               // 22: mygrpcservice.BlockDatesResponse
+	(*UnblockDatesRequest)(nil),              // 23: mygrpcservice.UnblockDatesRequest
+	(*UnblockDatesResponse)(nil),             // 24: mygrpcservice.UnblockDatesResponse
+	(*GetAvailabilityRequest)(nil),           // 25: mygrpcservice.GetAvailabilityRequest
+	(*GetAvailabilityResponse)(nil),          // 26: mygrpcservice.GetAvailabilityResponse
+	(*PropertyListByCategoryRequest)(nil),    // 27: mygrpcservice.PropertyListByCategoryRequest
+	(*PropertyListByOwnerRequest)(nil),       // 28: mygrpcservice.PropertyListByOwnerRequest
+	(*PropertyListNearRequest)(nil),          // 29: mygrpcservice.PropertyListNearRequest
+	(*PropertyListSimilarRequest)(nil),       // 30: mygrpcservice.PropertyListSimilarRequest
+	(*Coordinate)(nil),                       // 31: mygrpcservice.Coordinate
+	(*BoundingBox)(nil),                      // 32: mygrpcservice.BoundingBox
+	(*LinearRing)(nil),                       // 33: mygrpcservice.LinearRing
+	(*Polygon)(nil),                          // 34: mygrpcservice.Polygon
+	(*PropertyListWithinAreaRequest)(nil),    // 35: mygrpcservice.PropertyListWithinAreaRequest
+	(*PropertyListWithinCommuteRequest)(nil), // 36: mygrpcservice.PropertyListWithinCommuteRequest
+	(*ClusterPropertiesRequest)(nil),         // 37: mygrpcservice.ClusterPropertiesRequest
+	(*PropertyCluster)(nil),                  // 38: mygrpcservice.PropertyCluster
+	(*ClusterPropertiesResponse)(nil),        // 39: mygrpcservice.ClusterPropertiesResponse
+	(*PropertyFilter)(nil),                   // 40: mygrpcservice.PropertyFilter
+	(*POIDistance)(nil),                      // 41: mygrpcservice.POIDistance
+	(*SearchPropertiesRequest)(nil),          // 42: mygrpcservice.SearchPropertiesRequest
+	(*SearchPropertiesByTextRequest)(nil),    // 43: mygrpcservice.SearchPropertiesByTextRequest
+	(*GetPropertyFacetsRequest)(nil),         // 44: mygrpcservice.GetPropertyFacetsRequest
+	(*FacetBucket)(nil),                      // 45: mygrpcservice.FacetBucket
+	(*PropertyFacets)(nil),                   // 46: mygrpcservice.PropertyFacets
+	(*GetPropertyFacetsResponse)(nil),        // 47: mygrpcservice.GetPropertyFacetsResponse
+	(*SuggestRequest)(nil),                   // 48: mygrpcservice.SuggestRequest
+	(*SuggestResponse)(nil),                  // 49: mygrpcservice.SuggestResponse
+	(*ReverseGeocodeRequest)(nil),            // 50: mygrpcservice.ReverseGeocodeRequest
+	(*ImportAreasRequest)(nil),               // 51: mygrpcservice.ImportAreasRequest
+	(*ImportAreasResponse)(nil),              // 52: mygrpcservice.ImportAreasResponse
+	(*ListPropertyResponse)(nil),             // 53: mygrpcservice.ListPropertyResponse
+	nil,                                      // 54: mygrpcservice.Property.NearbyEntry
+	(*timestamppb.Timestamp)(nil),            // 55: google.protobuf.Timestamp
+	(*wrapperspb.BoolValue)(nil),             // 56: google.protobuf.BoolValue
 }
 var file_property_service_proto_depIdxs = []int32{
-	12, // 0: mygrpcservice.Property.available:type_name -> google.protobuf.BoolValue
-	13, // 1: mygrpcservice.Property.available_date:type_name -> google.protobuf.Timestamp
-	1,  // 2: mygrpcservice.Property.address:type_name -> mygrpcservice.Address
-	13, // 3: mygrpcservice.CreatePropertyRequest.available_date:type_name -> google.protobuf.Timestamp
-	1,  // 4: mygrpcservice.CreatePropertyRequest.address:type_name -> mygrpcservice.Address
-	12, // 5: mygrpcservice.UpdatePropertyRequest.available:type_name -> google.protobuf.BoolValue
-	13, // 6: mygrpcservice.UpdatePropertyRequest.available_date:type_name -> google.protobuf.Timestamp
-	1,  // 7: mygrpcservice.UpdatePropertyRequest.address:type_name -> mygrpcservice.Address
-	0,  // 8: mygrpcservice.ListPropertyResponse.properties:type_name -> mygrpcservice.Property
-	4,  // 9: mygrpcservice.PropertyService.ReadProperty:input_type -> mygrpcservice.ReadPropertyRequest
-	2,  // 10: mygrpcservice.PropertyService.CreateProperty:input_type -> mygrpcservice.CreatePropertyRequest
-	5,  // 11: mygrpcservice.PropertyService.UpdateProperty:input_type -> mygrpcservice.UpdatePropertyRequest
-	7,  // 12: mygrpcservice.PropertyService.DeleteProperty:input_type -> mygrpcservice.DeletePropertyRequest
-	9,  // 13: mygrpcservice.PropertyService.ListPropertyByCategory:input_type -> mygrpcservice.PropertyListByCategoryRequest
-	10, // 14: mygrpcservice.PropertyService.ListPropertyByOwner:input_type -> mygrpcservice.PropertyListByOwnerRequest
-	0,  // 15: mygrpcservice.PropertyService.ReadProperty:output_type -> mygrpcservice.Property
-	3,  // 16: mygrpcservice.PropertyService.CreateProperty:output_type -> mygrpcservice.CreatePropertyResponse
-	6,  // 17: mygrpcservice.PropertyService.UpdateProperty:output_type -> mygrpcservice.UpdatePropertyResponse
-	8,  // 18: mygrpcservice.PropertyService.DeleteProperty:output_type -> mygrpcservice.DeletePropertyResponse
-	11, // 19: mygrpcservice.PropertyService.ListPropertyByCategory:output_type -> mygrpcservice.ListPropertyResponse
-	11, // 20: mygrpcservice.PropertyService.ListPropertyByOwner:output_type -> mygrpcservice.ListPropertyResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	55, // 0: mygrpcservice.Property.available_date:type_name -> google.protobuf.Timestamp
+	10, // 1: mygrpcservice.Property.address:type_name -> mygrpcservice.Address
+	8,  // 2: mygrpcservice.Property.highlights:type_name -> mygrpcservice.Highlight
+	54, // 3: mygrpcservice.Property.nearby:type_name -> mygrpcservice.Property.NearbyEntry
+	5,  // 4: mygrpcservice.Property.rent:type_name -> mygrpcservice.Rent
+	4,  // 5: mygrpcservice.Property.asking_price:type_name -> mygrpcservice.Money
+	1,  // 6: mygrpcservice.Property.attributes:type_name -> mygrpcservice.Attributes
+	2,  // 7: mygrpcservice.Attributes.floor_area:type_name -> mygrpcservice.FloorArea
+	56, // 8: mygrpcservice.AttributeFilter.garden:type_name -> google.protobuf.BoolValue
+	56, // 9: mygrpcservice.AttributeFilter.pets_allowed:type_name -> google.protobuf.BoolValue
+	4,  // 10: mygrpcservice.Rent.price:type_name -> mygrpcservice.Money
+	31, // 11: mygrpcservice.NearbyPOI.location:type_name -> mygrpcservice.Coordinate
+	9,  // 12: mygrpcservice.Highlight.texts:type_name -> mygrpcservice.HighlightText
+	55, // 13: mygrpcservice.CreatePropertyRequest.available_date:type_name -> google.protobuf.Timestamp
+	10, // 14: mygrpcservice.CreatePropertyRequest.address:type_name -> mygrpcservice.Address
+	5,  // 15: mygrpcservice.CreatePropertyRequest.rent:type_name -> mygrpcservice.Rent
+	4,  // 16: mygrpcservice.CreatePropertyRequest.asking_price:type_name -> mygrpcservice.Money
+	1,  // 17: mygrpcservice.CreatePropertyRequest.attributes:type_name -> mygrpcservice.Attributes
+	55, // 18: mygrpcservice.UpdatePropertyRequest.available_date:type_name -> google.protobuf.Timestamp
+	10, // 19: mygrpcservice.UpdatePropertyRequest.address:type_name -> mygrpcservice.Address
+	5,  // 20: mygrpcservice.UpdatePropertyRequest.rent:type_name -> mygrpcservice.Rent
+	4,  // 21: mygrpcservice.UpdatePropertyRequest.asking_price:type_name -> mygrpcservice.Money
+	1,  // 22: mygrpcservice.UpdatePropertyRequest.attributes:type_name -> mygrpcservice.Attributes
+	55, // 23: mygrpcservice.DateRange.start:type_name -> google.protobuf.Timestamp
+	55, // 24: mygrpcservice.DateRange.end:type_name -> google.protobuf.Timestamp
+	55, // 25: mygrpcservice.BlockDatesRequest.start:type_name -> google.protobuf.Timestamp
+	55, // 26: mygrpcservice.BlockDatesRequest.end:type_name -> google.protobuf.Timestamp
+	55, // 27: mygrpcservice.UnblockDatesRequest.start:type_name -> google.protobuf.Timestamp
+	55, // 28: mygrpcservice.UnblockDatesRequest.end:type_name -> google.protobuf.Timestamp
+	55, // 29: mygrpcservice.GetAvailabilityRequest.start:type_name -> google.protobuf.Timestamp
+	55, // 30: mygrpcservice.GetAvailabilityRequest.end:type_name -> google.protobuf.Timestamp
+	20, // 31: mygrpcservice.GetAvailabilityResponse.ranges:type_name -> mygrpcservice.DateRange
+	55, // 32: mygrpcservice.PropertyListByCategoryRequest.free_from:type_name -> google.protobuf.Timestamp
+	55, // 33: mygrpcservice.PropertyListByCategoryRequest.free_to:type_name -> google.protobuf.Timestamp
+	55, // 34: mygrpcservice.PropertyListByOwnerRequest.free_from:type_name -> google.protobuf.Timestamp
+	55, // 35: mygrpcservice.PropertyListByOwnerRequest.free_to:type_name -> google.protobuf.Timestamp
+	3,  // 36: mygrpcservice.PropertyListNearRequest.attributes:type_name -> mygrpcservice.AttributeFilter
+	55, // 37: mygrpcservice.PropertyListNearRequest.free_from:type_name -> google.protobuf.Timestamp
+	55, // 38: mygrpcservice.PropertyListNearRequest.free_to:type_name -> google.protobuf.Timestamp
+	31, // 39: mygrpcservice.BoundingBox.bottom_left:type_name -> mygrpcservice.Coordinate
+	31, // 40: mygrpcservice.BoundingBox.top_right:type_name -> mygrpcservice.Coordinate
+	31, // 41: mygrpcservice.LinearRing.points:type_name -> mygrpcservice.Coordinate
+	33, // 42: mygrpcservice.Polygon.rings:type_name -> mygrpcservice.LinearRing
+	32, // 43: mygrpcservice.PropertyListWithinAreaRequest.bounding_box:type_name -> mygrpcservice.BoundingBox
+	34, // 44: mygrpcservice.PropertyListWithinAreaRequest.polygon:type_name -> mygrpcservice.Polygon
+	55, // 45: mygrpcservice.PropertyListWithinAreaRequest.free_from:type_name -> google.protobuf.Timestamp
+	55, // 46: mygrpcservice.PropertyListWithinAreaRequest.free_to:type_name -> google.protobuf.Timestamp
+	55, // 47: mygrpcservice.PropertyListWithinCommuteRequest.departure:type_name -> google.protobuf.Timestamp
+	3,  // 48: mygrpcservice.PropertyListWithinCommuteRequest.attributes:type_name -> mygrpcservice.AttributeFilter
+	55, // 49: mygrpcservice.PropertyListWithinCommuteRequest.free_from:type_name -> google.protobuf.Timestamp
+	55, // 50: mygrpcservice.PropertyListWithinCommuteRequest.free_to:type_name -> google.protobuf.Timestamp
+	32, // 51: mygrpcservice.ClusterPropertiesRequest.bounding_box:type_name -> mygrpcservice.BoundingBox
+	31, // 52: mygrpcservice.PropertyCluster.centroid:type_name -> mygrpcservice.Coordinate
+	38, // 53: mygrpcservice.ClusterPropertiesResponse.clusters:type_name -> mygrpcservice.PropertyCluster
+	0,  // 54: mygrpcservice.ClusterPropertiesResponse.properties:type_name -> mygrpcservice.Property
+	55, // 55: mygrpcservice.PropertyFilter.available_from:type_name -> google.protobuf.Timestamp
+	55, // 56: mygrpcservice.PropertyFilter.available_to:type_name -> google.protobuf.Timestamp
+	41, // 57: mygrpcservice.PropertyFilter.near_pois:type_name -> mygrpcservice.POIDistance
+	6,  // 58: mygrpcservice.PropertyFilter.rent:type_name -> mygrpcservice.PriceRange
+	6,  // 59: mygrpcservice.PropertyFilter.asking_price:type_name -> mygrpcservice.PriceRange
+	3,  // 60: mygrpcservice.PropertyFilter.attributes:type_name -> mygrpcservice.AttributeFilter
+	55, // 61: mygrpcservice.PropertyFilter.free_from:type_name -> google.protobuf.Timestamp
+	55, // 62: mygrpcservice.PropertyFilter.free_to:type_name -> google.protobuf.Timestamp
+	40, // 63: mygrpcservice.SearchPropertiesRequest.filter:type_name -> mygrpcservice.PropertyFilter
+	40, // 64: mygrpcservice.GetPropertyFacetsRequest.filter:type_name -> mygrpcservice.PropertyFilter
+	45, // 65: mygrpcservice.PropertyFacets.categories:type_name -> mygrpcservice.FacetBucket
+	45, // 66: mygrpcservice.PropertyFacets.sale_types:type_name -> mygrpcservice.FacetBucket
+	45, // 67: mygrpcservice.PropertyFacets.cities:type_name -> mygrpcservice.FacetBucket
+	45, // 68: mygrpcservice.PropertyFacets.statuses:type_name -> mygrpcservice.FacetBucket
+	40, // 69: mygrpcservice.GetPropertyFacetsResponse.filter:type_name -> mygrpcservice.PropertyFilter
+	46, // 70: mygrpcservice.GetPropertyFacetsResponse.facets:type_name -> mygrpcservice.PropertyFacets
+	0,  // 71: mygrpcservice.ListPropertyResponse.properties:type_name -> mygrpcservice.Property
+	7,  // 72: mygrpcservice.Property.NearbyEntry.value:type_name -> mygrpcservice.NearbyPOI
+	13, // 73: mygrpcservice.PropertyService.ReadProperty:input_type -> mygrpcservice.ReadPropertyRequest
+	11, // 74: mygrpcservice.PropertyService.CreateProperty:input_type -> mygrpcservice.CreatePropertyRequest
+	14, // 75: mygrpcservice.PropertyService.UpdateProperty:input_type -> mygrpcservice.UpdatePropertyRequest
+	16, // 76: mygrpcservice.PropertyService.DeleteProperty:input_type -> mygrpcservice.DeletePropertyRequest
+	18, // 77: mygrpcservice.PropertyService.PublishProperty:input_type -> mygrpcservice.ChangePropertyStatusRequest
+	18, // 78: mygrpcservice.PropertyService.MarkPropertyUnderOffer:input_type -> mygrpcservice.ChangePropertyStatusRequest
+	18, // 79: mygrpcservice.PropertyService.MarkPropertyLet:input_type -> mygrpcservice.ChangePropertyStatusRequest
+	18, // 80: mygrpcservice.PropertyService.MarkPropertySold:input_type -> mygrpcservice.ChangePropertyStatusRequest
+	18, // 81: mygrpcservice.PropertyService.WithdrawProperty:input_type -> mygrpcservice.ChangePropertyStatusRequest
+	18, // 82: mygrpcservice.PropertyService.ArchiveProperty:input_type -> mygrpcservice.ChangePropertyStatusRequest
+	21, // 83: mygrpcservice.PropertyService.BlockDates:input_type -> mygrpcservice.BlockDatesRequest
+	23, // 84: mygrpcservice.PropertyService.UnblockDates:input_type -> mygrpcservice.UnblockDatesRequest
+	25, // 85: mygrpcservice.PropertyService.GetAvailability:input_type -> mygrpcservice.GetAvailabilityRequest
+	27, // 86: mygrpcservice.PropertyService.ListPropertyByCategory:input_type -> mygrpcservice.PropertyListByCategoryRequest
+	28, // 87: mygrpcservice.PropertyService.ListPropertyByOwner:input_type -> mygrpcservice.PropertyListByOwnerRequest
+	29, // 88: mygrpcservice.PropertyService.ListPropertiesNear:input_type -> mygrpcservice.PropertyListNearRequest
+	30, // 89: mygrpcservice.PropertyService.ListSimilarProperties:input_type -> mygrpcservice.PropertyListSimilarRequest
+	35, // 90: mygrpcservice.PropertyService.ListPropertiesWithinArea:input_type -> mygrpcservice.PropertyListWithinAreaRequest
+	36, // 91: mygrpcservice.PropertyService.ListPropertiesWithinCommute:input_type -> mygrpcservice.PropertyListWithinCommuteRequest
+	37, // 92: mygrpcservice.PropertyService.ClusterProperties:input_type -> mygrpcservice.ClusterPropertiesRequest
+	42, // 93: mygrpcservice.PropertyService.SearchProperties:input_type -> mygrpcservice.SearchPropertiesRequest
+	43, // 94: mygrpcservice.PropertyService.SearchPropertiesByText:input_type -> mygrpcservice.SearchPropertiesByTextRequest
+	44, // 95: mygrpcservice.PropertyService.GetPropertyFacets:input_type -> mygrpcservice.GetPropertyFacetsRequest
+	48, // 96: mygrpcservice.PropertyService.Suggest:input_type -> mygrpcservice.SuggestRequest
+	50, // 97: mygrpcservice.PropertyService.ReverseGeocode:input_type -> mygrpcservice.ReverseGeocodeRequest
+	51, // 98: mygrpcservice.PropertyService.ImportAreas:input_type -> mygrpcservice.ImportAreasRequest
+	0,  // 99: mygrpcservice.PropertyService.ReadProperty:output_type -> mygrpcservice.Property
+	12, // 100: mygrpcservice.PropertyService.CreateProperty:output_type -> mygrpcservice.CreatePropertyResponse
+	15, // 101: mygrpcservice.PropertyService.UpdateProperty:output_type -> mygrpcservice.UpdatePropertyResponse
+	17, // 102: mygrpcservice.PropertyService.DeleteProperty:output_type -> mygrpcservice.DeletePropertyResponse
+	19, // 103: mygrpcservice.PropertyService.PublishProperty:output_type -> mygrpcservice.ChangePropertyStatusResponse
+	19, // 104: mygrpcservice.PropertyService.MarkPropertyUnderOffer:output_type -> mygrpcservice.ChangePropertyStatusResponse
+	19, // 105: mygrpcservice.PropertyService.MarkPropertyLet:output_type -> mygrpcservice.ChangePropertyStatusResponse
+	19, // 106: mygrpcservice.PropertyService.MarkPropertySold:output_type -> mygrpcservice.ChangePropertyStatusResponse
+	19, // 107: mygrpcservice.PropertyService.WithdrawProperty:output_type -> mygrpcservice.ChangePropertyStatusResponse
+	19, // 108: mygrpcservice.PropertyService.ArchiveProperty:output_type -> mygrpcservice.ChangePropertyStatusResponse
+	22, // 109: mygrpcservice.PropertyService.BlockDates:output_type -> mygrpcservice.BlockDatesResponse
+	24, // 110: mygrpcservice.PropertyService.UnblockDates:output_type -> mygrpcservice.UnblockDatesResponse
+	26, // 111: mygrpcservice.PropertyService.GetAvailability:output_type -> mygrpcservice.GetAvailabilityResponse
+	53, // 112: mygrpcservice.PropertyService.ListPropertyByCategory:output_type -> mygrpcservice.ListPropertyResponse
+	53, // 113: mygrpcservice.PropertyService.ListPropertyByOwner:output_type -> mygrpcservice.ListPropertyResponse
+	53, // 114: mygrpcservice.PropertyService.ListPropertiesNear:output_type -> mygrpcservice.ListPropertyResponse
+	53, // 115: mygrpcservice.PropertyService.ListSimilarProperties:output_type -> mygrpcservice.ListPropertyResponse
+	53, // 116: mygrpcservice.PropertyService.ListPropertiesWithinArea:output_type -> mygrpcservice.ListPropertyResponse
+	53, // 117: mygrpcservice.PropertyService.ListPropertiesWithinCommute:output_type -> mygrpcservice.ListPropertyResponse
+	39, // 118: mygrpcservice.PropertyService.ClusterProperties:output_type -> mygrpcservice.ClusterPropertiesResponse
+	53, // 119: mygrpcservice.PropertyService.SearchProperties:output_type -> mygrpcservice.ListPropertyResponse
+	53, // 120: mygrpcservice.PropertyService.SearchPropertiesByText:output_type -> mygrpcservice.ListPropertyResponse
+	47, // 121: mygrpcservice.PropertyService.GetPropertyFacets:output_type -> mygrpcservice.GetPropertyFacetsResponse
+	49, // 122: mygrpcservice.PropertyService.Suggest:output_type -> mygrpcservice.SuggestResponse
+	10, // 123: mygrpcservice.PropertyService.ReverseGeocode:output_type -> mygrpcservice.Address
+	52, // 124: mygrpcservice.PropertyService.ImportAreas:output_type -> mygrpcservice.ImportAreasResponse
+	99, // [99:125] is the sub-list for method output_type
+	73, // [73:99] is the sub-list for method input_type
+	73, // [73:73] is the sub-list for extension type_name
+	73, // [73:73] is the sub-list for extension extendee
+	0,  // [0:73] is the sub-list for field type_name
 }
 
 func init() { file_property_service_proto_init() }
@@ -1017,14 +4441,20 @@ func file_property_service_proto_init() {
 		return
 	}
 	file_property_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_property_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_property_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_property_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_property_service_proto_msgTypes[35].OneofWrappers = []any{
+		(*PropertyListWithinAreaRequest_BoundingBox)(nil),
+		(*PropertyListWithinAreaRequest_Polygon)(nil),
+	}
+	file_property_service_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_property_service_proto_rawDesc), len(file_property_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PropertyService_ReadProperty_FullMethodName           = "/mygrpcservice.PropertyService/ReadProperty"
-	PropertyService_CreateProperty_FullMethodName         = "/mygrpcservice.PropertyService/CreateProperty"
-	PropertyService_UpdateProperty_FullMethodName         = "/mygrpcservice.PropertyService/UpdateProperty"
-	PropertyService_DeleteProperty_FullMethodName         = "/mygrpcservice.PropertyService/DeleteProperty"
-	PropertyService_ListPropertyByCategory_FullMethodName = "/mygrpcservice.PropertyService/ListPropertyByCategory"
-	PropertyService_ListPropertyByOwner_FullMethodName    = "/mygrpcservice.PropertyService/ListPropertyByOwner"
+	PropertyService_ReadProperty_FullMethodName                = "/mygrpcservice.PropertyService/ReadProperty"
+	PropertyService_CreateProperty_FullMethodName              = "/mygrpcservice.PropertyService/CreateProperty"
+	PropertyService_UpdateProperty_FullMethodName              = "/mygrpcservice.PropertyService/UpdateProperty"
+	PropertyService_DeleteProperty_FullMethodName              = "/mygrpcservice.PropertyService/DeleteProperty"
+	PropertyService_PublishProperty_FullMethodName             = "/mygrpcservice.PropertyService/PublishProperty"
+	PropertyService_MarkPropertyUnderOffer_FullMethodName      = "/mygrpcservice.PropertyService/MarkPropertyUnderOffer"
+	PropertyService_MarkPropertyLet_FullMethodName             = "/mygrpcservice.PropertyService/MarkPropertyLet"
+	PropertyService_MarkPropertySold_FullMethodName            = "/mygrpcservice.PropertyService/MarkPropertySold"
+	PropertyService_WithdrawProperty_FullMethodName            = "/mygrpcservice.PropertyService/WithdrawProperty"
+	PropertyService_ArchiveProperty_FullMethodName             = "/mygrpcservice.PropertyService/ArchiveProperty"
+	PropertyService_BlockDates_FullMethodName                  = "/mygrpcservice.PropertyService/BlockDates"
+	PropertyService_UnblockDates_FullMethodName                = "/mygrpcservice.PropertyService/UnblockDates"
+	PropertyService_GetAvailability_FullMethodName             = "/mygrpcservice.PropertyService/GetAvailability"
+	PropertyService_ListPropertyByCategory_FullMethodName      = "/mygrpcservice.PropertyService/ListPropertyByCategory"
+	PropertyService_ListPropertyByOwner_FullMethodName         = "/mygrpcservice.PropertyService/ListPropertyByOwner"
+	PropertyService_ListPropertiesNear_FullMethodName          = "/mygrpcservice.PropertyService/ListPropertiesNear"
+	PropertyService_ListSimilarProperties_FullMethodName       = "/mygrpcservice.PropertyService/ListSimilarProperties"
+	PropertyService_ListPropertiesWithinArea_FullMethodName    = "/mygrpcservice.PropertyService/ListPropertiesWithinArea"
+	PropertyService_ListPropertiesWithinCommute_FullMethodName = "/mygrpcservice.PropertyService/ListPropertiesWithinCommute"
+	PropertyService_ClusterProperties_FullMethodName           = "/mygrpcservice.PropertyService/ClusterProperties"
+	PropertyService_SearchProperties_FullMethodName            = "/mygrpcservice.PropertyService/SearchProperties"
+	PropertyService_SearchPropertiesByText_FullMethodName      = "/mygrpcservice.PropertyService/SearchPropertiesByText"
+	PropertyService_GetPropertyFacets_FullMethodName           = "/mygrpcservice.PropertyService/GetPropertyFacets"
+	PropertyService_Suggest_FullMethodName                     = "/mygrpcservice.PropertyService/Suggest"
+	PropertyService_ReverseGeocode_FullMethodName              = "/mygrpcservice.PropertyService/ReverseGeocode"
+	PropertyService_ImportAreas_FullMethodName                 = "/mygrpcservice.PropertyService/ImportAreas"
 )
 
 // PropertyServiceClient is the client API for PropertyService service.
@@ -37,8 +57,34 @@ type PropertyServiceClient interface {
 	CreateProperty(ctx context.Context, in *CreatePropertyRequest, opts ...grpc.CallOption) (*CreatePropertyResponse, error)
 	UpdateProperty(ctx context.Context, in *UpdatePropertyRequest, opts ...grpc.CallOption) (*UpdatePropertyResponse, error)
 	DeleteProperty(ctx context.Context, in *DeletePropertyRequest, opts ...grpc.CallOption) (*DeletePropertyResponse, error)
+	// The listing status operations move a property through its lifecycle, a transition that
+	// is not allowed from its current status fails with FAILED_PRECONDITION.
+	PublishProperty(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error)
+	MarkPropertyUnderOffer(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error)
+	MarkPropertyLet(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error)
+	MarkPropertySold(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error)
+	WithdrawProperty(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error)
+	ArchiveProperty(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error)
+	// The availability calendar operations, blocking dates that overlap blocked or booked dates
+	// fails with FAILED_PRECONDITION and a calendar changed concurrently with ABORTED.
+	BlockDates(ctx context.Context, in *BlockDatesRequest, opts ...grpc.CallOption) (*BlockDatesResponse, error)
+	UnblockDates(ctx context.Context, in *UnblockDatesRequest, opts ...grpc.CallOption) (*UnblockDatesResponse, error)
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error)
 	ListPropertyByCategory(ctx context.Context, in *PropertyListByCategoryRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
 	ListPropertyByOwner(ctx context.Context, in *PropertyListByOwnerRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	ListPropertiesNear(ctx context.Context, in *PropertyListNearRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	ListSimilarProperties(ctx context.Context, in *PropertyListSimilarRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	ListPropertiesWithinArea(ctx context.Context, in *PropertyListWithinAreaRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	ListPropertiesWithinCommute(ctx context.Context, in *PropertyListWithinCommuteRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	ClusterProperties(ctx context.Context, in *ClusterPropertiesRequest, opts ...grpc.CallOption) (*ClusterPropertiesResponse, error)
+	SearchProperties(ctx context.Context, in *SearchPropertiesRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	SearchPropertiesByText(ctx context.Context, in *SearchPropertiesByTextRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error)
+	GetPropertyFacets(ctx context.Context, in *GetPropertyFacetsRequest, opts ...grpc.CallOption) (*GetPropertyFacetsResponse, error)
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*Address, error)
+	// ImportAreas creates or replaces named areas, the properties are tagged again with an
+	// area whose boundary changed.
+	ImportAreas(ctx context.Context, in *ImportAreasRequest, opts ...grpc.CallOption) (*ImportAreasResponse, error)
 }
 
 type propertyServiceClient struct {
@@ -89,6 +135,96 @@ func (c *propertyServiceClient) DeleteProperty(ctx context.Context, in *DeletePr
 	return out, nil
 }
 
+func (c *propertyServiceClient) PublishProperty(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePropertyStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_PublishProperty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) MarkPropertyUnderOffer(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePropertyStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_MarkPropertyUnderOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) MarkPropertyLet(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePropertyStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_MarkPropertyLet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) MarkPropertySold(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePropertyStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_MarkPropertySold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) WithdrawProperty(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePropertyStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_WithdrawProperty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ArchiveProperty(ctx context.Context, in *ChangePropertyStatusRequest, opts ...grpc.CallOption) (*ChangePropertyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePropertyStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ArchiveProperty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) BlockDates(ctx context.Context, in *BlockDatesRequest, opts ...grpc.CallOption) (*BlockDatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockDatesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_BlockDates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) UnblockDates(ctx context.Context, in *UnblockDatesRequest, opts ...grpc.CallOption) (*UnblockDatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockDatesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_UnblockDates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityResponse)
+	err := c.cc.Invoke(ctx, PropertyService_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *propertyServiceClient) ListPropertyByCategory(ctx context.Context, in *PropertyListByCategoryRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPropertyResponse)
@@ -109,6 +245,116 @@ func (c *propertyServiceClient) ListPropertyByOwner(ctx context.Context, in *Pro
 	return out, nil
 }
 
+func (c *propertyServiceClient) ListPropertiesNear(ctx context.Context, in *PropertyListNearRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ListPropertiesNear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ListSimilarProperties(ctx context.Context, in *PropertyListSimilarRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ListSimilarProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ListPropertiesWithinArea(ctx context.Context, in *PropertyListWithinAreaRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ListPropertiesWithinArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ListPropertiesWithinCommute(ctx context.Context, in *PropertyListWithinCommuteRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ListPropertiesWithinCommute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ClusterProperties(ctx context.Context, in *ClusterPropertiesRequest, opts ...grpc.CallOption) (*ClusterPropertiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterPropertiesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ClusterProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) SearchProperties(ctx context.Context, in *SearchPropertiesRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyResponse)
+	err := c.cc.Invoke(ctx, PropertyService_SearchProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) SearchPropertiesByText(ctx context.Context, in *SearchPropertiesByTextRequest, opts ...grpc.CallOption) (*ListPropertyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyResponse)
+	err := c.cc.Invoke(ctx, PropertyService_SearchPropertiesByText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) GetPropertyFacets(ctx context.Context, in *GetPropertyFacetsRequest, opts ...grpc.CallOption) (*GetPropertyFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyFacetsResponse)
+	err := c.cc.Invoke(ctx, PropertyService_GetPropertyFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, PropertyService_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*Address, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Address)
+	err := c.cc.Invoke(ctx, PropertyService_ReverseGeocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ImportAreas(ctx context.Context, in *ImportAreasRequest, opts ...grpc.CallOption) (*ImportAreasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportAreasResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ImportAreas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PropertyServiceServer is the server API for PropertyService service.
 // All implementations must embed UnimplementedPropertyServiceServer
 // for forward compatibility.
@@ -119,8 +365,34 @@ type PropertyServiceServer interface {
 	CreateProperty(context.Context, *CreatePropertyRequest) (*CreatePropertyResponse, error)
 	UpdateProperty(context.Context, *UpdatePropertyRequest) (*UpdatePropertyResponse, error)
 	DeleteProperty(context.Context, *DeletePropertyRequest) (*DeletePropertyResponse, error)
+	// The listing status operations move a property through its lifecycle, a transition that
+	// is not allowed from its current status fails with FAILED_PRECONDITION.
+	PublishProperty(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error)
+	MarkPropertyUnderOffer(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error)
+	MarkPropertyLet(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error)
+	MarkPropertySold(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error)
+	WithdrawProperty(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error)
+	ArchiveProperty(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error)
+	// The availability calendar operations, blocking dates that overlap blocked or booked dates
+	// fails with FAILED_PRECONDITION and a calendar changed concurrently with ABORTED.
+	BlockDates(context.Context, *BlockDatesRequest) (*BlockDatesResponse, error)
+	UnblockDates(context.Context, *UnblockDatesRequest) (*UnblockDatesResponse, error)
+	GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error)
 	ListPropertyByCategory(context.Context, *PropertyListByCategoryRequest) (*ListPropertyResponse, error)
 	ListPropertyByOwner(context.Context, *PropertyListByOwnerRequest) (*ListPropertyResponse, error)
+	ListPropertiesNear(context.Context, *PropertyListNearRequest) (*ListPropertyResponse, error)
+	ListSimilarProperties(context.Context, *PropertyListSimilarRequest) (*ListPropertyResponse, error)
+	ListPropertiesWithinArea(context.Context, *PropertyListWithinAreaRequest) (*ListPropertyResponse, error)
+	ListPropertiesWithinCommute(context.Context, *PropertyListWithinCommuteRequest) (*ListPropertyResponse, error)
+	ClusterProperties(context.Context, *ClusterPropertiesRequest) (*ClusterPropertiesResponse, error)
+	SearchProperties(context.Context, *SearchPropertiesRequest) (*ListPropertyResponse, error)
+	SearchPropertiesByText(context.Context, *SearchPropertiesByTextRequest) (*ListPropertyResponse, error)
+	GetPropertyFacets(context.Context, *GetPropertyFacetsRequest) (*GetPropertyFacetsResponse, error)
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*Address, error)
+	// ImportAreas creates or replaces named areas, the properties are tagged again with an
+	// area whose boundary changed.
+	ImportAreas(context.Context, *ImportAreasRequest) (*ImportAreasResponse, error)
 	mustEmbedUnimplementedPropertyServiceServer()
 }
 
@@ -143,12 +415,72 @@ func (UnimplementedPropertyServiceServer) UpdateProperty(context.Context, *Updat
 func (UnimplementedPropertyServiceServer) DeleteProperty(context.Context, *DeletePropertyRequest) (*DeletePropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProperty not implemented")
 }
+func (UnimplementedPropertyServiceServer) PublishProperty(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishProperty not implemented")
+}
+func (UnimplementedPropertyServiceServer) MarkPropertyUnderOffer(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPropertyUnderOffer not implemented")
+}
+func (UnimplementedPropertyServiceServer) MarkPropertyLet(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPropertyLet not implemented")
+}
+func (UnimplementedPropertyServiceServer) MarkPropertySold(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPropertySold not implemented")
+}
+func (UnimplementedPropertyServiceServer) WithdrawProperty(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProperty not implemented")
+}
+func (UnimplementedPropertyServiceServer) ArchiveProperty(context.Context, *ChangePropertyStatusRequest) (*ChangePropertyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProperty not implemented")
+}
+func (UnimplementedPropertyServiceServer) BlockDates(context.Context, *BlockDatesRequest) (*BlockDatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDates not implemented")
+}
+func (UnimplementedPropertyServiceServer) UnblockDates(context.Context, *UnblockDatesRequest) (*UnblockDatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockDates not implemented")
+}
+func (UnimplementedPropertyServiceServer) GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailability not implemented")
+}
 func (UnimplementedPropertyServiceServer) ListPropertyByCategory(context.Context, *PropertyListByCategoryRequest) (*ListPropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPropertyByCategory not implemented")
 }
 func (UnimplementedPropertyServiceServer) ListPropertyByOwner(context.Context, *PropertyListByOwnerRequest) (*ListPropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPropertyByOwner not implemented")
 }
+func (UnimplementedPropertyServiceServer) ListPropertiesNear(context.Context, *PropertyListNearRequest) (*ListPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPropertiesNear not implemented")
+}
+func (UnimplementedPropertyServiceServer) ListSimilarProperties(context.Context, *PropertyListSimilarRequest) (*ListPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSimilarProperties not implemented")
+}
+func (UnimplementedPropertyServiceServer) ListPropertiesWithinArea(context.Context, *PropertyListWithinAreaRequest) (*ListPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPropertiesWithinArea not implemented")
+}
+func (UnimplementedPropertyServiceServer) ListPropertiesWithinCommute(context.Context, *PropertyListWithinCommuteRequest) (*ListPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPropertiesWithinCommute not implemented")
+}
+func (UnimplementedPropertyServiceServer) ClusterProperties(context.Context, *ClusterPropertiesRequest) (*ClusterPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterProperties not implemented")
+}
+func (UnimplementedPropertyServiceServer) SearchProperties(context.Context, *SearchPropertiesRequest) (*ListPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProperties not implemented")
+}
+func (UnimplementedPropertyServiceServer) SearchPropertiesByText(context.Context, *SearchPropertiesByTextRequest) (*ListPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPropertiesByText not implemented")
+}
+func (UnimplementedPropertyServiceServer) GetPropertyFacets(context.Context, *GetPropertyFacetsRequest) (*GetPropertyFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyFacets not implemented")
+}
+func (UnimplementedPropertyServiceServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedPropertyServiceServer) ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*Address, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseGeocode not implemented")
+}
+func (UnimplementedPropertyServiceServer) ImportAreas(context.Context, *ImportAreasRequest) (*ImportAreasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAreas not implemented")
+}
 func (UnimplementedPropertyServiceServer) mustEmbedUnimplementedPropertyServiceServer() {}
 func (UnimplementedPropertyServiceServer) testEmbeddedByValue()                         {}
 
@@ -242,6 +574,168 @@ func _PropertyService_DeleteProperty_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PropertyService_PublishProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePropertyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).PublishProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_PublishProperty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).PublishProperty(ctx, req.(*ChangePropertyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_MarkPropertyUnderOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePropertyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).MarkPropertyUnderOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_MarkPropertyUnderOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).MarkPropertyUnderOffer(ctx, req.(*ChangePropertyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_MarkPropertyLet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePropertyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).MarkPropertyLet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_MarkPropertyLet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).MarkPropertyLet(ctx, req.(*ChangePropertyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_MarkPropertySold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePropertyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).MarkPropertySold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_MarkPropertySold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).MarkPropertySold(ctx, req.(*ChangePropertyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_WithdrawProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePropertyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).WithdrawProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_WithdrawProperty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).WithdrawProperty(ctx, req.(*ChangePropertyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ArchiveProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePropertyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ArchiveProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ArchiveProperty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ArchiveProperty(ctx, req.(*ChangePropertyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_BlockDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).BlockDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_BlockDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).BlockDates(ctx, req.(*BlockDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_UnblockDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).UnblockDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_UnblockDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).UnblockDates(ctx, req.(*UnblockDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).GetAvailability(ctx, req.(*GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PropertyService_ListPropertyByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PropertyListByCategoryRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +772,204 @@ func _PropertyService_ListPropertyByOwner_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PropertyService_ListPropertiesNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyListNearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ListPropertiesNear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ListPropertiesNear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ListPropertiesNear(ctx, req.(*PropertyListNearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ListSimilarProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyListSimilarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ListSimilarProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ListSimilarProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ListSimilarProperties(ctx, req.(*PropertyListSimilarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ListPropertiesWithinArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyListWithinAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ListPropertiesWithinArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ListPropertiesWithinArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ListPropertiesWithinArea(ctx, req.(*PropertyListWithinAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ListPropertiesWithinCommute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyListWithinCommuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ListPropertiesWithinCommute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ListPropertiesWithinCommute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ListPropertiesWithinCommute(ctx, req.(*PropertyListWithinCommuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ClusterProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ClusterProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ClusterProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ClusterProperties(ctx, req.(*ClusterPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_SearchProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).SearchProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_SearchProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).SearchProperties(ctx, req.(*SearchPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_SearchPropertiesByText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPropertiesByTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).SearchPropertiesByText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_SearchPropertiesByText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).SearchPropertiesByText(ctx, req.(*SearchPropertiesByTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_GetPropertyFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).GetPropertyFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_GetPropertyFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).GetPropertyFacets(ctx, req.(*GetPropertyFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ReverseGeocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGeocodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ReverseGeocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ReverseGeocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ReverseGeocode(ctx, req.(*ReverseGeocodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ImportAreas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAreasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ImportAreas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ImportAreas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ImportAreas(ctx, req.(*ImportAreasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PropertyService_ServiceDesc is the grpc.ServiceDesc for PropertyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,6 +993,42 @@ var PropertyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProperty",
 			Handler:    _PropertyService_DeleteProperty_Handler,
 		},
+		{
+			MethodName: "PublishProperty",
+			Handler:    _PropertyService_PublishProperty_Handler,
+		},
+		{
+			MethodName: "MarkPropertyUnderOffer",
+			Handler:    _PropertyService_MarkPropertyUnderOffer_Handler,
+		},
+		{
+			MethodName: "MarkPropertyLet",
+			Handler:    _PropertyService_MarkPropertyLet_Handler,
+		},
+		{
+			MethodName: "MarkPropertySold",
+			Handler:    _PropertyService_MarkPropertySold_Handler,
+		},
+		{
+			MethodName: "WithdrawProperty",
+			Handler:    _PropertyService_WithdrawProperty_Handler,
+		},
+		{
+			MethodName: "ArchiveProperty",
+			Handler:    _PropertyService_ArchiveProperty_Handler,
+		},
+		{
+			MethodName: "BlockDates",
+			Handler:    _PropertyService_BlockDates_Handler,
+		},
+		{
+			MethodName: "UnblockDates",
+			Handler:    _PropertyService_UnblockDates_Handler,
+		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _PropertyService_GetAvailability_Handler,
+		},
 		{
 			MethodName: "ListPropertyByCategory",
 			Handler:    _PropertyService_ListPropertyByCategory_Handler,
@@ -309,6 +1037,50 @@ var PropertyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPropertyByOwner",
 			Handler:    _PropertyService_ListPropertyByOwner_Handler,
 		},
+		{
+			MethodName: "ListPropertiesNear",
+			Handler:    _PropertyService_ListPropertiesNear_Handler,
+		},
+		{
+			MethodName: "ListSimilarProperties",
+			Handler:    _PropertyService_ListSimilarProperties_Handler,
+		},
+		{
+			MethodName: "ListPropertiesWithinArea",
+			Handler:    _PropertyService_ListPropertiesWithinArea_Handler,
+		},
+		{
+			MethodName: "ListPropertiesWithinCommute",
+			Handler:    _PropertyService_ListPropertiesWithinCommute_Handler,
+		},
+		{
+			MethodName: "ClusterProperties",
+			Handler:    _PropertyService_ClusterProperties_Handler,
+		},
+		{
+			MethodName: "SearchProperties",
+			Handler:    _PropertyService_SearchProperties_Handler,
+		},
+		{
+			MethodName: "SearchPropertiesByText",
+			Handler:    _PropertyService_SearchPropertiesByText_Handler,
+		},
+		{
+			MethodName: "GetPropertyFacets",
+			Handler:    _PropertyService_GetPropertyFacets_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _PropertyService_Suggest_Handler,
+		},
+		{
+			MethodName: "ReverseGeocode",
+			Handler:    _PropertyService_ReverseGeocode_Handler,
+		},
+		{
+			MethodName: "ImportAreas",
+			Handler:    _PropertyService_ImportAreas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "property_service.proto",
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: viewing_service.proto
+
+package proto
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A viewing slot the owner offers for a property, it is booked by at most one person.
+type ViewingSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId    string                 `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Booked        bool                   `protobuf:"varint,5,opt,name=booked,proto3" json:"booked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewingSlot) Reset() {
+	*x = ViewingSlot{}
+	mi := &file_viewing_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewingSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewingSlot) ProtoMessage() {}
+
+func (x *ViewingSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewingSlot.ProtoReflect.Descriptor instead.
+func (*ViewingSlot) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ViewingSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ViewingSlot) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *ViewingSlot) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ViewingSlot) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ViewingSlot) GetBooked() bool {
+	if x != nil {
+		return x.Booked
+	}
+	return false
+}
+
+// Request and Response messages for the CreateViewingSlot operation.
+type CreateViewingSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // A new ID is generated when empty.
+	PropertyId    string                 `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateViewingSlotRequest) Reset() {
+	*x = CreateViewingSlotRequest{}
+	mi := &file_viewing_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateViewingSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateViewingSlotRequest) ProtoMessage() {}
+
+func (x *CreateViewingSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateViewingSlotRequest.ProtoReflect.Descriptor instead.
+func (*CreateViewingSlotRequest) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateViewingSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateViewingSlotRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *CreateViewingSlotRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreateViewingSlotRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type CreateViewingSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateViewingSlotResponse) Reset() {
+	*x = CreateViewingSlotResponse{}
+	mi := &file_viewing_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateViewingSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateViewingSlotResponse) ProtoMessage() {}
+
+func (x *CreateViewingSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateViewingSlotResponse.ProtoReflect.Descriptor instead.
+func (*CreateViewingSlotResponse) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateViewingSlotResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the DeleteViewingSlot operation.
+type DeleteViewingSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteViewingSlotRequest) Reset() {
+	*x = DeleteViewingSlotRequest{}
+	mi := &file_viewing_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteViewingSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteViewingSlotRequest) ProtoMessage() {}
+
+func (x *DeleteViewingSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteViewingSlotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteViewingSlotRequest) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteViewingSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteViewingSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteViewingSlotResponse) Reset() {
+	*x = DeleteViewingSlotResponse{}
+	mi := &file_viewing_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteViewingSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteViewingSlotResponse) ProtoMessage() {}
+
+func (x *DeleteViewingSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteViewingSlotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteViewingSlotResponse) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteViewingSlotResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the ListViewingSlots operation, the slots from start,
+// now when unset, up to end, two weeks later when unset.
+type ListViewingSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	FreeOnly      bool                   `protobuf:"varint,4,opt,name=free_only,json=freeOnly,proto3" json:"free_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListViewingSlotsRequest) Reset() {
+	*x = ListViewingSlotsRequest{}
+	mi := &file_viewing_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListViewingSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListViewingSlotsRequest) ProtoMessage() {}
+
+func (x *ListViewingSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListViewingSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListViewingSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListViewingSlotsRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *ListViewingSlotsRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListViewingSlotsRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ListViewingSlotsRequest) GetFreeOnly() bool {
+	if x != nil {
+		return x.FreeOnly
+	}
+	return false
+}
+
+type ListViewingSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*ViewingSlot         `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListViewingSlotsResponse) Reset() {
+	*x = ListViewingSlotsResponse{}
+	mi := &file_viewing_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListViewingSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListViewingSlotsResponse) ProtoMessage() {}
+
+func (x *ListViewingSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListViewingSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListViewingSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListViewingSlotsResponse) GetSlots() []*ViewingSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Request and Response messages for the BookViewing operation.
+type BookViewingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Telephone     string                 `protobuf:"bytes,4,opt,name=telephone,proto3" json:"telephone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookViewingRequest) Reset() {
+	*x = BookViewingRequest{}
+	mi := &file_viewing_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookViewingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookViewingRequest) ProtoMessage() {}
+
+func (x *BookViewingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookViewingRequest.ProtoReflect.Descriptor instead.
+func (*BookViewingRequest) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BookViewingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookViewingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookViewingRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BookViewingRequest) GetTelephone() string {
+	if x != nil {
+		return x.Telephone
+	}
+	return ""
+}
+
+type BookViewingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"` // Needed to cancel or reschedule the viewing.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookViewingResponse) Reset() {
+	*x = BookViewingResponse{}
+	mi := &file_viewing_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookViewingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookViewingResponse) ProtoMessage() {}
+
+func (x *BookViewingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookViewingResponse.ProtoReflect.Descriptor instead.
+func (*BookViewingResponse) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BookViewingResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookViewingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+// Request and Response messages for the CancelViewing operation.
+type CancelViewingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelViewingRequest) Reset() {
+	*x = CancelViewingRequest{}
+	mi := &file_viewing_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelViewingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelViewingRequest) ProtoMessage() {}
+
+func (x *CancelViewingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelViewingRequest.ProtoReflect.Descriptor instead.
+func (*CancelViewingRequest) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelViewingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelViewingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CancelViewingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelViewingResponse) Reset() {
+	*x = CancelViewingResponse{}
+	mi := &file_viewing_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelViewingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelViewingResponse) ProtoMessage() {}
+
+func (x *CancelViewingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelViewingResponse.ProtoReflect.Descriptor instead.
+func (*CancelViewingResponse) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelViewingResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request and Response messages for the RescheduleViewing operation, the booking moves to the
+// new slot of the same property.
+type RescheduleViewingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	NewId         string                 `protobuf:"bytes,3,opt,name=new_id,json=newId,proto3" json:"new_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleViewingRequest) Reset() {
+	*x = RescheduleViewingRequest{}
+	mi := &file_viewing_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleViewingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleViewingRequest) ProtoMessage() {}
+
+func (x *RescheduleViewingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleViewingRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleViewingRequest) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RescheduleViewingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RescheduleViewingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *RescheduleViewingRequest) GetNewId() string {
+	if x != nil {
+		return x.NewId
+	}
+	return ""
+}
+
+type RescheduleViewingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleViewingResponse) Reset() {
+	*x = RescheduleViewingResponse{}
+	mi := &file_viewing_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleViewingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleViewingResponse) ProtoMessage() {}
+
+func (x *RescheduleViewingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewing_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleViewingResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleViewingResponse) Descriptor() ([]byte, []int) {
+	return file_viewing_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RescheduleViewingResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RescheduleViewingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+var File_viewing_service_proto protoreflect.FileDescriptor
+
+const file_viewing_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15viewing_service.proto\x12\rmygrpcservice\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xb6\x01\n" +
+	"\vViewingSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproperty_id\x18\x02 \x01(\tR\n" +
+	"propertyId\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x16\n" +
+	"\x06booked\x18\x05 \x01(\bR\x06booked\"\xab\x01\n" +
+	"\x18CreateViewingSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproperty_id\x18\x02 \x01(\tR\n" +
+	"propertyId\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"+\n" +
+	"\x19CreateViewingSlotResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18DeleteViewingSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x19DeleteViewingSlotResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb7\x01\n" +
+	"\x17ListViewingSlotsRequest\x12\x1f\n" +
+	"\vproperty_id\x18\x01 \x01(\tR\n" +
+	"propertyId\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1b\n" +
+	"\tfree_only\x18\x04 \x01(\bR\bfreeOnly\"L\n" +
+	"\x18ListViewingSlotsResponse\x120\n" +
+	"\x05slots\x18\x01 \x03(\v2\x1a.mygrpcservice.ViewingSlotR\x05slots\"l\n" +
+	"\x12BookViewingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1c\n" +
+	"\ttelephone\x18\x04 \x01(\tR\ttelephone\"D\n" +
+	"\x13BookViewingResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\"E\n" +
+	"\x14CancelViewingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\"'\n" +
+	"\x15CancelViewingResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x18RescheduleViewingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x15\n" +
+	"\x06new_id\x18\x03 \x01(\tR\x05newId\"J\n" +
+	"\x19RescheduleViewingResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId2\xc6\x06\n" +
+	"\x0eViewingService\x12\x95\x01\n" +
+	"\x11CreateViewingSlot\x12'.mygrpcservice.CreateViewingSlotRequest\x1a(.mygrpcservice.CreateViewingSlotResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/property/{property_id}/viewing\x12\x80\x01\n" +
+	"\x11DeleteViewingSlot\x12'.mygrpcservice.DeleteViewingSlotRequest\x1a(.mygrpcservice.DeleteViewingSlotResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/viewing/{id}\x12\x8f\x01\n" +
+	"\x10ListViewingSlots\x12&.mygrpcservice.ListViewingSlotsRequest\x1a'.mygrpcservice.ListViewingSlotsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/property/{property_id}/viewing\x12v\n" +
+	"\vBookViewing\x12!.mygrpcservice.BookViewingRequest\x1a\".mygrpcservice.BookViewingResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/viewing/{id}:book\x12~\n" +
+	"\rCancelViewing\x12#.mygrpcservice.CancelViewingRequest\x1a$.mygrpcservice.CancelViewingResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/viewing/{id}:cancel\x12\x8e\x01\n" +
+	"\x11RescheduleViewing\x12'.mygrpcservice.RescheduleViewingRequest\x1a(.mygrpcservice.RescheduleViewingResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/viewing/{id}:rescheduleB\"Z property-service/api/proto;protob\x06proto3"
+
+var (
+	file_viewing_service_proto_rawDescOnce sync.Once
+	file_viewing_service_proto_rawDescData []byte
+)
+
+func file_viewing_service_proto_rawDescGZIP() []byte {
+	file_viewing_service_proto_rawDescOnce.Do(func() {
+		file_viewing_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_viewing_service_proto_rawDesc), len(file_viewing_service_proto_rawDesc)))
+	})
+	return file_viewing_service_proto_rawDescData
+}
+
+var file_viewing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_viewing_service_proto_goTypes = []any{
+	(*ViewingSlot)(nil),               // 0: mygrpcservice.ViewingSlot
+	(*CreateViewingSlotRequest)(nil),  // 1: mygrpcservice.CreateViewingSlotRequest
+	(*CreateViewingSlotResponse)(nil), // 2: mygrpcservice.CreateViewingSlotResponse
+	(*DeleteViewingSlotRequest)(nil),  // 3: mygrpcservice.DeleteViewingSlotRequest
+	(*DeleteViewingSlotResponse)(nil), // 4: mygrpcservice.DeleteViewingSlotResponse
+	(*ListViewingSlotsRequest)(nil),   // 5: mygrpcservice.ListViewingSlotsRequest
+	(*ListViewingSlotsResponse)(nil),  // 6: mygrpcservice.ListViewingSlotsResponse
+	(*BookViewingRequest)(nil),        // 7: mygrpcservice.BookViewingRequest
+	(*BookViewingResponse)(nil),       // 8: mygrpcservice.BookViewingResponse
+	(*CancelViewingRequest)(nil),      // 9: mygrpcservice.CancelViewingRequest
+	(*CancelViewingResponse)(nil),     // 10: mygrpcservice.CancelViewingResponse
+	(*RescheduleViewingRequest)(nil),  // 11: mygrpcservice.RescheduleViewingRequest
+	(*RescheduleViewingResponse)(nil), // 12: mygrpcservice.RescheduleViewingResponse
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+}
+var file_viewing_service_proto_depIdxs = []int32{
+	13, // 0: mygrpcservice.ViewingSlot.start:type_name -> google.protobuf.Timestamp
+	13, // 1: mygrpcservice.ViewingSlot.end:type_name -> google.protobuf.Timestamp
+	13, // 2: mygrpcservice.CreateViewingSlotRequest.start:type_name -> google.protobuf.Timestamp
+	13, // 3: mygrpcservice.CreateViewingSlotRequest.end:type_name -> google.protobuf.Timestamp
+	13, // 4: mygrpcservice.ListViewingSlotsRequest.start:type_name -> google.protobuf.Timestamp
+	13, // 5: mygrpcservice.ListViewingSlotsRequest.end:type_name -> google.protobuf.Timestamp
+	0,  // 6: mygrpcservice.ListViewingSlotsResponse.slots:type_name -> mygrpcservice.ViewingSlot
+	1,  // 7: mygrpcservice.ViewingService.CreateViewingSlot:input_type -> mygrpcservice.CreateViewingSlotRequest
+	3,  // 8: mygrpcservice.ViewingService.DeleteViewingSlot:input_type -> mygrpcservice.DeleteViewingSlotRequest
+	5,  // 9: mygrpcservice.ViewingService.ListViewingSlots:input_type -> mygrpcservice.ListViewingSlotsRequest
+	7,  // 10: mygrpcservice.ViewingService.BookViewing:input_type -> mygrpcservice.BookViewingRequest
+	9,  // 11: mygrpcservice.ViewingService.CancelViewing:input_type -> mygrpcservice.CancelViewingRequest
+	11, // 12: mygrpcservice.ViewingService.RescheduleViewing:input_type -> mygrpcservice.RescheduleViewingRequest
+	2,  // 13: mygrpcservice.ViewingService.CreateViewingSlot:output_type -> mygrpcservice.CreateViewingSlotResponse
+	4,  // 14: mygrpcservice.ViewingService.DeleteViewingSlot:output_type -> mygrpcservice.DeleteViewingSlotResponse
+	6,  // 15: mygrpcservice.ViewingService.ListViewingSlots:output_type -> mygrpcservice.ListViewingSlotsResponse
+	8,  // 16: mygrpcservice.ViewingService.BookViewing:output_type -> mygrpcservice.BookViewingResponse
+	10, // 17: mygrpcservice.ViewingService.CancelViewing:output_type -> mygrpcservice.CancelViewingResponse
+	12, // 18: mygrpcservice.ViewingService.RescheduleViewing:output_type -> mygrpcservice.RescheduleViewingResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_viewing_service_proto_init() }
+func file_viewing_service_proto_init() {
+	if File_viewing_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_viewing_service_proto_rawDesc), len(file_viewing_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_viewing_service_proto_goTypes,
+		DependencyIndexes: file_viewing_service_proto_depIdxs,
+		MessageInfos:      file_viewing_service_proto_msgTypes,
+	}.Build()
+	File_viewing_service_proto = out.File
+	file_viewing_service_proto_goTypes = nil
+	file_viewing_service_proto_depIdxs = nil
+}
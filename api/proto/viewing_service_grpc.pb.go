@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: viewing_service.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ViewingService_CreateViewingSlot_FullMethodName = "/mygrpcservice.ViewingService/CreateViewingSlot"
+	ViewingService_DeleteViewingSlot_FullMethodName = "/mygrpcservice.ViewingService/DeleteViewingSlot"
+	ViewingService_ListViewingSlots_FullMethodName  = "/mygrpcservice.ViewingService/ListViewingSlots"
+	ViewingService_BookViewing_FullMethodName       = "/mygrpcservice.ViewingService/BookViewing"
+	ViewingService_CancelViewing_FullMethodName     = "/mygrpcservice.ViewingService/CancelViewing"
+	ViewingService_RescheduleViewing_FullMethodName = "/mygrpcservice.ViewingService/RescheduleViewing"
+)
+
+// ViewingServiceClient is the client API for ViewingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ViewingService schedules the viewings of the properties.
+type ViewingServiceClient interface {
+	CreateViewingSlot(ctx context.Context, in *CreateViewingSlotRequest, opts ...grpc.CallOption) (*CreateViewingSlotResponse, error)
+	DeleteViewingSlot(ctx context.Context, in *DeleteViewingSlotRequest, opts ...grpc.CallOption) (*DeleteViewingSlotResponse, error)
+	ListViewingSlots(ctx context.Context, in *ListViewingSlotsRequest, opts ...grpc.CallOption) (*ListViewingSlotsResponse, error)
+	BookViewing(ctx context.Context, in *BookViewingRequest, opts ...grpc.CallOption) (*BookViewingResponse, error)
+	CancelViewing(ctx context.Context, in *CancelViewingRequest, opts ...grpc.CallOption) (*CancelViewingResponse, error)
+	RescheduleViewing(ctx context.Context, in *RescheduleViewingRequest, opts ...grpc.CallOption) (*RescheduleViewingResponse, error)
+}
+
+type viewingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewViewingServiceClient(cc grpc.ClientConnInterface) ViewingServiceClient {
+	return &viewingServiceClient{cc}
+}
+
+func (c *viewingServiceClient) CreateViewingSlot(ctx context.Context, in *CreateViewingSlotRequest, opts ...grpc.CallOption) (*CreateViewingSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateViewingSlotResponse)
+	err := c.cc.Invoke(ctx, ViewingService_CreateViewingSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewingServiceClient) DeleteViewingSlot(ctx context.Context, in *DeleteViewingSlotRequest, opts ...grpc.CallOption) (*DeleteViewingSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteViewingSlotResponse)
+	err := c.cc.Invoke(ctx, ViewingService_DeleteViewingSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewingServiceClient) ListViewingSlots(ctx context.Context, in *ListViewingSlotsRequest, opts ...grpc.CallOption) (*ListViewingSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListViewingSlotsResponse)
+	err := c.cc.Invoke(ctx, ViewingService_ListViewingSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewingServiceClient) BookViewing(ctx context.Context, in *BookViewingRequest, opts ...grpc.CallOption) (*BookViewingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookViewingResponse)
+	err := c.cc.Invoke(ctx, ViewingService_BookViewing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewingServiceClient) CancelViewing(ctx context.Context, in *CancelViewingRequest, opts ...grpc.CallOption) (*CancelViewingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelViewingResponse)
+	err := c.cc.Invoke(ctx, ViewingService_CancelViewing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewingServiceClient) RescheduleViewing(ctx context.Context, in *RescheduleViewingRequest, opts ...grpc.CallOption) (*RescheduleViewingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleViewingResponse)
+	err := c.cc.Invoke(ctx, ViewingService_RescheduleViewing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ViewingServiceServer is the server API for ViewingService service.
+// All implementations must embed UnimplementedViewingServiceServer
+// for forward compatibility.
+//
+// ViewingService schedules the viewings of the properties.
+type ViewingServiceServer interface {
+	CreateViewingSlot(context.Context, *CreateViewingSlotRequest) (*CreateViewingSlotResponse, error)
+	DeleteViewingSlot(context.Context, *DeleteViewingSlotRequest) (*DeleteViewingSlotResponse, error)
+	ListViewingSlots(context.Context, *ListViewingSlotsRequest) (*ListViewingSlotsResponse, error)
+	BookViewing(context.Context, *BookViewingRequest) (*BookViewingResponse, error)
+	CancelViewing(context.Context, *CancelViewingRequest) (*CancelViewingResponse, error)
+	RescheduleViewing(context.Context, *RescheduleViewingRequest) (*RescheduleViewingResponse, error)
+	mustEmbedUnimplementedViewingServiceServer()
+}
+
+// UnimplementedViewingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedViewingServiceServer struct{}
+
+func (UnimplementedViewingServiceServer) CreateViewingSlot(context.Context, *CreateViewingSlotRequest) (*CreateViewingSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateViewingSlot not implemented")
+}
+func (UnimplementedViewingServiceServer) DeleteViewingSlot(context.Context, *DeleteViewingSlotRequest) (*DeleteViewingSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteViewingSlot not implemented")
+}
+func (UnimplementedViewingServiceServer) ListViewingSlots(context.Context, *ListViewingSlotsRequest) (*ListViewingSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListViewingSlots not implemented")
+}
+func (UnimplementedViewingServiceServer) BookViewing(context.Context, *BookViewingRequest) (*BookViewingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookViewing not implemented")
+}
+func (UnimplementedViewingServiceServer) CancelViewing(context.Context, *CancelViewingRequest) (*CancelViewingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelViewing not implemented")
+}
+func (UnimplementedViewingServiceServer) RescheduleViewing(context.Context, *RescheduleViewingRequest) (*RescheduleViewingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleViewing not implemented")
+}
+func (UnimplementedViewingServiceServer) mustEmbedUnimplementedViewingServiceServer() {}
+func (UnimplementedViewingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeViewingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ViewingServiceServer will
+// result in compilation errors.
+type UnsafeViewingServiceServer interface {
+	mustEmbedUnimplementedViewingServiceServer()
+}
+
+func RegisterViewingServiceServer(s grpc.ServiceRegistrar, srv ViewingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedViewingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ViewingService_ServiceDesc, srv)
+}
+
+func _ViewingService_CreateViewingSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateViewingSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewingServiceServer).CreateViewingSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewingService_CreateViewingSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewingServiceServer).CreateViewingSlot(ctx, req.(*CreateViewingSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewingService_DeleteViewingSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteViewingSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewingServiceServer).DeleteViewingSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewingService_DeleteViewingSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewingServiceServer).DeleteViewingSlot(ctx, req.(*DeleteViewingSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewingService_ListViewingSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListViewingSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewingServiceServer).ListViewingSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewingService_ListViewingSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewingServiceServer).ListViewingSlots(ctx, req.(*ListViewingSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewingService_BookViewing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookViewingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewingServiceServer).BookViewing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewingService_BookViewing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewingServiceServer).BookViewing(ctx, req.(*BookViewingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewingService_CancelViewing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelViewingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewingServiceServer).CancelViewing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewingService_CancelViewing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewingServiceServer).CancelViewing(ctx, req.(*CancelViewingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewingService_RescheduleViewing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleViewingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewingServiceServer).RescheduleViewing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewingService_RescheduleViewing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewingServiceServer).RescheduleViewing(ctx, req.(*RescheduleViewingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ViewingService_ServiceDesc is the grpc.ServiceDesc for ViewingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ViewingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mygrpcservice.ViewingService",
+	HandlerType: (*ViewingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateViewingSlot",
+			Handler:    _ViewingService_CreateViewingSlot_Handler,
+		},
+		{
+			MethodName: "DeleteViewingSlot",
+			Handler:    _ViewingService_DeleteViewingSlot_Handler,
+		},
+		{
+			MethodName: "ListViewingSlots",
+			Handler:    _ViewingService_ListViewingSlots_Handler,
+		},
+		{
+			MethodName: "BookViewing",
+			Handler:    _ViewingService_BookViewing_Handler,
+		},
+		{
+			MethodName: "CancelViewing",
+			Handler:    _ViewingService_CancelViewing_Handler,
+		},
+		{
+			MethodName: "RescheduleViewing",
+			Handler:    _ViewingService_RescheduleViewing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewing_service.proto",
+}
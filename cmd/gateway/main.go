@@ -43,6 +43,12 @@ func main() {
 	if err := proto.RegisterPropertyServiceHandlerFromEndpoint(ctx, mux, *grpcServerEndpoint, opts); err != nil {
 		log.Fatalf("Failed to register property service HTTP handler: %v", err)
 	}
+	if err := proto.RegisterViewingServiceHandlerFromEndpoint(ctx, mux, *grpcServerEndpoint, opts); err != nil {
+		log.Fatalf("Failed to register viewing service HTTP handler: %v", err)
+	}
+	if err := proto.RegisterOfferServiceHandlerFromEndpoint(ctx, mux, *grpcServerEndpoint, opts); err != nil {
+		log.Fatalf("Failed to register offer service HTTP handler: %v", err)
+	}
 	log.Println("Starting grpc-gateway on :8080")
 	if err := http.ListenAndServe("0.0.0.0:8080", mux); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
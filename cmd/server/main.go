@@ -37,6 +37,12 @@ func main() {
 	propService := &transport.MyPropertyService{
 		AppService: portService,
 	}
+	viewingService := &transport.MyViewingService{
+		AppService: portService,
+	}
+	offerService := &transport.MyOfferService{
+		AppService: portService,
+	}
 
 	// Start gRPC server.
 	lis, err := net.Listen("tcp", ":50051")
@@ -48,6 +54,8 @@ func main() {
 	)
 	proto.RegisterOwnerServiceServer(grpcServer, ownerService)
 	proto.RegisterPropertyServiceServer(grpcServer, propService)
+	proto.RegisterViewingServiceServer(grpcServer, viewingService)
+	proto.RegisterOfferServiceServer(grpcServer, offerService)
 	logger.Info("Server started on :50051")
 	if err := grpcServer.Serve(lis); err != nil {
 		logger.Fatal("failed to serve: %v", err)
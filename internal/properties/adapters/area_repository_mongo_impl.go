@@ -0,0 +1,205 @@
+package adapters
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"property-service/internal/properties/domain/area"
+	"property-service/pkg/address"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Verify that AreaRepositoryMongoImpl implements area.Repository.
+var _ area.Repository = (*AreaRepositoryMongoImpl)(nil)
+
+type AreaRepositoryMongoImpl struct {
+	log  log.Logger
+	area database.FinderInserterUpdaterRemover[
+		bson.M,
+		bson.M,
+		area.Area,
+	]
+	factory    area.Factory[uuid.UUID]
+	aggregator database.Grouper[mongo.Pipeline, area.Area]
+}
+
+func NewMongoAreaRepository(
+	log log.Logger,
+	area database.FinderInserterUpdaterRemover[bson.M, bson.M, area.Area],
+	factory area.Factory[uuid.UUID],
+	aggregator database.Grouper[mongo.Pipeline, area.Area],
+) *AreaRepositoryMongoImpl {
+	return &AreaRepositoryMongoImpl{
+		log:        log,
+		area:       area,
+		factory:    factory,
+		aggregator: aggregator,
+	}
+}
+
+// Save implements area.Repository, an empty kind keeps the kind of an existing area. The area
+// is written with a single upsert on its unique key so repeated or concurrent imports of the
+// same area never store it twice.
+func (p *AreaRepositoryMongoImpl) Save(
+	c context.Context,
+	params area.NewAreaParams,
+) (*area.Area, bool, error) {
+	key := area.Key(params.Name)
+	p.log.Debug("Saving area with key: %s", key)
+	if err := params.Boundary.Validate(); err != nil {
+		return nil, false, errors.NewInvalidArgumentError(err)
+	}
+	// The factory validates the area and gives it the ID it is stored with when it is new.
+	newArea, err := p.factory.New(params)
+	if err != nil {
+		return nil, false, errors.NewInvalidArgumentError(err)
+	}
+	model, err := p.factory.ToDatabase(*newArea)
+	if err != nil {
+		return nil, false, errors.NewInvalidArgumentError(err)
+	}
+
+	updateData := bson.M{
+		"Name":               params.Name,
+		"Boundary":           params.Boundary,
+		"Metadata.UpdatedAt": primitive.NewDateTimeFromTime(time.Now()),
+	}
+	insertData := bson.M{
+		"_id":                model.ID,
+		"Metadata.CreatedAt": primitive.NewDateTimeFromTime(model.Metadata.CreatedAt),
+	}
+	if params.Kind != "" {
+		updateData["Kind"] = params.Kind
+	} else {
+		insertData["Kind"] = ""
+	}
+	existing, err := p.area.UpsertAndFind(
+		c,
+		bson.M{"Key": key},
+		bson.M{"$set": updateData, "$setOnInsert": insertData},
+	)
+	if err != nil {
+		return nil, false, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if existing == nil {
+		return newArea, true, nil
+	}
+
+	changed := !reflect.DeepEqual(existing.Boundary.Coordinates, params.Boundary.Coordinates)
+	existing.Name = params.Name
+	existing.Boundary = params.Boundary
+	if params.Kind != "" {
+		existing.Kind = params.Kind
+	}
+	return existing, changed, nil
+}
+
+// MigrateDuplicateKeys removes the areas stored twice by the imports before the area keys
+// were unique, the most recently updated area of a key is kept.
+func (p *AreaRepositoryMongoImpl) MigrateDuplicateKeys(c context.Context) (int64, error) {
+	areas, err := p.find(c, bson.D{})
+	if err != nil {
+		return 0, err
+	}
+	kept := make(map[string]area.Area, len(areas))
+	duplicates := bson.A{}
+	for _, found := range areas {
+		previous, ok := kept[found.Key]
+		if !ok {
+			kept[found.Key] = found
+			continue
+		}
+		removed := found
+		if found.Metadata.UpdatedAt().After(previous.Metadata.UpdatedAt()) {
+			kept[found.Key] = found
+			removed = previous
+		}
+		id, err := database.StringToID(removed.ID)
+		if err != nil {
+			return 0, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		duplicates = append(duplicates, id)
+	}
+	if len(duplicates) == 0 {
+		return 0, nil
+	}
+	count, err := p.area.DeleteMany(c, bson.M{"_id": bson.M{"$in": duplicates}})
+	if err != nil {
+		return 0, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return count, nil
+}
+
+// GetByKey implements area.Repository.
+func (p *AreaRepositoryMongoImpl) GetByKey(c context.Context, key string) (*area.Area, error) {
+	p.log.Debug("Fetching area with key: %s", key)
+	found, err := p.find(c, bson.D{{Key: "Key", Value: key}})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, errors.NewHandlerError(
+			area.ErrAreaNotFound,
+			codes.NotFound,
+		)
+	}
+	return &found[0], nil
+}
+
+// ListContaining implements area.Repository.
+func (p *AreaRepositoryMongoImpl) ListContaining(
+	c context.Context,
+	point address.GeoJSONCoordinates,
+) ([]area.Area, error) {
+	return p.find(c, bson.D{{Key: "Boundary", Value: bson.D{
+		{Key: "$geoIntersects", Value: bson.D{
+			{Key: "$geometry", Value: bson.D{
+				{Key: "type", Value: "Point"},
+				{Key: "coordinates", Value: point.Coordinates},
+			}},
+		}},
+	}}})
+}
+
+// find returns the areas matching a filter, by key.
+func (p *AreaRepositoryMongoImpl) find(c context.Context, filter bson.D) ([]area.Area, error) {
+	res, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "Key", Value: 1}}}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	return *finalRes, nil
+}
@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Verify that CalendarRepositoryMongoImpl implements calendar.Repository.
+var _ calendar.Repository = (*CalendarRepositoryMongoImpl)(nil)
+
+type CalendarRepositoryMongoImpl struct {
+	log      log.Logger
+	calendar database.FinderInserterUpdaterRemover[
+		bson.M,
+		bson.M,
+		calendar.Calendar,
+	]
+	factory    calendar.Factory[uuid.UUID]
+	aggregator database.Grouper[mongo.Pipeline, calendar.Calendar]
+}
+
+func NewMongoCalendarRepository(
+	log log.Logger,
+	calendar database.FinderInserterUpdaterRemover[bson.M, bson.M, calendar.Calendar],
+	factory calendar.Factory[uuid.UUID],
+	aggregator database.Grouper[mongo.Pipeline, calendar.Calendar],
+) *CalendarRepositoryMongoImpl {
+	return &CalendarRepositoryMongoImpl{
+		log:        log,
+		calendar:   calendar,
+		factory:    factory,
+		aggregator: aggregator,
+	}
+}
+
+// Get implements calendar.Repository.
+func (p *CalendarRepositoryMongoImpl) Get(c context.Context, propertyID string) (*calendar.Calendar, error) {
+	p.log.Debug("Fetching calendar of property: %s", propertyID)
+	found, err := p.find(c, bson.D{{Key: "PropertyID", Value: propertyID}}, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) > 0 {
+		return &found[0], nil
+	}
+	newCalendar, err := p.factory.New(calendar.NewCalendarParams{PropertyID: propertyID})
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return newCalendar, nil
+}
+
+// Save implements calendar.Repository, a new calendar is inserted and the unique index on the
+// property ID rejects a second one. A stored calendar is only replaced when its version is
+// still the one that was read.
+func (p *CalendarRepositoryMongoImpl) Save(c context.Context, propertyCalendar *calendar.Calendar) error {
+	p.log.Debug("Saving calendar of property: %s", propertyCalendar.PropertyID)
+	if propertyCalendar.Version == 0 {
+		stored := *propertyCalendar
+		stored.Version = 1
+		if _, err := p.calendar.InsertOne(c, stored); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return errors.NewHandlerError(
+					calendar.ErrCalendarChanged,
+					codes.Aborted,
+				)
+			}
+			return errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		propertyCalendar.Version = stored.Version
+		return nil
+	}
+
+	count, err := p.calendar.UpdateMany(
+		c,
+		bson.M{"PropertyID": propertyCalendar.PropertyID, "Version": propertyCalendar.Version},
+		bson.M{
+			"$set": bson.M{"Ranges": propertyCalendar.Ranges, "Metadata.UpdatedAt": time.Now()},
+			"$inc": bson.M{"Version": 1},
+		},
+	)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			calendar.ErrCalendarChanged,
+			codes.Aborted,
+		)
+	}
+	propertyCalendar.Version++
+	return nil
+}
+
+// ListUnavailable implements calendar.Repository.
+func (p *CalendarRepositoryMongoImpl) ListUnavailable(c context.Context, period calendar.Period) ([]string, error) {
+	found, err := p.find(c, bson.D{{Key: "Ranges", Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+		{Key: "Start", Value: bson.D{{Key: "$lt", Value: period.End}}},
+		{Key: "End", Value: bson.D{{Key: "$gt", Value: period.Start}}},
+	}}}}}, calendar.MaxUnavailable+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) > calendar.MaxUnavailable {
+		return nil, errors.NewInvalidArgumentError(calendar.ErrTooManyUnavailable)
+	}
+	ids := make([]string, 0, len(found))
+	for _, propertyCalendar := range found {
+		ids = append(ids, propertyCalendar.PropertyID)
+	}
+	return ids, nil
+}
+
+// find returns at most limit calendars matching a filter, by property ID.
+func (p *CalendarRepositoryMongoImpl) find(c context.Context, filter bson.D, limit int) ([]calendar.Calendar, error) {
+	res, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "PropertyID", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	return *finalRes, nil
+}
@@ -0,0 +1,227 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Verify that OfferRepositoryMongoImpl implements offer.Repository.
+var _ offer.Repository = (*OfferRepositoryMongoImpl)(nil)
+
+type OfferRepositoryMongoImpl struct {
+	log   log.Logger
+	offer database.FinderInserterUpdaterRemover[
+		bson.M,
+		bson.M,
+		offer.Offer,
+	]
+	factory    offer.Factory[uuid.UUID]
+	aggregator database.Grouper[mongo.Pipeline, offer.Offer]
+	session    database.Session[database.SessionReceiver]
+	properties property.Repository
+}
+
+func NewMongoOfferRepository(
+	log log.Logger,
+	offer database.FinderInserterUpdaterRemover[bson.M, bson.M, offer.Offer],
+	factory offer.Factory[uuid.UUID],
+	aggregator database.Grouper[mongo.Pipeline, offer.Offer],
+	session database.Session[database.SessionReceiver],
+	properties property.Repository,
+) *OfferRepositoryMongoImpl {
+	return &OfferRepositoryMongoImpl{
+		log:        log,
+		offer:      offer,
+		factory:    factory,
+		aggregator: aggregator,
+		session:    session,
+		properties: properties,
+	}
+}
+
+// New implements offer.Repository.
+func (p *OfferRepositoryMongoImpl) New(
+	c context.Context,
+	params offer.NewOfferParams,
+) (*offer.Offer, error) {
+	p.log.Debug("Submitting new offer on property: %s", params.PropertyID)
+	newOffer, err := p.factory.New(params)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if _, err := p.offer.InsertOne(c, *newOffer); err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return newOffer, nil
+}
+
+// Get implements offer.Repository.
+func (p *OfferRepositoryMongoImpl) Get(c context.Context, ID string) (*offer.Offer, error) {
+	p.log.Debug("Fetching offer with ID: %s", ID)
+	id, err := p.id(ID)
+	if err != nil {
+		return nil, err
+	}
+	found, err := p.find(c, bson.D{{Key: "_id", Value: id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, errors.NewHandlerError(
+			errors.Join(offer.ErrNotFound, errors.NewSimple(ID)),
+			codes.NotFound,
+		)
+	}
+	return &found[0], nil
+}
+
+// ListByProperty implements offer.Repository.
+func (p *OfferRepositoryMongoImpl) ListByProperty(
+	c context.Context,
+	propertyID string,
+	statuses []offer.Status,
+) ([]offer.Offer, error) {
+	p.log.Debug("Listing offers on property: %s", propertyID)
+	filter := bson.D{{Key: "PropertyID", Value: propertyID}}
+	if len(statuses) > 0 {
+		filter = append(filter, bson.E{Key: "Status", Value: bson.D{{Key: "$in", Value: statuses}}})
+	}
+	return p.find(c, filter)
+}
+
+// Respond implements offer.Repository, the status is only changed when it is still the from
+// status so the owner cannot answer an offer twice.
+func (p *OfferRepositoryMongoImpl) Respond(
+	c context.Context,
+	id string,
+	from offer.Status,
+	to offer.Status,
+	counter *offer.Counter,
+) error {
+	p.log.Debug("Moving offer %s from %s to %s", id, from, to)
+	oid, err := p.id(id)
+	if err != nil {
+		return err
+	}
+	updateData := bson.M{"Status": to, "Metadata.UpdatedAt": time.Now()}
+	if counter != nil {
+		updateData["Counter"] = counter
+	}
+	count, err := p.offer.UpdateMany(
+		c,
+		bson.M{"_id": oid, "Status": from},
+		bson.M{"$set": updateData},
+	)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			offer.ErrOfferChanged,
+			codes.FailedPrecondition,
+		)
+	}
+	return nil
+}
+
+// Accept implements offer.Repository, the writes run in a session transaction so a listing
+// can never end up under offer without its accepted offer or with two accepted offers.
+func (p *OfferRepositoryMongoImpl) Accept(c context.Context, accepted offer.Offer) error {
+	p.log.Debug("Accepting offer %s on property %s", accepted.ID, accepted.PropertyID)
+	oid, err := p.id(accepted.ID)
+	if err != nil {
+		return err
+	}
+	err = p.session.Execute(c, func(sc mongo.SessionContext) (interface{}, error) {
+		// The repository errors keep their code, FailedPrecondition when the offer or the
+		// listing status changed.
+		if err := p.Respond(sc, accepted.ID, offer.Pending, offer.Accepted, nil); err != nil {
+			return nil, err
+		}
+		declined, err := p.offer.UpdateMany(
+			sc,
+			bson.M{
+				"PropertyID": accepted.PropertyID,
+				"_id":        bson.M{"$ne": oid},
+				"Status":     bson.M{"$in": offer.OpenStatuses},
+			},
+			bson.M{"$set": bson.M{"Status": offer.Declined, "Metadata.UpdatedAt": time.Now()}},
+		)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		p.log.Debug("Declined %d other offers on property %s", declined, accepted.PropertyID)
+		if err := p.properties.UpdateStatus(
+			sc, accepted.PropertyID, property.Published, property.UnderOffer,
+		); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	var appErr errors.AppError
+	if err != nil && !errors.AsAppError(err, &appErr) {
+		// A failed commit or session is not an application error yet.
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return err
+}
+
+// id returns the stored form of an offer ID, the offers are stored with the UUID of the factory.
+func (p *OfferRepositoryMongoImpl) id(ID string) (uuid.UUID, error) {
+	id, err := database.StringToID(ID)
+	if err != nil {
+		return uuid.Nil, errors.NewInvalidArgumentError(err)
+	}
+	return id, nil
+}
+
+// find returns the offers matching a filter, the newest first.
+func (p *OfferRepositoryMongoImpl) find(c context.Context, filter bson.D) ([]offer.Offer, error) {
+	res, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "Metadata.CreatedAt", Value: -1}, {Key: "_id", Value: 1}}}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	return *finalRes, nil
+}
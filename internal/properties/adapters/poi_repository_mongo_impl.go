@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"property-service/internal/properties/domain/poi"
+	"property-service/pkg/address"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Verify that POIRepositoryMongoImpl implements poi.Repository.
+var _ poi.Repository = (*POIRepositoryMongoImpl)(nil)
+
+type POIRepositoryMongoImpl struct {
+	log log.Logger
+	poi database.FinderInserterUpdaterRemover[
+		bson.M,
+		bson.M,
+		poi.POI,
+	]
+	factory    poi.Factory[uuid.UUID]
+	aggregator database.Grouper[mongo.Pipeline, poi.POI]
+	session    database.Session[database.SessionReceiver]
+}
+
+func NewMongoPOIRepository(
+	log log.Logger,
+	poi database.FinderInserterUpdaterRemover[bson.M, bson.M, poi.POI],
+	factory poi.Factory[uuid.UUID],
+	aggregator database.Grouper[mongo.Pipeline, poi.POI],
+	session database.Session[database.SessionReceiver],
+) *POIRepositoryMongoImpl {
+	return &POIRepositoryMongoImpl{
+		log:        log,
+		poi:        poi,
+		factory:    factory,
+		aggregator: aggregator,
+		session:    session,
+	}
+}
+
+// Replace implements poi.Repository, the points are swapped in a session transaction so the
+// readers never see a partial dataset and the replicas loading the dataset at the same time
+// conflict and retry instead of interleaving.
+func (p *POIRepositoryMongoImpl) Replace(
+	c context.Context,
+	dataset string,
+	params []poi.NewPOIParams,
+) (int, bool, error) {
+	p.log.Debug("Replacing the points of interest with %d points of dataset %s", len(params), dataset)
+	// Every point is validated before the stored ones are removed.
+	pois := make([]poi.POI, 0, len(params))
+	for i, param := range params {
+		newPOI, err := p.factory.New(param)
+		if err != nil {
+			return 0, false, errors.NewInvalidArgumentError(err)
+		}
+		// The IDs follow the position in the dataset, two loads insert the same first ID
+		// and so conflict even when the collection was empty.
+		newPOI.ID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("poi/%d", i))).String()
+		newPOI.Dataset = dataset
+		pois = append(pois, *newPOI)
+	}
+
+	var changed bool
+	err := p.session.Execute(c, func(sc mongo.SessionContext) (interface{}, error) {
+		// Set on every attempt, a transaction retried after a conflict sees the other load.
+		changed = false
+		loaded, err := p.poi.Count(sc, bson.M{"Dataset": dataset})
+		if err != nil {
+			return nil, err
+		}
+		others, err := p.poi.Count(sc, bson.M{"Dataset": bson.M{"$ne": dataset}})
+		if err != nil {
+			return nil, err
+		}
+		if loaded == int64(len(pois)) && others == 0 {
+			return nil, nil
+		}
+		if _, err := p.poi.DeleteMany(sc, bson.M{}); err != nil {
+			return nil, err
+		}
+		if _, err := p.poi.InsertMany(sc, pois); err != nil {
+			return nil, err
+		}
+		changed = true
+		return nil, nil
+	})
+	if err != nil {
+		return 0, false, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return len(pois), changed, nil
+}
+
+// NearestPerType implements poi.Repository.
+func (p *POIRepositoryMongoImpl) NearestPerType(
+	c context.Context,
+	point address.GeoJSONCoordinates,
+	maxDistance float64,
+) ([]poi.POI, error) {
+	res, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		// $geoNear must be the first stage and already sorts nearest first.
+		bson.D{{Key: "$geoNear", Value: bson.D{
+			{Key: "near", Value: bson.D{
+				{Key: "type", Value: "Point"},
+				{Key: "coordinates", Value: point.Coordinates},
+			}},
+			{Key: "key", Value: "Location"},
+			{Key: "distanceField", Value: "Distance"},
+			{Key: "maxDistance", Value: maxDistance},
+			{Key: "spherical", Value: true},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$Type"},
+			{Key: "nearest", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		bson.D{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$nearest"}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "Type", Value: 1}}}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	return *finalRes, nil
+}
@@ -117,13 +117,15 @@ func (c *CachedPropertyRepository) ListByCategory(
 	ctx context.Context,
 
 	category string,
-	sort uint8,
+	area string,
+	excluded []string,
+	sort property.Sort,
 	limit uint16,
 	paginationToken string,
 	search uint8,
 ) ([]property.Property, error) {
 	// Construct a cache key that uniquely identifies the query.
-	key := fmt.Sprintf("list:%s:%s:%d:%d", category, paginationToken, sort, limit)
+	key := fmt.Sprintf("list:%s:%s:%v:%s:%s:%d:%d", category, area, excluded, paginationToken, sort, limit, search)
 
 	// Attempt to get the cached list from Redis.
 	cachedData, err := c.redisAdapter.cacher.KeyGet(ctx, key)
@@ -137,7 +139,7 @@ func (c *CachedPropertyRepository) ListByCategory(
 	}
 
 	// Retrieve the list from the primary repository (Mongo).
-	props, err := c.baseRepo.ListByCategory(ctx, category, sort, limit, paginationToken, search)
+	props, err := c.baseRepo.ListByCategory(ctx, category, area, excluded, sort, limit, paginationToken, search)
 	if err != nil {
 		return nil, err
 	}
@@ -2,15 +2,25 @@ package adapters
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
 
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
+	"property-service/pkg/address"
 	"property-service/pkg/errors"
 	"property-service/pkg/errors/codes"
 	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -35,6 +45,7 @@ type PropertyRepositoryMongoImpl struct {
 	paginationHelper database.PaginationHelper
 	factory          property.Factory[uuid.UUID]
 	aggregator       database.Grouper[mongo.Pipeline, property.Property]
+	rawAggregator    database.Grouper[mongo.Pipeline, bson.M]
 }
 
 func NewMongoPropertyRepository(
@@ -42,14 +53,17 @@ func NewMongoPropertyRepository(
 	property database.FinderInserterUpdaterRemover[bson.M, bson.M, property.Property],
 	factory property.Factory[uuid.UUID],
 	aggregator database.Grouper[mongo.Pipeline, property.Property],
+	rawAggregator database.Grouper[mongo.Pipeline, bson.M],
+	paginationHelper database.PaginationHelper,
 ) *PropertyRepositoryMongoImpl {
 	return &PropertyRepositoryMongoImpl{
 		log:              log,
 		property:         property,
 		queryHelper:      database.NewMongoQueryHelper(),
-		paginationHelper: &database.PaginationHelperMongoImpl{},
+		paginationHelper: paginationHelper,
 		factory:          factory,
 		aggregator:       aggregator,
+		rawAggregator:    rawAggregator,
 	}
 }
 
@@ -135,9 +149,37 @@ func (p *PropertyRepositoryMongoImpl) Update(
 	}
 	if !params.Address.IsEmpty() {
 		updateData["Address"] = params.Address
+		// The area tags and nearby points of interest follow the address, none are left when
+		// it moves out of every area or away from every point.
+		areas := params.Areas
+		if areas == nil {
+			areas = []string{}
+		}
+		updateData["Areas"] = areas
+		nearby := params.Nearby
+		if nearby == nil {
+			nearby = map[string]property.NearbyPOI{}
+		}
+		updateData["Nearby"] = nearby
 	}
+	removeData := bson.M{}
 	if params.SaleType != 0 {
 		updateData["SaleType"] = params.SaleType
+		// The prices follow the sale type, the price of a sale type the property is no
+		// longer for is removed.
+		if params.Rent != nil {
+			updateData["Rent"] = property.NewRent(params.Rent.Price, params.Rent.Period)
+		} else {
+			removeData["Rent"] = ""
+		}
+		if params.AskingPrice != nil {
+			updateData["AskingPrice"] = money.New(params.AskingPrice.Amount, params.AskingPrice.Currency)
+		} else {
+			removeData["AskingPrice"] = ""
+		}
+	}
+	if params.Attributes != nil {
+		updateData["Attributes"] = property.NewAttributes(*params.Attributes)
 	}
 
 	if len(updateData) == 0 {
@@ -146,6 +188,9 @@ func (p *PropertyRepositoryMongoImpl) Update(
 	updateFields := bson.M{
 		"$set": updateData,
 	}
+	if len(removeData) > 0 {
+		updateFields["$unset"] = removeData
+	}
 	err := p.property.UpdateOneByID(c, id, updateFields)
 	if err != nil {
 		return errors.NewHandlerError(
@@ -157,39 +202,600 @@ func (p *PropertyRepositoryMongoImpl) Update(
 
 }
 
+// UpdateStatus implements property.Repository, the status is only changed when it is still
+// the from status so concurrent transitions cannot both succeed.
+func (p *PropertyRepositoryMongoImpl) UpdateStatus(
+	c context.Context,
+
+	id string,
+	from property.Status,
+	to property.Status,
+) error {
+	p.log.Debug("Moving property %s from %s to %s", id, from, to)
+	uid, err := database.StringToID(id)
+	if err != nil {
+		return errors.NewInvalidArgumentError(err)
+	}
+	count, err := p.property.UpdateMany(
+		c,
+		bson.M{"_id": uid, "Status": from},
+		bson.M{"$set": bson.M{"Status": to, "Metadata.UpdatedAt": time.Now()}},
+	)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			property.ErrStatusChanged,
+			codes.FailedPrecondition,
+		)
+	}
+	return nil
+}
+
+// MigrateAvailability gives the properties stored before the listing statuses a status, an
+// available property is published and any other withdrawn, and removes their Available flag.
+func (p *PropertyRepositoryMongoImpl) MigrateAvailability(c context.Context) (int64, error) {
+	var migrated int64
+	for available, status := range map[bool]property.Status{
+		true:  property.Published,
+		false: property.Withdrawn,
+	} {
+		count, err := p.property.UpdateMany(
+			c,
+			bson.M{"Status": bson.M{"$exists": false}, "Available": available},
+			bson.M{"$set": bson.M{"Status": status}, "$unset": bson.M{"Available": ""}},
+		)
+		if err != nil {
+			return migrated, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		migrated += count
+	}
+	return migrated, nil
+}
+
+// MigrateGeoJSON rewrites the coordinates stored before the GeoJSON fields were renamed to
+// type and coordinates. They were stored as [latitude, longitude] and are swapped into the
+// [longitude, latitude] order of GeoJSON, a pair that is not a latitude and longitude is
+// removed so the address is geocoded on its next update. The geo index cannot be built while
+// a property has the old fields.
+func (p *PropertyRepositoryMongoImpl) MigrateGeoJSON(c context.Context) (int64, error) {
+	res, aggErr := p.rawAggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "Address.GeoJSON.Coordinates", Value: bson.D{{Key: "$exists", Value: true}}},
+		}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 1},
+			{Key: "Coordinates", Value: "$Address.GeoJSON.Coordinates"},
+		}}},
+	})
+	if aggErr != nil {
+		return 0, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+	defer res.Close(c)
+
+	var migrated int64
+	for {
+		hasNext, doc, err := res.GetNext(c)
+		if !hasNext {
+			break
+		}
+		if err != nil {
+			return migrated, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		// The stored _id is matched as read, whatever its type.
+		var legacy struct {
+			ID          interface{} `bson:"_id"`
+			Coordinates [2]float64  `bson:"Coordinates"`
+		}
+		raw, err := bson.Marshal(doc)
+		if err == nil {
+			err = bson.Unmarshal(raw, &legacy)
+		}
+		if err != nil {
+			return migrated, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		lat, lng := legacy.Coordinates[0], legacy.Coordinates[1]
+		update := bson.M{"$unset": bson.M{"Address.GeoJSON": ""}}
+		if math.Abs(lat) <= 90 && math.Abs(lng) <= 180 {
+			update = bson.M{"$set": bson.M{"Address.GeoJSON": address.NewPoint(lat, lng)}}
+		}
+		// Matching the old field again leaves a property migrated by another replica as it is.
+		count, err := p.property.UpdateMany(
+			c,
+			bson.M{"_id": legacy.ID, "Address.GeoJSON.Coordinates": bson.M{"$exists": true}},
+			update,
+		)
+		if err != nil {
+			return migrated, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		migrated += count
+	}
+	return migrated, nil
+}
+
+// TagArea implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) TagArea(
+	c context.Context,
+
+	key string,
+	boundary address.GeoJSONMultiPolygon,
+) error {
+	p.log.Debug("Tagging properties with area: %s", key)
+	// The tag is removed first so the properties left outside a changed boundary lose it.
+	if _, err := p.property.UpdateMany(
+		c,
+		bson.M{"Areas": key},
+		bson.M{"$pull": bson.M{"Areas": key}},
+	); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if _, err := p.property.UpdateMany(
+		c,
+		bson.M{"Address.GeoJSON": bson.M{"$geoIntersects": bson.M{"$geometry": boundary}}},
+		bson.M{"$addToSet": bson.M{"Areas": key}},
+	); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return nil
+}
+
+// RefreshNearby implements property.Repository, the properties are read one at a time so the
+// whole catalogue is never held in memory.
+func (p *PropertyRepositoryMongoImpl) RefreshNearby(
+	c context.Context,
+	nearest func(c context.Context, point address.GeoJSONCoordinates) (map[string]property.NearbyPOI, error),
+) (int64, error) {
+	p.log.Debug("Refreshing the nearest points of interest of the properties")
+	res, aggErr := p.rawAggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "Address.GeoJSON", Value: bson.D{{Key: "$ne", Value: nil}}}}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 1},
+			{Key: "GeoJSON", Value: "$Address.GeoJSON"},
+		}}},
+	})
+	if aggErr != nil {
+		return 0, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+	defer res.Close(c)
+
+	var refreshed int64
+	for {
+		hasNext, doc, err := res.GetNext(c)
+		if !hasNext {
+			break
+		}
+		if err != nil {
+			return refreshed, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		// The stored _id is matched as read, whatever its type.
+		var located struct {
+			ID      interface{}                `bson:"_id"`
+			GeoJSON address.GeoJSONCoordinates `bson:"GeoJSON"`
+		}
+		raw, err := bson.Marshal(doc)
+		if err == nil {
+			err = bson.Unmarshal(raw, &located)
+		}
+		if err != nil {
+			return refreshed, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		nearby, err := nearest(c, located.GeoJSON)
+		if err != nil {
+			return refreshed, err
+		}
+		update := bson.M{"$unset": bson.M{"Nearby": ""}}
+		if len(nearby) > 0 {
+			update = bson.M{"$set": bson.M{"Nearby": nearby}}
+		}
+		count, err := p.property.UpdateMany(c, bson.M{"_id": located.ID}, update)
+		if err != nil {
+			return refreshed, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		refreshed += count
+	}
+	return refreshed, nil
+}
+
 // ListByCategory implements property.Repository.
 func (p *PropertyRepositoryMongoImpl) ListByCategory(
 	c context.Context,
 
 	category string,
-	sort uint8,
+	area string,
+	excluded []string,
+	sort property.Sort,
 	limit uint16,
 	paginationToken string,
 	search uint8,
 ) ([]property.Property, error) {
-	sortSpec := bson.D{
-		{Key: "Title", Value: sort},
+	sortSpec, err := toSortSpec(sort)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := p.textInAreaFilter("Category", category, area, nil, excluded, sortSpec, search, paginationToken)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
 	}
-	filter, err := p.paginationHelper.TextPaginationHelper(
+
+	return p.list(c, filter, sortSpec, limit, search)
+}
+
+// CountByCategory implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) CountByCategory(
+	c context.Context,
+
+	category string,
+	area string,
+	excluded []string,
+) (int64, error) {
+	filter, err := p.textInAreaFilter("Category", category, area, nil, excluded, nil, 0, "")
+	if err != nil {
+		return 0, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return p.count(c, filter)
+}
+
+func (p *PropertyRepositoryMongoImpl) ListByOwner(
+	c context.Context,
+
+	ownerID string,
+	area string,
+	statuses []property.Status,
+	excluded []string,
+	sort property.Sort,
+	limit uint16,
+	paginationToken string,
+	search uint8,
+) ([]property.Property, error) {
+	sortSpec, err := toSortSpec(sort)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := p.textInAreaFilter("OwnerID", ownerID, area, statuses, excluded, sortSpec, search, paginationToken)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+
+	return p.list(c, filter, sortSpec, limit, search)
+}
+
+// CountByOwner implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) CountByOwner(
+	c context.Context,
+
+	ownerID string,
+	area string,
+	statuses []property.Status,
+	excluded []string,
+) (int64, error) {
+	filter, err := p.textInAreaFilter("OwnerID", ownerID, area, statuses, excluded, nil, 0, "")
+	if err != nil {
+		return 0, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return p.count(c, filter)
+}
+
+// textInAreaFilter builds the filter stages of the properties in any of the statuses whose
+// path has the value, limited to the properties tagged with the named area when it is not
+// empty and leaving out the excluded IDs.
+func (p *PropertyRepositoryMongoImpl) textInAreaFilter(
+	path string,
+	value string,
+	areaName string,
+	statuses []property.Status,
+	excluded []string,
+	sortSpec bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	clauses := []database.SearchClause{
+		{Operator: "text", Path: path, Options: bson.D{{Key: "query", Value: value}}},
+		statusClause(statuses),
+	}
+	if areaName != "" {
+		clauses = append(clauses, areaClause(areaName))
+	}
+	clauses = append(clauses, excludedClauses(excluded)...)
+	return p.paginationHelper.CompoundPaginationHelper(
 		"default",
-		"Category",
-		category,
+		clauses,
 		sortSpec,
 		search,
 		paginationToken,
 	)
-	if err != nil {
+}
+
+// statusClause matches the properties in any of the statuses, the published properties when
+// there are none.
+func statusClause(statuses []property.Status) database.SearchClause {
+	values := bson.A{}
+	for _, status := range statuses {
+		values = append(values, int(status))
+	}
+	if len(values) == 0 {
+		values = append(values, int(property.Published))
+	}
+	return database.SearchClause{
+		Operator: "in", Path: "Status",
+		Options: bson.D{{Key: "value", Value: values}},
+	}
+}
+
+// areaClause matches the properties tagged with a named area, by its key or name.
+func areaClause(areaName string) database.SearchClause {
+	return database.SearchClause{
+		Operator: "in", Path: "Areas",
+		Options: bson.D{{Key: "value", Value: []string{area.Key(areaName)}}},
+	}
+}
+
+// list runs a paginated aggregation, stages are the filter stages built by the pagination
+// helper and every listed property gets the token of its position.
+func (p *PropertyRepositoryMongoImpl) list(
+	c context.Context,
+
+	stages mongo.Pipeline,
+	sortSpec bson.D,
+	limit uint16,
+	search uint8,
+) ([]property.Property, error) {
+	pipeline := append(stages, bson.D{{Key: "$limit", Value: limit}})
+	pipeline = append(pipeline, p.paginationHelper.PageStages(sortSpec, search)...)
+	pipeline = append(pipeline, bson.D{{Key: "$project", Value: bson.D{
+		{Key: "_id", Value: 1},
+		{Key: "OwnerID", Value: 1},
+		{Key: "Description", Value: 1},
+		{Key: "Title", Value: 1},
+		{Key: "Category", Value: 1},
+		{Key: "Status", Value: 1},
+		{Key: "AvailableDate", Value: 1},
+		{Key: "Address", Value: 1},
+		{Key: "SaleType", Value: 1},
+		{Key: "Highlights", Value: 1},
+		{Key: "Areas", Value: 1},
+		{Key: "Nearby", Value: 1},
+		{Key: "Rent", Value: 1},
+		{Key: "AskingPrice", Value: 1},
+		{Key: "Attributes", Value: 1},
+		{Key: "Metadata", Value: 1}, // Read by the keyset token of the created_at and updated_at sorts.
+		{Key: "PaginationToken", Value: 1},
+	}}})
+
+	res, aggErr := p.aggregator.Aggregate(c, pipeline)
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
 		return nil, errors.NewHandlerError(
-			err,
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+
+	properties := *finalRes
+	for i := range properties {
+		token, err := p.paginationHelper.PageToken(
+			properties[i].PaginationToken,
+			sortValues(properties[i], sortSpec),
+			properties[i].ID,
+		)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		properties[i].PaginationToken = token
+	}
+	return properties, nil
+}
+
+// count runs the count of the documents matched by filter stages.
+func (p *PropertyRepositoryMongoImpl) count(c context.Context, stages mongo.Pipeline) (int64, error) {
+	res, aggErr := p.rawAggregator.Aggregate(c, p.paginationHelper.CountStages(stages))
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return 0, errors.NewHandlerError(
+			aggErr,
 			codes.Internal,
 		)
 	}
 
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return 0, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	// No document is returned when nothing matches.
+	if len(*finalRes) == 0 {
+		return 0, nil
+	}
+	switch count := (*finalRes)[0]["count"].(type) {
+	case int32:
+		return int64(count), nil
+	case int64:
+		return count, nil
+	}
+	return 0, nil
+}
+
+// sortFields maps the sort fields onto the document paths.
+var sortFields = map[property.SortField]string{
+	property.SortByTitle:         "Title",
+	property.SortByAvailableDate: "AvailableDate",
+	property.SortBySaleType:      "SaleType",
+	property.SortByCreatedAt:     "Metadata.CreatedAt",
+	property.SortByUpdatedAt:     "Metadata.UpdatedAt",
+	property.SortByRent:          "Rent.MonthlyAmount",
+	property.SortByAskingPrice:   "AskingPrice.Amount",
+}
+
+// toSortSpec converts a sort into a Mongo sort, Title and _id are added as tie breakers
+// so the order is deterministic. An empty sort orders by title.
+func toSortSpec(sort property.Sort) (bson.D, error) {
+	sortSpec := bson.D{}
+	hasTitle := false
+	for _, order := range sort {
+		path, ok := sortFields[order.Field]
+		if !ok {
+			return nil, errors.NewInvalidArgumentError(
+				errors.Join(property.ErrUnsupportedSortField, errors.NewSimple(string(order.Field))),
+			)
+		}
+		direction := 1
+		if order.Descending {
+			direction = -1
+		}
+		sortSpec = append(sortSpec, bson.E{Key: path, Value: direction})
+		hasTitle = hasTitle || order.Field == property.SortByTitle
+	}
+	if !hasTitle {
+		sortSpec = append(sortSpec, bson.E{Key: "Title", Value: 1})
+	}
+	return append(sortSpec, bson.E{Key: "_id", Value: 1}), nil
+}
+
+// sortValues returns the values of the sorted fields of a property, in sort order, the
+// _id tie breaker is left out.
+func sortValues(prop property.Property, sortSpec bson.D) []interface{} {
+	values := make([]interface{}, 0, len(sortSpec))
+	for _, field := range sortSpec {
+		switch field.Key {
+		case "_id":
+		case "Title":
+			values = append(values, prop.Title)
+		case "Category":
+			values = append(values, prop.Category)
+		case "AvailableDate":
+			values = append(values, prop.AvailableDate)
+		case "SaleType":
+			values = append(values, prop.SaleType)
+		case "Metadata.CreatedAt":
+			values = append(values, prop.Metadata.CreatedAt())
+		case "Metadata.UpdatedAt":
+			values = append(values, prop.Metadata.UpdatedAt())
+		case "Rent.MonthlyAmount":
+			// A property without the price sorts first, as Mongo sorts a missing field.
+			if prop.Rent != nil {
+				values = append(values, prop.Rent.MonthlyAmount)
+			} else {
+				values = append(values, nil)
+			}
+		case "AskingPrice.Amount":
+			if prop.AskingPrice != nil {
+				values = append(values, prop.AskingPrice.Amount)
+			} else {
+				values = append(values, nil)
+			}
+		default:
+			values = append(values, nil)
+		}
+	}
+	return values
+}
+
+// ListNear implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) ListNear(
+	c context.Context,
+
+	latitude float64,
+	longitude float64,
+	radius float64,
+	category string,
+	saleType uint8,
+	areaName string,
+	attributes property.AttributeFilter,
+	excluded []string,
+	limit uint16,
+) ([]property.Property, error) {
+	filter := bson.D{{Key: "Status", Value: property.Published}}
+	if category != "" {
+		filter = append(filter, bson.E{Key: "Category", Value: category})
+	}
+	if saleType != 0 {
+		filter = append(filter, bson.E{Key: "SaleType", Value: saleType})
+	}
+	if areaName != "" {
+		filter = append(filter, bson.E{Key: "Areas", Value: area.Key(areaName)})
+	}
+	filter = append(filter, attributeMatch(attributes)...)
+	filter = append(filter, excludedMatch(excluded)...)
+
 	res, aggErr := p.aggregator.Aggregate(
 		c,
 
 		mongo.Pipeline{
-			filter,
+			// $geoNear must be the first stage and already sorts nearest first.
+			bson.D{{Key: "$geoNear", Value: bson.D{
+				{Key: "near", Value: bson.D{
+					{Key: "type", Value: "Point"},
+					{Key: "coordinates", Value: bson.A{longitude, latitude}},
+				}},
+				{Key: "key", Value: "Address.GeoJSON"},
+				{Key: "distanceField", Value: "Distance"},
+				{Key: "maxDistance", Value: radius},
+				{Key: "query", Value: filter},
+				{Key: "spherical", Value: true},
+			}}},
 			bson.D{{Key: "$limit", Value: limit}},
 			bson.D{{Key: "$project", Value: bson.D{
 				{Key: "_id", Value: 1},
@@ -197,11 +803,16 @@ func (p *PropertyRepositoryMongoImpl) ListByCategory(
 				{Key: "Description", Value: 1},
 				{Key: "Title", Value: 1},
 				{Key: "Category", Value: 1},
-				{Key: "Available", Value: 1},
+				{Key: "Status", Value: 1},
 				{Key: "AvailableDate", Value: 1},
 				{Key: "Address", Value: 1},
 				{Key: "SaleType", Value: 1},
-				{Key: "PaginationToken", Value: bson.D{{Key: "$meta", Value: "searchSequenceToken"}}},
+				{Key: "Areas", Value: 1},
+				{Key: "Nearby", Value: 1},
+				{Key: "Rent", Value: 1},
+				{Key: "AskingPrice", Value: 1},
+				{Key: "Attributes", Value: 1},
+				{Key: "Distance", Value: 1},
 			}}}},
 	)
 	if aggErr != nil {
@@ -223,10 +834,170 @@ func (p *PropertyRepositoryMongoImpl) ListByCategory(
 	return *finalRes, nil
 }
 
-func (p *PropertyRepositoryMongoImpl) ListByOwner(
+// Weights of the criteria a similar property is scored on, the highest score is their sum.
+const (
+	similarCategoryWeight  = 3.0
+	similarSaleTypeWeight  = 2.0
+	similarProximityWeight = 3.0 // Halves at similarProximityScale metres.
+	similarWordingWeight   = 2.0 // Share of the words of the source found in the property.
+
+	similarProximityScale = 1000.0
+	// similarCandidates is the number of properties scored, the nearest ones when the source
+	// has a location and else ones sharing its category or sale type.
+	similarCandidates = 500
+)
+
+// similarWordPattern matches the words compared between the wording of two properties.
+const similarWordPattern = "[a-z0-9]{3,}"
+
+var similarWordRegexp = regexp.MustCompile(similarWordPattern)
+
+// ListSimilar implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) ListSimilar(
 	c context.Context,
 
-	ownerID string,
+	source property.Property,
+	limit uint16,
+) ([]property.Property, error) {
+	filter := bson.D{{Key: "Status", Value: property.Published}}
+	score := bson.A{
+		bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$eq", Value: bson.A{"$Category", source.Category}}}, similarCategoryWeight, 0,
+		}}},
+		bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$eq", Value: bson.A{"$SaleType", source.SaleType}}}, similarSaleTypeWeight, 0,
+		}}},
+	}
+
+	pipeline := mongo.Pipeline{}
+	if source.Address.GeoJSON != nil {
+		pipeline = append(pipeline,
+			// $geoNear must be the first stage, only the nearest candidates are scored.
+			bson.D{{Key: "$geoNear", Value: bson.D{
+				{Key: "near", Value: bson.D{
+					{Key: "type", Value: "Point"},
+					{Key: "coordinates", Value: bson.A{
+						source.Address.GeoJSON.Coordinates[0],
+						source.Address.GeoJSON.Coordinates[1],
+					}},
+				}},
+				{Key: "key", Value: "Address.GeoJSON"},
+				{Key: "distanceField", Value: "Distance"},
+				{Key: "query", Value: filter},
+				{Key: "spherical", Value: true},
+			}}},
+			bson.D{{Key: "$limit", Value: similarCandidates}},
+		)
+		score = append(score, bson.D{{Key: "$divide", Value: bson.A{
+			similarProximityWeight,
+			bson.D{{Key: "$add", Value: bson.A{
+				1, bson.D{{Key: "$divide", Value: bson.A{"$Distance", similarProximityScale}}},
+			}}},
+		}}})
+	} else {
+		// Without a location only the properties scoring on category or sale type are
+		// candidates.
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "Category", Value: source.Category}},
+			bson.D{{Key: "SaleType", Value: source.SaleType}},
+		}})
+		pipeline = append(pipeline,
+			bson.D{{Key: "$match", Value: filter}},
+			bson.D{{Key: "$limit", Value: similarCandidates}},
+		)
+	}
+
+	if words := similarWords(source.Title + " " + source.Description); len(words) > 0 {
+		score = append(score, bson.D{{Key: "$multiply", Value: bson.A{
+			similarWordingWeight / float64(len(words)),
+			bson.D{{Key: "$size", Value: bson.D{{Key: "$setIntersection", Value: bson.A{
+				words,
+				bson.D{{Key: "$map", Value: bson.D{
+					{Key: "input", Value: bson.D{{Key: "$regexFindAll", Value: bson.D{
+						{Key: "input", Value: bson.D{{Key: "$toLower", Value: bson.D{
+							{Key: "$concat", Value: bson.A{"$Title", " ", "$Description"}},
+						}}}},
+						{Key: "regex", Value: similarWordPattern},
+					}}}},
+					{Key: "in", Value: "$$this.match"},
+				}}},
+			}}}}},
+		}}})
+	}
+
+	// One more than the limit, the source itself is among the candidates.
+	pipeline = append(pipeline,
+		bson.D{{Key: "$addFields", Value: bson.D{
+			{Key: "SimilarityScore", Value: bson.D{{Key: "$add", Value: score}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "SimilarityScore", Value: -1},
+			{Key: "_id", Value: 1},
+		}}},
+		bson.D{{Key: "$limit", Value: int(limit) + 1}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 1},
+			{Key: "OwnerID", Value: 1},
+			{Key: "Description", Value: 1},
+			{Key: "Title", Value: 1},
+			{Key: "Category", Value: 1},
+			{Key: "Status", Value: 1},
+			{Key: "AvailableDate", Value: 1},
+			{Key: "Address", Value: 1},
+			{Key: "SaleType", Value: 1},
+			{Key: "Rent", Value: 1},
+			{Key: "AskingPrice", Value: 1},
+			{Key: "Attributes", Value: 1},
+			{Key: "Distance", Value: 1},
+			{Key: "SimilarityScore", Value: 1},
+		}}},
+	)
+
+	res, aggErr := p.aggregator.Aggregate(c, pipeline)
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	similar := make([]property.Property, 0, len(*finalRes))
+	for _, prop := range *finalRes {
+		if prop.ID != source.ID && len(similar) < int(limit) {
+			similar = append(similar, prop)
+		}
+	}
+	return similar, nil
+}
+
+// similarWords returns the distinct lower case words of a text compared by ListSimilar.
+func similarWords(text string) bson.A {
+	seen := map[string]bool{}
+	words := bson.A{}
+	for _, word := range similarWordRegexp.FindAllString(strings.ToLower(text), -1) {
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// ListWithinArea implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) ListWithinArea(
+	c context.Context,
+
+	area property.SearchArea,
+	excluded []string,
 	sort uint8,
 	limit uint16,
 	paginationToken string,
@@ -235,10 +1006,9 @@ func (p *PropertyRepositoryMongoImpl) ListByOwner(
 	sortSpec := bson.D{
 		{Key: "Title", Value: sort},
 	}
-	filter, err := p.paginationHelper.TextPaginationHelper(
+	filter, err := p.paginationHelper.CompoundPaginationHelper(
 		"default",
-		"OwnerID",
-		ownerID,
+		append(areaClauses(area), excludedClauses(excluded)...),
 		sortSpec,
 		search,
 		paginationToken,
@@ -250,25 +1020,414 @@ func (p *PropertyRepositoryMongoImpl) ListByOwner(
 		)
 	}
 
-	res, aggErr := p.aggregator.Aggregate(
-		c,
+	return p.list(c, filter, sortSpec, limit, search)
+}
 
-		mongo.Pipeline{
-			filter,
-			bson.D{{Key: "$limit", Value: limit}},
-			bson.D{{Key: "$project", Value: bson.D{
-				{Key: "_id", Value: 1},
-				{Key: "OwnerID", Value: 1},
-				{Key: "Description", Value: 1},
-				{Key: "Title", Value: 1},
-				{Key: "Category", Value: 1},
-				{Key: "Available", Value: 1},
-				{Key: "AvailableDate", Value: 1},
-				{Key: "Address", Value: 1},
-				{Key: "SaleType", Value: 1},
-				{Key: "PaginationToken", Value: bson.D{{Key: "$meta", Value: "searchSequenceToken"}}},
+// ListWithinBoundary implements property.Repository, the boundary is matched on the 2dsphere
+// index of the coordinates.
+func (p *PropertyRepositoryMongoImpl) ListWithinBoundary(
+	c context.Context,
+	boundary address.GeoJSONMultiPolygon,
+	category string,
+	saleType uint8,
+	attributes property.AttributeFilter,
+	excluded []string,
+	limit uint16,
+) ([]property.Property, error) {
+	if len(boundary.Coordinates) == 0 {
+		return nil, nil
+	}
+	filter := bson.D{
+		{Key: "Address.GeoJSON", Value: bson.D{{Key: "$geoWithin", Value: bson.D{{Key: "$geometry", Value: boundary}}}}},
+		{Key: "Status", Value: property.Published},
+	}
+	if category != "" {
+		filter = append(filter, bson.E{Key: "Category", Value: category})
+	}
+	if saleType != 0 {
+		filter = append(filter, bson.E{Key: "SaleType", Value: saleType})
+	}
+	filter = append(filter, attributeMatch(attributes)...)
+	filter = append(filter, excludedMatch(excluded)...)
+
+	res, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$limit", Value: limit}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 1},
+			{Key: "OwnerID", Value: 1},
+			{Key: "Description", Value: 1},
+			{Key: "Title", Value: 1},
+			{Key: "Category", Value: 1},
+			{Key: "Status", Value: 1},
+			{Key: "AvailableDate", Value: 1},
+			{Key: "Address", Value: 1},
+			{Key: "SaleType", Value: 1},
+			{Key: "Areas", Value: 1},
+			{Key: "Nearby", Value: 1},
+			{Key: "Rent", Value: 1},
+			{Key: "AskingPrice", Value: 1},
+			{Key: "Attributes", Value: 1},
+		}}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	return *finalRes, nil
+}
+
+// CountWithinArea implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) CountWithinArea(
+	c context.Context,
+
+	area property.SearchArea,
+	excluded []string,
+) (int64, error) {
+	filter, err := p.paginationHelper.CompoundPaginationHelper(
+		"default", append(areaClauses(area), excludedClauses(excluded)...), nil, 0, "",
+	)
+	if err != nil {
+		return 0, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return p.count(c, filter)
+}
+
+// ClusterWithinBox implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) ClusterWithinBox(
+	c context.Context,
+
+	box address.BoundingBox,
+	zoom uint8,
+	threshold uint16,
+) (*property.Clusters, error) {
+	cellSize := property.ClusterCellSize(zoom)
+	longitude := bson.D{{Key: "$arrayElemAt", Value: bson.A{"$Address.GeoJSON.coordinates", 0}}}
+	latitude := bson.D{{Key: "$arrayElemAt", Value: bson.A{"$Address.GeoJSON.coordinates", 1}}}
+	res, aggErr := p.rawAggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: append(boxFilter(box), bson.E{Key: "Status", Value: property.Published})}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "x", Value: bson.D{{Key: "$floor", Value: bson.D{
+					{Key: "$divide", Value: bson.A{longitude, cellSize}},
+				}}}},
+				{Key: "y", Value: bson.D{{Key: "$floor", Value: bson.D{
+					{Key: "$divide", Value: bson.A{latitude, cellSize}},
+				}}}},
+			}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "longitude", Value: bson.D{{Key: "$avg", Value: longitude}}},
+			{Key: "latitude", Value: bson.D{{Key: "$avg", Value: latitude}}},
+			// A cell below the threshold has all its properties in ids. $firstN needs
+			// MongoDB 5.2 or later, unlike $push it never holds every ID of a dense cell.
+			{Key: "ids", Value: bson.D{{Key: "$firstN", Value: bson.D{
+				{Key: "input", Value: "$_id"},
+				{Key: "n", Value: threshold},
 			}}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: property.MaxClusterCells}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+
+	clusters := &property.Clusters{
+		Clusters:   []property.Cluster{},
+		Properties: []property.Property{},
+	}
+	var ids bson.A
+	for _, cell := range *finalRes {
+		var count int64
+		switch n := cell["count"].(type) {
+		case int32:
+			count = int64(n)
+		case int64:
+			count = n
+		}
+		cellIDs, _ := cell["ids"].(bson.A)
+		if count < int64(threshold) {
+			ids = append(ids, cellIDs...)
+			continue
+		}
+		var sampleID string
+		if len(cellIDs) > 0 {
+			id, err := rawPropertyID(cellIDs[0])
+			if err != nil {
+				return nil, errors.NewHandlerError(
+					err,
+					codes.Internal,
+				)
+			}
+			sampleID = id
+		}
+		lng, _ := cell["longitude"].(float64)
+		lat, _ := cell["latitude"].(float64)
+		clusters.Clusters = append(clusters.Clusters, property.Cluster{
+			Count:    count,
+			Centroid: *address.NewPoint(lat, lng),
+			SampleID: sampleID,
+		})
+	}
+	if len(ids) == 0 {
+		return clusters, nil
+	}
+
+	propRes, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "Title", Value: 1}, {Key: "_id", Value: 1}}}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+	properties, getErr := propRes.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	clusters.Properties = *properties
+	return clusters, nil
+}
+
+// boxFilter matches the properties with coordinates inside the box, a box whose bottom left
+// longitude is east of its top right longitude crosses the antimeridian. The 2dsphere index
+// finds the properties inside the cover of the box, the ones in its margin are then left out
+// on their coordinates.
+func boxFilter(box address.BoundingBox) bson.D {
+	west, south := box.BottomLeft.Coordinates[0], box.BottomLeft.Coordinates[1]
+	east, north := box.TopRight.Coordinates[0], box.TopRight.Coordinates[1]
+	filter := bson.D{
+		{Key: "Address.GeoJSON", Value: bson.D{{Key: "$geoWithin", Value: bson.D{
+			{Key: "$geometry", Value: box.Cover()},
+		}}}},
+		{Key: "Address.GeoJSON.coordinates.1", Value: bson.D{
+			{Key: "$gte", Value: south},
+			{Key: "$lte", Value: north},
+		}},
+	}
+	if west <= east {
+		return append(filter, bson.E{Key: "Address.GeoJSON.coordinates.0", Value: bson.D{
+			{Key: "$gte", Value: west},
+			{Key: "$lte", Value: east},
+		}})
+	}
+	return append(filter, bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "Address.GeoJSON.coordinates.0", Value: bson.D{{Key: "$gte", Value: west}}}},
+		bson.D{{Key: "Address.GeoJSON.coordinates.0", Value: bson.D{{Key: "$lte", Value: east}}}},
+	}})
+}
+
+// rawPropertyID converts a property _id read without the factory, a binary UUID, into its
+// domain representation.
+func rawPropertyID(raw interface{}) (string, error) {
+	binary, ok := raw.(primitive.Binary)
+	if !ok {
+		return "", fmt.Errorf("unexpected property id %T", raw)
+	}
+	id, err := uuid.FromBytes(binary.Data)
+	if err != nil {
+		return "", err
+	}
+	return database.IDToString(id)
+}
+
+// areaClauses match the published properties inside a search area.
+func areaClauses(area property.SearchArea) []database.SearchClause {
+	return []database.SearchClause{
+		{Operator: "geoWithin", Path: "Address.GeoJSON", Options: areaShape(area)},
+		statusClause(nil),
+	}
+}
+
+// areaShape converts a search area into the shape of an Atlas Search geoWithin operator.
+func areaShape(area property.SearchArea) bson.D {
+	if area.Polygon != nil {
+		return bson.D{{Key: "geometry", Value: bson.D{
+			{Key: "type", Value: "Polygon"},
+			{Key: "coordinates", Value: area.Polygon.Coordinates},
+		}}}
+	}
+	return bson.D{{Key: "box", Value: bson.D{
+		{Key: "bottomLeft", Value: bson.D{
+			{Key: "type", Value: "Point"},
+			{Key: "coordinates", Value: area.BoundingBox.BottomLeft.Coordinates},
+		}},
+		{Key: "topRight", Value: bson.D{
+			{Key: "type", Value: "Point"},
+			{Key: "coordinates", Value: area.BoundingBox.TopRight.Coordinates},
+		}},
+	}}}
+}
+
+// Search implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) Search(
+	c context.Context,
+
+	filter property.SearchFilter,
+	sort uint8,
+	limit uint16,
+	paginationToken string,
+	search uint8,
+) ([]property.Property, error) {
+	sortSpec := bson.D{
+		{Key: "Title", Value: sort},
+	}
+	searchFilter, err := p.paginationHelper.CompoundPaginationHelper(
+		"default",
+		filterClauses(filter),
+		sortSpec,
+		search,
+		paginationToken,
 	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+
+	return p.list(c, searchFilter, sortSpec, limit, search)
+}
+
+// CountSearch implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) CountSearch(c context.Context, filter property.SearchFilter) (int64, error) {
+	searchFilter, err := p.paginationHelper.CompoundPaginationHelper(
+		"default", filterClauses(filter), nil, 0, "",
+	)
+	if err != nil {
+		return 0, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return p.count(c, searchFilter)
+}
+
+// textSearchPaths are the paths a free text search matches.
+var textSearchPaths = []string{
+	"Title",
+	"Description",
+	"Address.FirstLine",
+	"Address.Street",
+	"Address.City",
+	"Address.County",
+	"Address.Country",
+	"Address.PostalCode",
+}
+
+// SearchText implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) SearchText(
+	c context.Context,
+
+	text string,
+	sort property.Sort,
+	limit uint16,
+	paginationToken string,
+	search uint8,
+) ([]property.Property, error) {
+	// Without a sort the properties are in relevance order.
+	var sortSpec bson.D
+	if len(sort) > 0 {
+		var err error
+		sortSpec, err = toSortSpec(sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+	filter, err := p.paginationHelper.FuzzyTextPaginationHelper(
+		"default",
+		textSearchPaths,
+		text,
+		[]database.SearchClause{statusClause(nil)},
+		sortSpec,
+		search,
+		paginationToken,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+
+	return p.list(c, filter, sortSpec, limit, search)
+}
+
+// CountSearchText implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) CountSearchText(c context.Context, text string) (int64, error) {
+	filter, err := p.paginationHelper.FuzzyTextPaginationHelper(
+		"default", textSearchPaths, text, []database.SearchClause{statusClause(nil)}, nil, 0, "",
+	)
+	if err != nil {
+		return 0, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return p.count(c, filter)
+}
+
+// propertyFacets are the facets of a property search, the sale type and status buckets are the
+// sale types and statuses.
+var propertyFacets = []database.Facet{
+	{Name: "categories", Path: "Category", Type: database.FacetString},
+	{Name: "saleTypes", Path: "SaleType", Type: database.FacetNumber, Boundaries: bson.A{0, 1, 2, 3, 4}},
+	{Name: "cities", Path: "Address.City", Type: database.FacetString},
+	{Name: "statuses", Path: "Status", Type: database.FacetNumber, Boundaries: bson.A{1, 2, 3, 4, 5, 6, 7, 8}},
+}
+
+// Facets implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) Facets(
+	c context.Context,
+
+	filter property.SearchFilter,
+) (*property.Facets, error) {
+	searchFilter, err := p.paginationHelper.CompoundPaginationHelper(
+		"default", filterClauses(filter), nil, 0, "",
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+
+	res, aggErr := p.rawAggregator.Aggregate(c, p.paginationHelper.FacetStages(searchFilter, propertyFacets))
 	if aggErr != nil {
 		p.log.Debug("Error in aggregation: %v", aggErr)
 		return nil, errors.NewHandlerError(
@@ -285,5 +1444,320 @@ func (p *PropertyRepositoryMongoImpl) ListByOwner(
 			codes.Internal,
 		)
 	}
-	return *finalRes, nil
+	facets := &property.Facets{}
+	if len(*finalRes) == 0 {
+		return facets, nil
+	}
+	result := (*finalRes)[0]
+	facets.Categories = facetBuckets(result["categories"])
+	facets.SaleTypes = facetBuckets(result["saleTypes"])
+	facets.Cities = facetBuckets(result["cities"])
+	facets.Statuses = facetBuckets(result["statuses"])
+	return facets, nil
+}
+
+// facetBuckets converts the {_id, count} buckets of a facet, empty buckets are left out.
+func facetBuckets(raw interface{}) []property.FacetBucket {
+	buckets, _ := raw.(bson.A)
+	facet := make([]property.FacetBucket, 0, len(buckets))
+	for _, raw := range buckets {
+		var bucket bson.M
+		switch b := raw.(type) {
+		case bson.M:
+			bucket = b
+		case bson.D:
+			bucket = b.Map()
+		default:
+			continue
+		}
+		var count int64
+		switch n := bucket["count"].(type) {
+		case int32:
+			count = int64(n)
+		case int64:
+			count = n
+		}
+		if count == 0 || bucket["_id"] == nil {
+			continue
+		}
+		facet = append(facet, property.FacetBucket{
+			Value: fmt.Sprint(bucket["_id"]),
+			Count: count,
+		})
+	}
+	return facet
+}
+
+// propertySuggestions are the fields suggested while a search is typed.
+var propertySuggestions = []database.Facet{
+	{Name: "cities", Path: "Address.City", Type: database.FacetString},
+	{Name: "counties", Path: "Address.County", Type: database.FacetString},
+	{Name: "postcodes", Path: "Address.PostalCode", Type: database.FacetString},
+	{Name: "titles", Path: "Title", Type: database.FacetString},
+}
+
+// Suggest implements property.Repository.
+func (p *PropertyRepositoryMongoImpl) Suggest(
+	c context.Context,
+
+	prefix string,
+	limit uint16,
+) (*property.Suggestions, error) {
+	stages, err := p.paginationHelper.SuggestStages(
+		"default", prefix, propertySuggestions, []database.SearchClause{statusClause(nil)}, limit,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	res, aggErr := p.rawAggregator.Aggregate(c, stages)
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	suggestions := &property.Suggestions{}
+	if len(*finalRes) == 0 {
+		return suggestions, nil
+	}
+	result := (*finalRes)[0]
+	suggestions.Cities = suggestedValues(result["cities"])
+	suggestions.Counties = suggestedValues(result["counties"])
+	suggestions.Postcodes = suggestedValues(result["postcodes"])
+	suggestions.Titles = suggestedValues(result["titles"])
+	return suggestions, nil
+}
+
+// suggestedValues converts the {_id, value, count} suggestions of a field, empty values are
+// left out.
+func suggestedValues(raw interface{}) []string {
+	suggested, _ := raw.(bson.A)
+	values := make([]string, 0, len(suggested))
+	for _, raw := range suggested {
+		var suggestion bson.M
+		switch s := raw.(type) {
+		case bson.M:
+			suggestion = s
+		case bson.D:
+			suggestion = s.Map()
+		default:
+			continue
+		}
+		if value, ok := suggestion["value"].(string); ok && value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// filterClauses converts a search filter into Atlas Search clauses, an empty
+// filter matches every published property.
+func filterClauses(filter property.SearchFilter) []database.SearchClause {
+	clauses := []database.SearchClause{statusClause(filter.Statuses)}
+	if len(filter.Categories) > 0 {
+		clauses = append(clauses, database.SearchClause{
+			Operator: "in", Path: "Category",
+			Options: bson.D{{Key: "value", Value: filter.Categories}},
+		})
+	}
+	if filter.SaleType != 0 {
+		clauses = append(clauses, database.SearchClause{
+			Operator: "equals", Path: "SaleType",
+			Options: bson.D{{Key: "value", Value: filter.SaleType}},
+		})
+	}
+	if !filter.AvailableFrom.IsZero() || !filter.AvailableTo.IsZero() {
+		dateRange := bson.D{}
+		if !filter.AvailableFrom.IsZero() {
+			dateRange = append(dateRange, bson.E{Key: "gte", Value: filter.AvailableFrom})
+		}
+		if !filter.AvailableTo.IsZero() {
+			dateRange = append(dateRange, bson.E{Key: "lte", Value: filter.AvailableTo})
+		}
+		clauses = append(clauses, database.SearchClause{
+			Operator: "range", Path: "AvailableDate", Options: dateRange,
+		})
+	}
+	if filter.City != "" {
+		clauses = append(clauses, database.SearchClause{
+			Operator: "phrase", Path: "Address.City",
+			Options: bson.D{{Key: "query", Value: filter.City}},
+		})
+	}
+	if filter.PostcodePrefix != "" {
+		clauses = append(clauses, database.SearchClause{
+			Operator: "wildcard", Path: "Address.PostalCode",
+			Options: bson.D{
+				{Key: "query", Value: database.WildcardPrefix(filter.PostcodePrefix)},
+				{Key: "allowAnalyzedField", Value: true},
+			},
+		})
+	}
+	if filter.Country != "" {
+		// Addresses are stored with the country name, a code or alias is searched as its name.
+		country := filter.Country
+		if name, ok := address.CountryName(country); ok {
+			country = name
+		}
+		clauses = append(clauses, database.SearchClause{
+			Operator: "phrase", Path: "Address.Country",
+			Options: bson.D{{Key: "query", Value: country}},
+		})
+	}
+	if filter.Area != "" {
+		clauses = append(clauses, areaClause(filter.Area))
+	}
+	for _, near := range filter.NearPOIs {
+		// Only the nearest point of every type is stored, it is within the distance when any is.
+		clauses = append(clauses, database.SearchClause{
+			Operator: "range", Path: "Nearby." + poi.TypeKey(near.Type) + ".Distance",
+			Options: bson.D{{Key: "lte", Value: near.Within}},
+		})
+	}
+	if filter.Rent != nil {
+		clauses = append(clauses, priceClauses("Rent.MonthlyAmount", "Rent.Price.Currency", *filter.Rent)...)
+	}
+	if filter.AskingPrice != nil {
+		clauses = append(clauses, priceClauses("AskingPrice.Amount", "AskingPrice.Currency", *filter.AskingPrice)...)
+	}
+	clauses = append(clauses, attributeClauses(filter.Attributes)...)
+	clauses = append(clauses, excludedClauses(filter.ExcludedIDs)...)
+	return clauses
+}
+
+// excludedClauses match every property but the ones with the IDs, the IDs are compared in
+// the UUID form the factory stores and an ID that is not a UUID is left out as no property
+// has it.
+func excludedClauses(ids []string) []database.SearchClause {
+	uuids := make(bson.A, 0, len(ids))
+	for _, id := range ids {
+		if uid, err := database.StringToID(id); err == nil {
+			uuids = append(uuids, uid)
+		}
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+	return []database.SearchClause{{
+		Operator: "in", Path: "_id",
+		Options: bson.D{{Key: "value", Value: uuids}},
+		Negate:  true,
+	}}
+}
+
+// excludedMatch converts the excluded IDs into the elements of a query filter, for the
+// stages that are not Atlas Searches.
+func excludedMatch(ids []string) bson.D {
+	match := bson.D{}
+	for _, clause := range excludedClauses(ids) {
+		match = append(match, bson.E{Key: clause.Path, Value: bson.D{
+			{Key: "$nin", Value: clause.Options.Map()["value"]},
+		}})
+	}
+	return match
+}
+
+// priceClauses match the prices at amountPath within the range, in its currency when it has one.
+func priceClauses(amountPath string, currencyPath string, price property.PriceRange) []database.SearchClause {
+	bounds := bson.D{{Key: "gte", Value: price.Min}}
+	if price.Max > 0 {
+		bounds = append(bounds, bson.E{Key: "lte", Value: price.Max})
+	}
+	clauses := []database.SearchClause{{Operator: "range", Path: amountPath, Options: bounds}}
+	if price.Currency != "" {
+		clauses = append(clauses, database.SearchClause{
+			Operator: "equals", Path: currencyPath,
+			Options: bson.D{{Key: "value", Value: price.Currency}},
+		})
+	}
+	return clauses
+}
+
+// attributeClauses converts an attribute filter into Atlas Search clauses, a feature of
+// every key must be present.
+func attributeClauses(filter property.AttributeFilter) []database.SearchClause {
+	var clauses []database.SearchClause
+	// A nil maximum is no upper bound, a zero one only matches zero.
+	rangeClause := func(path string, min float64, max *float64) {
+		bounds := bson.D{}
+		if min > 0 {
+			bounds = append(bounds, bson.E{Key: "gte", Value: min})
+		}
+		if max != nil {
+			bounds = append(bounds, bson.E{Key: "lte", Value: *max})
+		}
+		if len(bounds) > 0 {
+			clauses = append(clauses, database.SearchClause{Operator: "range", Path: path, Options: bounds})
+		}
+	}
+	equalsClause := func(path string, value interface{}) {
+		clauses = append(clauses, database.SearchClause{
+			Operator: "equals", Path: path,
+			Options: bson.D{{Key: "value", Value: value}},
+		})
+	}
+	var maxBedrooms *float64
+	if filter.MaxBedrooms != nil {
+		max := float64(*filter.MaxBedrooms)
+		maxBedrooms = &max
+	}
+	rangeClause("Attributes.Bedrooms", float64(filter.MinBedrooms), maxBedrooms)
+	rangeClause("Attributes.Bathrooms", float64(filter.MinBathrooms), nil)
+	rangeClause("Attributes.FloorArea.SquareMetres", filter.MinFloorArea, filter.MaxFloorArea)
+	if filter.Furnishing != property.UnknownFurnishing {
+		equalsClause("Attributes.Furnishing", int(filter.Furnishing))
+	}
+	if filter.Parking {
+		rangeClause("Attributes.Parking", float64(property.StreetParking), nil)
+	}
+	if filter.Garden != nil {
+		equalsClause("Attributes.Garden", *filter.Garden)
+	}
+	if filter.PetsAllowed != nil {
+		equalsClause("Attributes.PetsAllowed", *filter.PetsAllowed)
+	}
+	for _, feature := range filter.Accessibility {
+		equalsClause("Attributes.Accessibility", property.FeatureKey(feature))
+	}
+	for _, amenity := range filter.Amenities {
+		equalsClause("Attributes.Amenities", property.FeatureKey(amenity))
+	}
+	return clauses
+}
+
+// attributeMatch converts an attribute filter into the elements of a query filter, for the
+// stages that are not Atlas Searches.
+func attributeMatch(filter property.AttributeFilter) bson.D {
+	match := bson.D{}
+	// Several features on the same path must all be present.
+	equals := bson.A{}
+	for _, clause := range attributeClauses(filter) {
+		switch clause.Operator {
+		case "range":
+			bounds := bson.D{}
+			for _, e := range clause.Options {
+				bounds = append(bounds, bson.E{Key: "$" + e.Key, Value: e.Value})
+			}
+			match = append(match, bson.E{Key: clause.Path, Value: bounds})
+		case "equals":
+			equals = append(equals, bson.D{{Key: clause.Path, Value: clause.Options.Map()["value"]}})
+		}
+	}
+	if len(equals) > 0 {
+		match = append(match, bson.E{Key: "$and", Value: equals})
+	}
+	return match
 }
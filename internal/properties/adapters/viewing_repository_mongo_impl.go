@@ -0,0 +1,304 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Verify that ViewingRepositoryMongoImpl implements viewing.Repository.
+var _ viewing.Repository = (*ViewingRepositoryMongoImpl)(nil)
+
+type ViewingRepositoryMongoImpl struct {
+	log     log.Logger
+	viewing database.FinderInserterUpdaterRemover[
+		bson.M,
+		bson.M,
+		viewing.Viewing,
+	]
+	factory    viewing.Factory[uuid.UUID]
+	aggregator database.Grouper[mongo.Pipeline, viewing.Viewing]
+	properties database.Updater[bson.M, bson.M, property.Property]
+	session    database.Session[database.SessionReceiver]
+}
+
+func NewMongoViewingRepository(
+	log log.Logger,
+	viewing database.FinderInserterUpdaterRemover[bson.M, bson.M, viewing.Viewing],
+	factory viewing.Factory[uuid.UUID],
+	aggregator database.Grouper[mongo.Pipeline, viewing.Viewing],
+	properties database.Updater[bson.M, bson.M, property.Property],
+	session database.Session[database.SessionReceiver],
+) *ViewingRepositoryMongoImpl {
+	return &ViewingRepositoryMongoImpl{
+		log:        log,
+		viewing:    viewing,
+		factory:    factory,
+		aggregator: aggregator,
+		properties: properties,
+		session:    session,
+	}
+}
+
+// New implements viewing.Repository, the slot is checked for overlaps and inserted in a
+// session transaction that first writes the ViewingLock field of the property. Two slots of
+// the same property created at the same time conflict on it and the retried one sees the other.
+func (p *ViewingRepositoryMongoImpl) New(
+	c context.Context,
+	params viewing.NewViewingParams,
+) (*viewing.Viewing, error) {
+	p.log.Debug("Creating new viewing slot of property: %s", params.PropertyID)
+	newViewing, err := p.factory.New(params)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	err = p.session.Execute(c, func(sc mongo.SessionContext) (interface{}, error) {
+		if err := p.lockProperty(sc, params.PropertyID); err != nil {
+			return nil, err
+		}
+		overlapping, err := p.ListByProperty(sc, params.PropertyID, params.Start, params.End)
+		if err != nil {
+			return nil, err
+		}
+		if len(overlapping) > 0 {
+			return nil, errors.NewHandlerError(
+				errors.Join(viewing.ErrSlotOverlap, errors.NewSimple(overlapping[0].ID)),
+				codes.FailedPrecondition,
+			)
+		}
+		if _, err := p.viewing.InsertOne(sc, *newViewing); err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		return nil, nil
+	})
+	var appErr errors.AppError
+	if err != nil && !errors.AsAppError(err, &appErr) {
+		// A failed commit or session is not an application error yet.
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newViewing, nil
+}
+
+// Get implements viewing.Repository.
+func (p *ViewingRepositoryMongoImpl) Get(c context.Context, ID string) (*viewing.Viewing, error) {
+	p.log.Debug("Fetching viewing slot with ID: %s", ID)
+	id, err := p.id(ID)
+	if err != nil {
+		return nil, err
+	}
+	found, err := p.find(c, bson.D{{Key: "_id", Value: id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, errors.NewHandlerError(
+			errors.Join(viewing.ErrNotFound, errors.NewSimple(ID)),
+			codes.NotFound,
+		)
+	}
+	return &found[0], nil
+}
+
+// Delete implements viewing.Repository, the slot is only removed while it is free.
+func (p *ViewingRepositoryMongoImpl) Delete(c context.Context, ID string) error {
+	p.log.Debug("Deleting viewing slot with ID: %s", ID)
+	id, err := p.id(ID)
+	if err != nil {
+		return err
+	}
+	// DeleteMany reports a slot booked in the meantime as nothing deleted instead of an error.
+	count, err := p.viewing.DeleteMany(c, bson.M{"_id": id, "Booking": nil})
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			viewing.ErrSlotBooked,
+			codes.FailedPrecondition,
+		)
+	}
+	return nil
+}
+
+// ListByProperty implements viewing.Repository.
+func (p *ViewingRepositoryMongoImpl) ListByProperty(
+	c context.Context,
+	propertyID string,
+	start time.Time,
+	end time.Time,
+) ([]viewing.Viewing, error) {
+	p.log.Debug("Listing viewing slots of property: %s", propertyID)
+	return p.find(c, bson.D{
+		{Key: "PropertyID", Value: propertyID},
+		{Key: "Start", Value: bson.D{{Key: "$lt", Value: end}}},
+		{Key: "End", Value: bson.D{{Key: "$gt", Value: start}}},
+	})
+}
+
+// Book implements viewing.Repository, the booking is only set on a slot without one so two
+// concurrent bookings of the same slot cannot both succeed.
+func (p *ViewingRepositoryMongoImpl) Book(c context.Context, ID string, booking viewing.Booking) error {
+	p.log.Debug("Booking viewing slot with ID: %s", ID)
+	id, err := p.id(ID)
+	if err != nil {
+		return err
+	}
+	count, err := p.viewing.UpdateMany(
+		c,
+		bson.M{"_id": id, "Booking": nil},
+		bson.M{"$set": bson.M{"Booking": booking, "Metadata.UpdatedAt": time.Now()}},
+	)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			viewing.ErrSlotBooked,
+			codes.FailedPrecondition,
+		)
+	}
+	return nil
+}
+
+// Reschedule implements viewing.Repository, the new slot is booked and the old one freed in
+// a session transaction so the booking never holds both slots or neither.
+func (p *ViewingRepositoryMongoImpl) Reschedule(
+	c context.Context,
+	ID string,
+	newID string,
+	booking viewing.Booking,
+) error {
+	p.log.Debug("Moving booking %s from viewing slot %s to %s", booking.ID, ID, newID)
+	err := p.session.Execute(c, func(sc mongo.SessionContext) (interface{}, error) {
+		// The repository errors keep their code, FailedPrecondition when the new slot was
+		// booked and NotFound when the old one is no longer booked by the booking.
+		if err := p.Book(sc, newID, booking); err != nil {
+			return nil, err
+		}
+		if err := p.Cancel(sc, ID, booking.ID); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	var appErr errors.AppError
+	if err != nil && !errors.AsAppError(err, &appErr) {
+		// A failed commit or session is not an application error yet.
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return err
+}
+
+// Cancel implements viewing.Repository.
+func (p *ViewingRepositoryMongoImpl) Cancel(c context.Context, ID string, bookingID string) error {
+	p.log.Debug("Cancelling booking %s of viewing slot with ID: %s", bookingID, ID)
+	id, err := p.id(ID)
+	if err != nil {
+		return err
+	}
+	count, err := p.viewing.UpdateMany(
+		c,
+		bson.M{"_id": id, "Booking.ID": bookingID},
+		bson.M{"$unset": bson.M{"Booking": ""}, "$set": bson.M{"Metadata.UpdatedAt": time.Now()}},
+	)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			viewing.ErrBookingNotFound,
+			codes.NotFound,
+		)
+	}
+	return nil
+}
+
+// lockProperty writes the ViewingLock field of the property in the session transaction, the
+// transactions changing the slots of the same property conflict on it.
+func (p *ViewingRepositoryMongoImpl) lockProperty(sc mongo.SessionContext, propertyID string) error {
+	id, err := database.StringToID(propertyID)
+	if err != nil {
+		return errors.NewInvalidArgumentError(err)
+	}
+	count, err := p.properties.UpdateMany(sc, bson.M{"_id": id}, bson.M{"$inc": bson.M{"ViewingLock": 1}})
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if count == 0 {
+		return errors.NewHandlerError(
+			errors.Join(viewing.ErrPropertyNotFound, errors.NewSimple(propertyID)),
+			codes.NotFound,
+		)
+	}
+	return nil
+}
+
+// id returns the stored form of a slot ID, the slots are stored with the UUID of the factory.
+func (p *ViewingRepositoryMongoImpl) id(ID string) (uuid.UUID, error) {
+	id, err := database.StringToID(ID)
+	if err != nil {
+		return uuid.Nil, errors.NewInvalidArgumentError(err)
+	}
+	return id, nil
+}
+
+// find returns the viewing slots matching a filter, the earliest first.
+func (p *ViewingRepositoryMongoImpl) find(c context.Context, filter bson.D) ([]viewing.Viewing, error) {
+	res, aggErr := p.aggregator.Aggregate(c, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "Start", Value: 1}, {Key: "_id", Value: 1}}}},
+	})
+	if aggErr != nil {
+		p.log.Debug("Error in aggregation: %v", aggErr)
+		return nil, errors.NewHandlerError(
+			aggErr,
+			codes.Internal,
+		)
+	}
+
+	finalRes, getErr := res.GetAll(c)
+	if getErr != nil {
+		p.log.Debug("Error in getting all results: %v", getErr)
+		return nil, errors.NewHandlerError(
+			getErr,
+			codes.Internal,
+		)
+	}
+	return *finalRes, nil
+}
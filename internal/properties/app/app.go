@@ -18,18 +18,50 @@ type Application struct {
 
 // Commands holds the command handlers for processing property and owner actions.
 type Commands struct {
-	CreateProperty command.CreatePropertyHandler
-	DeleteProperty command.DeletePropertyHandler
-	UpdateProperty command.UpdatePropertyHandler
-	CreateOwner    command.CreateOwnerHandler
-	DeleteOwner    command.DeleteOwnerHandler
-	UpdateOwner    command.UpdateOwnerHandler
+	CreateProperty         command.CreatePropertyHandler
+	DeleteProperty         command.DeletePropertyHandler
+	UpdateProperty         command.UpdatePropertyHandler
+	PublishProperty        command.MovePropertyHandler
+	MarkPropertyUnderOffer command.MovePropertyHandler
+	MarkPropertyLet        command.MovePropertyHandler
+	MarkPropertySold       command.MovePropertyHandler
+	WithdrawProperty       command.MovePropertyHandler
+	ArchiveProperty        command.MovePropertyHandler
+	BlockDates             command.BlockDatesHandler
+	UnblockDates           command.UnblockDatesHandler
+	CreateViewingSlot      command.CreateViewingSlotHandler
+	DeleteViewingSlot      command.DeleteViewingSlotHandler
+	BookViewing            command.BookViewingHandler
+	CancelViewing          command.CancelViewingHandler
+	RescheduleViewing      command.RescheduleViewingHandler
+	SubmitOffer            command.SubmitOfferHandler
+	AcceptOffer            command.AcceptOfferHandler
+	RejectOffer            command.RejectOfferHandler
+	CounterOffer           command.CounterOfferHandler
+	ImportAreas            command.ImportAreasHandler
+	CreateOwner            command.CreateOwnerHandler
+	DeleteOwner            command.DeleteOwnerHandler
+	UpdateOwner            command.UpdateOwnerHandler
 }
 
 // Queries holds the query handlers for retrieving property and owner information.
 type Queries struct {
-	GetProperty              query.GetPropertyHandler
-	GetOwner                 query.GetOwnerHandler
-	ListPropertiesByCategory query.ListPropertiesByCategoryHandler
-	ListPropertiesByOwner    query.ListPropertiesByOwnerHandler
+	GetProperty                 query.GetPropertyHandler
+	GetOwner                    query.GetOwnerHandler
+	ListPropertiesByCategory    query.ListPropertiesByCategoryHandler
+	ListPropertiesByOwner       query.ListPropertiesByOwnerHandler
+	ListPropertiesNear          query.ListPropertiesNearHandler
+	ListSimilarProperties       query.ListSimilarPropertiesHandler
+	ListPropertiesWithinArea    query.ListPropertiesWithinAreaHandler
+	ListPropertiesWithinCommute query.ListPropertiesWithinCommuteHandler
+	ClusterProperties           query.ClusterPropertiesHandler
+	SearchProperties            query.SearchPropertiesHandler
+	SearchPropertiesByText      query.SearchPropertiesByTextHandler
+	GetPropertyFacets           query.GetPropertyFacetsHandler
+	SuggestProperties           query.SuggestPropertiesHandler
+	GetAvailability             query.GetAvailabilityHandler
+	ListViewingSlots            query.ListViewingSlotsHandler
+	GetOffer                    query.GetOfferHandler
+	ListOffers                  query.ListOffersHandler
+	ReverseGeocode              query.ReverseGeocodeHandler
 }
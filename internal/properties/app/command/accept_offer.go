@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// AcceptOfferCommand : This is the accept offer request in a struct format.
+type AcceptOfferCommand struct {
+	OfferID string `validate:"required"`
+}
+
+// AcceptOfferHandler is a CQRS endpoint that handles a command to accept an offer.
+// It implements the CommandHandler interface for the AcceptOfferCommand.
+// The handler accepts the offer, declines the other open offers and puts the listing under offer.
+type AcceptOfferHandler decorator.CommandHandler[AcceptOfferCommand]
+
+type AcceptOfferHandlerImpl struct {
+	repository offer.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewAcceptOfferHandler creates a new instance of AcceptOfferHandler,
+// applying necessary decorators for logging and validation.
+func NewAcceptOfferHandler(
+	repository offer.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) AcceptOfferHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		AcceptOfferHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the accept offer command, an offer that is not pending and a listing that cannot move
+// to under offer are a failed precondition. So is an offer or listing changed by another request
+// since it was read, nothing is changed then.
+func (cph AcceptOfferHandlerImpl) Handle(
+	c context.Context, cmd AcceptOfferCommand,
+) error {
+	accepted, err := answerableOffer(c, cph.repository, cmd.OfferID, offer.Accepted)
+	if err != nil {
+		return err
+	}
+	listing, err := cph.properties.Get(c, accepted.PropertyID)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if err := property.ValidateTransition(listing.Status, property.UnderOffer, listing.SaleType); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.FailedPrecondition,
+		)
+	}
+	// The repository error keeps its code.
+	return cph.repository.Accept(c, *accepted)
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/area"
+	"property-service/pkg/address"
+)
+
+// containingAreas returns the keys of the named areas whose boundary contains the address,
+// an address without coordinates is in no area.
+func containingAreas(
+	c context.Context,
+	areas area.Repository,
+	addr address.Address,
+) ([]string, error) {
+	if addr.GeoJSON == nil {
+		return nil, nil
+	}
+	found, err := areas.ListContaining(c, *addr.GeoJSON)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(found))
+	for _, a := range found {
+		keys = append(keys, a.Key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,154 @@
+//go:build cse
+// +build cse
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// AvailabilityCalendarTestSuite is the test suite of the availability calendar commands.
+type AvailabilityCalendarTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	block      command.BlockDatesHandler
+	unblock    command.UnblockDatesHandler
+	propertyID string
+	start      time.Time
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the command handlers and a property for rent.
+func (s *AvailabilityCalendarTestSuite) SetupSuite() {
+	calendars := s.ServiceDep.Repo.CalendarRepository
+	properties := s.ServiceDep.Repo.PropertyRepository
+	s.block = command.NewBlockDatesHandler(calendars, properties, s.log, s.validator)
+	s.unblock = command.NewUnblockDatesHandler(calendars, properties, s.log, s.validator)
+	s.start = time.Date(2030, time.July, 1, 0, 0, 0, 0, time.UTC)
+	s.propertyID = database.NewStringID()
+	if _, err := properties.New(
+		s.ctx,
+		property.NewPropertyParams{
+			PropertyID: s.propertyID,
+			OwnerID:    database.NewStringID(),
+			Address: address.Address{
+				FirstLine:  "42",
+				Street:     "Triq ic-Cangar",
+				City:       "Victoria",
+				Country:    "Malta",
+				PostalCode: "VCT2162",
+			},
+			Description:   "A holiday let with an availability calendar",
+			Title:         "Calendar Property",
+			Category:      "House",
+			Status:        property.Published,
+			AvailableDate: time.Now(),
+			SaleType:      uint8(property.ForRent),
+		},
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+}
+
+// days returns the dates from the first up to but excluding the last day after the start.
+func (s *AvailabilityCalendarTestSuite) days(first int, last int) (time.Time, time.Time) {
+	return s.start.AddDate(0, 0, first), s.start.AddDate(0, 0, last)
+}
+
+// requireRanges checks the stored ranges of the calendar of the test property.
+func (s *AvailabilityCalendarTestSuite) requireRanges(expected ...[2]int) {
+	propertyCalendar, err := s.ServiceDep.Repo.CalendarRepository.Get(s.ctx, s.propertyID)
+	s.Require().NoError(err, "Expected no error when reading the calendar")
+	s.Require().Len(propertyCalendar.Ranges, len(expected), "Expected %d ranges", len(expected))
+	for i, days := range expected {
+		start, end := s.days(days[0], days[1])
+		s.True(start.Equal(propertyCalendar.Ranges[i].Start), "Expected range %d to start on %s", i, start)
+		s.True(end.Equal(propertyCalendar.Ranges[i].End), "Expected range %d to end on %s", i, end)
+	}
+}
+
+// TestBlockDates tests that ranges are blocked and an overlapping range is rejected.
+func (s *AvailabilityCalendarTestSuite) TestBlockDates() {
+	start, end := s.days(0, 7)
+	s.Require().NoError(s.block.Handle(s.ctx, command.BlockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end, Kind: calendar.Booked,
+	}))
+	// The end date of a range is free, the next range may start on it.
+	start, end = s.days(7, 10)
+	s.Require().NoError(s.block.Handle(s.ctx, command.BlockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end, Note: "Maintenance",
+	}))
+	s.requireRanges([2]int{0, 7}, [2]int{7, 10})
+
+	start, end = s.days(9, 12)
+	err := s.block.Handle(s.ctx, command.BlockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end,
+	})
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.FailedPrecondition, appErr.Code(), "Expected a failed precondition error")
+	s.requireRanges([2]int{0, 7}, [2]int{7, 10})
+}
+
+// TestUnblockDates tests that a range partly freed is shortened or split.
+func (s *AvailabilityCalendarTestSuite) TestUnblockDates() {
+	start, end := s.days(20, 30)
+	s.Require().NoError(s.block.Handle(s.ctx, command.BlockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end,
+	}))
+	start, end = s.days(23, 25)
+	s.Require().NoError(s.unblock.Handle(s.ctx, command.UnblockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end,
+	}))
+	s.requireRanges([2]int{20, 23}, [2]int{25, 30})
+
+	start, end = s.days(15, 24)
+	s.Require().NoError(s.unblock.Handle(s.ctx, command.UnblockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end,
+	}))
+	s.requireRanges([2]int{25, 30})
+}
+
+// TestBlockDatesEmptyPeriod tests that a period ending before it starts is rejected.
+func (s *AvailabilityCalendarTestSuite) TestBlockDatesEmptyPeriod() {
+	end, start := s.days(40, 45)
+	err := s.block.Handle(s.ctx, command.BlockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end,
+	})
+	s.Error(err, "Expected an error for an empty period")
+}
+
+// TearDownTest frees every date of the test property.
+func (s *AvailabilityCalendarTestSuite) TearDownTest() {
+	start, end := s.days(-1, 366)
+	if err := s.unblock.Handle(s.ctx, command.UnblockDatesCommand{
+		PropertyID: s.propertyID, Start: start, End: end,
+	}); err != nil {
+		s.log.Error("Failed to free the dates after test", err)
+	}
+}
+
+func (s *AvailabilityCalendarTestSuite) TearDownSuite() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.propertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
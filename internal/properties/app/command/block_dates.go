@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// BlockDatesCommand : This is the block dates request in a struct format, the dates from
+// Start up to but excluding End are blocked.
+type BlockDatesCommand struct {
+	PropertyID string        `validate:"required"`
+	Start      time.Time     `validate:"required"`
+	End        time.Time     `validate:"required"`
+	Kind       calendar.Kind `validate:"omitempty,oneof=1 2"` // Blocked when unset.
+	Note       string        `validate:"omitempty,max=200"`
+}
+
+// BlockDatesHandler is a CQRS endpoint that handles a command to block the dates of a property.
+// It implements the CommandHandler interface for the BlockDatesCommand.
+// The handler adds the dates to the availability calendar of the property.
+type BlockDatesHandler decorator.CommandHandler[BlockDatesCommand]
+
+type BlockDatesHandlerImpl struct {
+	repository calendar.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewBlockDatesHandler creates a new instance of BlockDatesHandler,
+// applying necessary decorators for logging and validation.
+func NewBlockDatesHandler(
+	repository calendar.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) BlockDatesHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		BlockDatesHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the block dates command, dates that overlap dates already blocked or booked are a
+// failed precondition and a calendar changed by another request since it was read is aborted.
+func (cph BlockDatesHandlerImpl) Handle(
+	c context.Context, cmd BlockDatesCommand,
+) error {
+	propertyCalendar, period, err := propertyCalendar(
+		c, cph.properties, cph.repository, cmd.PropertyID, cmd.Start, cmd.End,
+	)
+	if err != nil {
+		return err
+	}
+	kind := cmd.Kind
+	if kind == calendar.UnknownKind {
+		kind = calendar.Blocked
+	}
+	if err := propertyCalendar.Block(calendar.DateRange{
+		Period: period,
+		Kind:   kind,
+		Note:   cmd.Note,
+	}); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.FailedPrecondition,
+		)
+	}
+	// The repository error keeps its code, Aborted when the calendar changed.
+	return cph.repository.Save(c, propertyCalendar)
+}
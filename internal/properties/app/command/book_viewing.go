@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// BookViewingCommand : This is the book viewing request in a struct format, the BookingID
+// identifies the booking when it is cancelled or rescheduled.
+type BookViewingCommand struct {
+	ViewingID string `validate:"required"`
+	BookingID string `validate:"required"`
+	Name      string `validate:"required,lt=100"`
+	Email     string `validate:"required,email"`
+	Telephone string `validate:"omitempty,gte=7,lte=15"`
+}
+
+// BookViewingHandler is a CQRS endpoint that handles a command to book a viewing.
+// It implements the CommandHandler interface for the BookViewingCommand.
+// The handler books a free viewing slot of a published property.
+type BookViewingHandler decorator.CommandHandler[BookViewingCommand]
+
+type BookViewingHandlerImpl struct {
+	repository viewing.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewBookViewingHandler creates a new instance of BookViewingHandler,
+// applying necessary decorators for logging and validation.
+func NewBookViewingHandler(
+	repository viewing.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) BookViewingHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		BookViewingHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the book viewing command, a booked or past slot and a listing that is not published
+// are a failed precondition.
+func (cph BookViewingHandlerImpl) Handle(
+	c context.Context, cmd BookViewingCommand,
+) error {
+	if _, err := bookableViewing(c, cph.properties, cph.repository, cmd.ViewingID); err != nil {
+		return err
+	}
+	// The repository error keeps its code, FailedPrecondition when the slot was booked since
+	// it was read.
+	return cph.repository.Book(c, cmd.ViewingID, viewing.Booking{
+		ID:        cmd.BookingID,
+		Name:      cmd.Name,
+		Email:     cmd.Email,
+		Telephone: cmd.Telephone,
+		BookedAt:  time.Now(),
+	})
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+)
+
+// propertyCalendar returns the availability calendar of an existing property and the period
+// from start up to end, an empty or too long period is an invalid argument.
+func propertyCalendar(
+	c context.Context,
+	properties property.Repository,
+	calendars calendar.Repository,
+	id string,
+	start time.Time,
+	end time.Time,
+) (*calendar.Calendar, calendar.Period, error) {
+	period, err := calendar.NewPeriod(start, end)
+	if err != nil {
+		return nil, calendar.Period{}, errors.NewInvalidArgumentError(err)
+	}
+	if _, err := properties.Get(c, id); err != nil {
+		return nil, calendar.Period{}, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	propertyCalendar, err := calendars.Get(c, id)
+	if err != nil {
+		return nil, calendar.Period{}, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return propertyCalendar, period, nil
+}
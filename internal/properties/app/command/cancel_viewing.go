@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// CancelViewingCommand : This is the cancel viewing request in a struct format.
+type CancelViewingCommand struct {
+	ViewingID string `validate:"required"`
+	BookingID string `validate:"required"`
+}
+
+// CancelViewingHandler is a CQRS endpoint that handles a command to cancel a viewing.
+// It implements the CommandHandler interface for the CancelViewingCommand.
+// The handler frees the viewing slot so it can be booked again.
+type CancelViewingHandler decorator.CommandHandler[CancelViewingCommand]
+
+type CancelViewingHandlerImpl struct {
+	repository viewing.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewCancelViewingHandler creates a new instance of CancelViewingHandler,
+// applying necessary decorators for logging and validation.
+func NewCancelViewingHandler(
+	repository viewing.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) CancelViewingHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		CancelViewingHandlerImpl{
+			repository: repository,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the cancel viewing command, a slot not booked by the booking is not found.
+func (cph CancelViewingHandlerImpl) Handle(
+	c context.Context, cmd CancelViewingCommand,
+) error {
+	return cph.repository.Cancel(c, cmd.ViewingID, cmd.BookingID)
+}
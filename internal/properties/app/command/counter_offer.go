@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// CounterOfferCommand : This is the counter offer request in a struct format, the Amount is in
+// the minor units of the currency of the offer.
+type CounterOfferCommand struct {
+	OfferID string `validate:"required"`
+	Amount  int64  `validate:"gt=0"`
+	Message string `validate:"omitempty,max=2000"`
+}
+
+// CounterOfferHandler is a CQRS endpoint that handles a command to counter an offer.
+// It implements the CommandHandler interface for the CounterOfferCommand.
+// The handler answers a pending offer with the amount the owner would accept.
+type CounterOfferHandler decorator.CommandHandler[CounterOfferCommand]
+
+type CounterOfferHandlerImpl struct {
+	repository offer.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewCounterOfferHandler creates a new instance of CounterOfferHandler,
+// applying necessary decorators for logging and validation.
+func NewCounterOfferHandler(
+	repository offer.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) CounterOfferHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		CounterOfferHandlerImpl{
+			repository: repository,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the counter offer command, an offer that is not pending is a failed precondition. The
+// applicant answers a counter offer by submitting a new offer.
+func (cph CounterOfferHandlerImpl) Handle(
+	c context.Context, cmd CounterOfferCommand,
+) error {
+	countered, err := answerableOffer(c, cph.repository, cmd.OfferID, offer.Countered)
+	if err != nil {
+		return err
+	}
+	// The repository error keeps its code, FailedPrecondition when the offer was answered
+	// since it was read.
+	return cph.repository.Respond(c, countered.ID, countered.Status, offer.Countered, &offer.Counter{
+		Amount:  money.New(cmd.Amount, countered.Amount.Currency),
+		Message: cmd.Message,
+	})
+}
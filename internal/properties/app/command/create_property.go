@@ -4,12 +4,15 @@ import (
 	"context"
 	"time"
 
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
 	"property-service/pkg/address"
 	"property-service/pkg/decorator"
 	"property-service/pkg/errors"
 	"property-service/pkg/errors/codes"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -21,10 +24,15 @@ type CreatePropertyCommand struct {
 	Category      string          `validate:"required"`
 	Description   string          `validate:"required"`
 	Title         string          `validate:"required"`
-	Available     bool            `validate:"required"`
 	AvailableDate time.Time       `validate:"required"`
-	Address       address.Address `validate:"required"`
+	Address       address.Address `validate:"required,address"`
 	SaleType      uint8           `validate:"required"`
+	// Rent is required for rent and AskingPrice for sale, both when the property is for both.
+	Rent        *property.Rent `validate:"omitempty"`
+	AskingPrice *money.Money   `validate:"omitempty"`
+	// Attributes are the rooms, floor area and features of the property, see
+	// property.NewAttributes for the feature keys.
+	Attributes *property.Attributes `validate:"omitempty"`
 }
 
 // CreatePropertyHandler is a CQRS endpoint that handles a command to create a property.
@@ -34,6 +42,9 @@ type CreatePropertyHandler decorator.CommandHandler[CreatePropertyCommand]
 
 type CreatePropertyHandlerImpl struct {
 	repository property.Repository
+	geocoder   address.Geocoder
+	areas      area.Repository
+	pois       poi.Repository
 	validator  *validator.Validate
 	log        log.Logger
 }
@@ -42,15 +53,30 @@ type CreatePropertyHandlerImpl struct {
 // applying necessary decorators for logging and validation.
 func NewCreatePropertyHandler(
 	repository property.Repository,
+	geocoder address.Geocoder,
+	areas area.Repository,
+	pois poi.Repository,
 	logger log.Logger,
 	validator *validator.Validate,
 ) CreatePropertyHandler {
 	if repository == nil {
 		logger.Panic("nil repository")
 	}
+	if geocoder == nil {
+		logger.Panic("nil geocoder")
+	}
+	if areas == nil {
+		logger.Panic("nil area repository")
+	}
+	if pois == nil {
+		logger.Panic("nil point of interest repository")
+	}
 	return decorator.ApplyCommandDecorators(
 		CreatePropertyHandlerImpl{
 			repository: repository,
+			geocoder:   geocoder,
+			areas:      areas,
+			pois:       pois,
 			validator:  validator,
 			log:        logger,
 		},
@@ -59,10 +85,27 @@ func NewCreatePropertyHandler(
 	)
 }
 
-// Validate the create property command.
+// Handle the create property command, an address without coordinates is geocoded and the
+// property is tagged with the named areas containing it and enriched with the nearest points
+// of interest. The property is a draft until it is published.
 func (cph CreatePropertyHandlerImpl) Handle(
 	c context.Context, cmd CreatePropertyCommand,
 ) error {
+	if err := property.ValidatePricing(cmd.SaleType, cmd.Rent, cmd.AskingPrice); err != nil {
+		return errors.NewInvalidArgumentError(err)
+	}
+	propertyAddress, err := geocodeAddress(c, cph.geocoder, cmd.Address)
+	if err != nil {
+		return err
+	}
+	areas, err := containingAreas(c, cph.areas, propertyAddress)
+	if err != nil {
+		return err
+	}
+	nearby, err := nearbyPOIs(c, cph.pois, propertyAddress)
+	if err != nil {
+		return err
+	}
 	if _, registerErr := cph.repository.New(
 		c,
 		property.NewPropertyParams{
@@ -71,10 +114,15 @@ func (cph CreatePropertyHandlerImpl) Handle(
 			Category:      cmd.Category,
 			Description:   cmd.Description,
 			Title:         cmd.Title,
-			Available:     cmd.Available,
+			Status:        property.Draft,
 			AvailableDate: cmd.AvailableDate,
-			Address:       cmd.Address,
+			Address:       propertyAddress,
 			SaleType:      cmd.SaleType,
+			Areas:         areas,
+			Nearby:        nearby,
+			Rent:          cmd.Rent,
+			AskingPrice:   cmd.AskingPrice,
+			Attributes:    cmd.Attributes,
 		},
 	); registerErr != nil {
 		return errors.NewHandlerError(
@@ -6,12 +6,16 @@ package command_test
 import (
 	"context"
 	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
 	"property-service/internal/properties/service"
 	"property-service/pkg/address"
 	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
 	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -36,6 +40,9 @@ func (s *NewPropertyTestSuite) SetupTest() {
 	// Initialize the command handler
 	s.handler = command.NewCreatePropertyHandler(
 		s.ServiceDep.Repo.PropertyRepository,
+		testGeocoder,
+		s.ServiceDep.Repo.AreaRepository,
+		s.ServiceDep.Repo.POIRepository,
 		s.log,
 		s.validator,
 	)
@@ -53,9 +60,9 @@ func (s *NewPropertyTestSuite) SetupTest() {
 		Description:   "A beautiful property",
 		Title:         "Beautiful Property",
 		Category:      "House",
-		Available:     true,
 		AvailableDate: time.Now(),
 		SaleType:      1,
+		Rent:          &property.Rent{Price: money.New(120000, "EUR"), Period: property.Monthly},
 	}
 }
 
@@ -71,6 +78,214 @@ func (s *NewPropertyTestSuite) TestCreatePropertyHandler() {
 	s.NotNil(property, "Expected property to be found")
 	s.Equal(s.params.Title, property.Title, "Expected property title to match")
 	s.Equal(s.params.Description, property.Description, "Expected property description to match")
+	s.Require().NotNil(property.Address.GeoJSON, "Expected the address to be geocoded")
+	s.Equal(testPoint.Coordinates, property.Address.GeoJSON.Coordinates, "Expected [lng, lat] coordinates")
+}
+
+// TestCreatePropertyKeepsCoordinates tests that given coordinates are not geocoded.
+func (s *NewPropertyTestSuite) TestCreatePropertyKeepsCoordinates() {
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	params.Address.GeoJSON = address.NewPoint(35.8989, 14.5146)
+	err := s.handler.Handle(s.ctx, params)
+	s.NoError(err, "Expected no error when creating a property")
+
+	property, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, params.PropertyID)
+	s.NoError(err, "Expected no error when finding the property")
+	s.Require().NotNil(property.Address.GeoJSON, "Expected the coordinates to be stored")
+	s.Equal([2]float64{14.5146, 35.8989}, property.Address.GeoJSON.Coordinates, "Expected [lng, lat] coordinates")
+}
+
+// TestCreatePropertyPricing tests that the prices of the sale type are stored, a weekly rent
+// with its monthly amount.
+func (s *NewPropertyTestSuite) TestCreatePropertyPricing() {
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	params.SaleType = uint8(property.ForBoth)
+	params.Rent = &property.Rent{Price: money.New(30000, "eur"), Period: property.Weekly}
+	params.AskingPrice = &money.Money{Amount: 35000000, Currency: "EUR"}
+	err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when creating a property")
+
+	created, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, params.PropertyID)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.Require().NotNil(created.Rent, "Expected the rent to be stored")
+	s.Equal(money.New(30000, "EUR"), created.Rent.Price, "Expected the rent in upper case currency")
+	s.Equal(int64(130000), created.Rent.MonthlyAmount, "Expected 52 weeks over 12 months")
+	s.Require().NotNil(created.AskingPrice, "Expected the asking price to be stored")
+	s.Equal(*params.AskingPrice, *created.AskingPrice)
+}
+
+// TestCreatePropertyPricingMismatch tests that the prices must match the sale type.
+func (s *NewPropertyTestSuite) TestCreatePropertyPricingMismatch() {
+	for name, mutate := range map[string]func(*command.CreatePropertyCommand){
+		"rent missing": func(cmd *command.CreatePropertyCommand) { cmd.Rent = nil },
+		"asking price not for sale": func(cmd *command.CreatePropertyCommand) {
+			cmd.AskingPrice = &money.Money{Amount: 100, Currency: "EUR"}
+		},
+		"unknown currency": func(cmd *command.CreatePropertyCommand) {
+			cmd.Rent = &property.Rent{Price: money.New(100, "XYZ"), Period: property.Monthly}
+		},
+		"unknown period": func(cmd *command.CreatePropertyCommand) {
+			cmd.Rent = &property.Rent{Price: money.New(100, "EUR")}
+		},
+	} {
+		params := s.params
+		params.PropertyID = database.NewStringID()
+		mutate(&params)
+		err := s.handler.Handle(s.ctx, params)
+		s.Error(err, "Expected an error for "+name)
+	}
+}
+
+// TestCreatePropertyAttributes tests that the attributes are stored with the floor area in
+// square metres and the amenity names as sorted keys without duplicates.
+func (s *NewPropertyTestSuite) TestCreatePropertyAttributes() {
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	params.Attributes = &property.Attributes{
+		Bedrooms:       3,
+		Bathrooms:      2,
+		ReceptionRooms: 1,
+		FloorArea:      &property.FloorArea{Size: 1000, Unit: property.SquareFeet},
+		Furnishing:     property.PartFurnished,
+		Parking:        property.GarageParking,
+		Garden:         true,
+		PetsAllowed:    true,
+		Accessibility:  []string{"lift", "step-free-access"},
+		Amenities:      []string{"Swimming Pool", "Air Conditioning", "air-conditioning"},
+	}
+	err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when creating a property")
+
+	created, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, params.PropertyID)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.Require().NotNil(created.Attributes, "Expected the attributes to be stored")
+	s.Equal(uint8(3), created.Attributes.Bedrooms)
+	s.Equal(property.GarageParking, created.Attributes.Parking)
+	s.Require().NotNil(created.Attributes.FloorArea, "Expected the floor area to be stored")
+	s.Equal(92.9, created.Attributes.FloorArea.SquareMetres, "Expected the floor area in square metres")
+	s.Equal([]string{"lift", "step-free-access"}, created.Attributes.Accessibility)
+	s.Equal([]string{"air-conditioning", "swimming-pool"}, created.Attributes.Amenities,
+		"Expected the amenities as sorted keys without duplicates")
+}
+
+// TestCreatePropertyInvalidAttributes tests that attributes out of range are rejected.
+func (s *NewPropertyTestSuite) TestCreatePropertyInvalidAttributes() {
+	for name, attributes := range map[string]property.Attributes{
+		"too many bedrooms":       {Bedrooms: 51},
+		"floor area without unit": {FloorArea: &property.FloorArea{Size: 80}},
+		"empty floor area":        {FloorArea: &property.FloorArea{Unit: property.SquareMetres}},
+		"unknown furnishing":      {Furnishing: 4},
+		"unknown accessibility":   {Accessibility: []string{"escalator"}},
+		"duplicate accessibility": {Accessibility: []string{"lift", "lift"}},
+	} {
+		params := s.params
+		params.PropertyID = database.NewStringID()
+		params.Attributes = &attributes
+		err := s.handler.Handle(s.ctx, params)
+		s.Error(err, "Expected an error for "+name)
+	}
+}
+
+// TestCreatePropertyUnresolvedAddress tests that an address the geocoder cannot resolve is
+// an invalid argument.
+func (s *NewPropertyTestSuite) TestCreatePropertyUnresolvedAddress() {
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	params.Address.City = unknownCity
+	params.Address.PostalCode = ""
+	err := s.handler.Handle(s.ctx, params)
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.InvalidArgument, appErr.Code(), "Expected an invalid argument error")
+}
+
+// TestCreatePropertyCanonicalAddress tests that the address is stored in its canonical form.
+func (s *NewPropertyTestSuite) TestCreatePropertyCanonicalAddress() {
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	params.Address.Street = "triq  ic-cangar"
+	params.Address.City = "VICTORIA"
+	params.Address.Country = "mt"
+	params.Address.PostalCode = "vct2162"
+	err := s.handler.Handle(s.ctx, params)
+	s.NoError(err, "Expected no error when creating a property")
+
+	property, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, params.PropertyID)
+	s.NoError(err, "Expected no error when finding the property")
+	s.Equal("Triq Ic-Cangar", property.Address.Street, "Expected a capitalised street")
+	s.Equal("Victoria", property.Address.City, "Expected a capitalised city")
+	s.Equal("Malta", property.Address.Country, "Expected the ISO 3166-1 country name")
+	s.Equal("VCT 2162", property.Address.PostalCode, "Expected the postcode in the Maltese format")
+}
+
+// TestCreatePropertyInvalidPostcode tests that a postcode not valid for its country is an
+// invalid argument.
+func (s *NewPropertyTestSuite) TestCreatePropertyInvalidPostcode() {
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	params.Address.PostalCode = "12345"
+	err := s.handler.Handle(s.ctx, params)
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.InvalidArgument, appErr.Code(), "Expected an invalid argument error")
+}
+
+// TestCreatePropertyNearbyPOIs tests that the property is enriched with the nearest point of
+// interest of every type within the maximum distance.
+func (s *NewPropertyTestSuite) TestCreatePropertyNearbyPOIs() {
+	pois, dataset, err := poi.LoadFile("testdata/pois.geojson")
+	s.Require().NoError(err, "Expected the test points of interest to load")
+	count, _, err := s.ServiceDep.Repo.POIRepository.Replace(s.ctx, dataset, pois)
+	s.Require().NoError(err, "Expected no error when storing the points of interest")
+	// The same dataset is not loaded twice.
+	again, changed, err := s.ServiceDep.Repo.POIRepository.Replace(s.ctx, dataset, pois)
+	s.Require().NoError(err, "Expected no error when storing the points of interest again")
+	s.False(changed, "Expected the stored points of interest to be kept")
+	s.Equal(count, again, "Expected every point of interest to be kept")
+
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	err = s.handler.Handle(s.ctx, params)
+	s.NoError(err, "Expected no error when creating a property")
+
+	property, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, params.PropertyID)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.Require().Contains(property.Nearby, "station", "Expected the nearest station")
+	s.Equal("Victoria Bus Terminus", property.Nearby["station"].Name, "Expected the station name")
+	s.InDelta(300, property.Nearby["station"].Distance, 10, "Expected the distance in metres")
+	s.Contains(property.Nearby, "school", "Expected the nearest school")
+	s.NotContains(property.Nearby, "park", "Expected no park beyond the maximum distance")
+}
+
+// TestRefreshNearbyPOIs tests that a stored property gets the nearest points of interest of a
+// changed dataset.
+func (s *NewPropertyTestSuite) TestRefreshNearbyPOIs() {
+	_, _, err := s.ServiceDep.Repo.POIRepository.Replace(s.ctx, "empty", nil)
+	s.Require().NoError(err, "Expected no error when removing the points of interest")
+	params := s.params
+	params.PropertyID = database.NewStringID()
+	s.Require().NoError(s.handler.Handle(s.ctx, params), "Expected no error when creating a property")
+
+	pois, dataset, err := poi.LoadFile("testdata/pois.geojson")
+	s.Require().NoError(err, "Expected the test points of interest to load")
+	_, changed, err := s.ServiceDep.Repo.POIRepository.Replace(s.ctx, dataset, pois)
+	s.Require().NoError(err, "Expected no error when storing the points of interest")
+	s.Require().True(changed, "Expected the points of interest to change")
+	refreshed, err := s.ServiceDep.Repo.PropertyRepository.RefreshNearby(
+		s.ctx,
+		func(c context.Context, point address.GeoJSONCoordinates) (map[string]property.NearbyPOI, error) {
+			found, err := s.ServiceDep.Repo.POIRepository.NearestPerType(c, point, poi.DefaultMaxDistance)
+			return poi.Nearby(found), err
+		},
+	)
+	s.Require().NoError(err, "Expected no error when refreshing the properties")
+	s.Positive(refreshed, "Expected the new property to be refreshed")
+
+	property, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, params.PropertyID)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.Contains(property.Nearby, "station", "Expected the nearest station of the new dataset")
 }
 
 func (s *NewPropertyTestSuite) TearDownSuite() {
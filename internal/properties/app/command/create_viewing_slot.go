@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateViewingSlotCommand : This is the create viewing slot request in a struct format, the
+// slot runs from Start up to End.
+type CreateViewingSlotCommand struct {
+	ViewingID  string    `validate:"required"`
+	PropertyID string    `validate:"required"`
+	Start      time.Time `validate:"required"`
+	End        time.Time `validate:"required"`
+}
+
+// CreateViewingSlotHandler is a CQRS endpoint that handles a command to create a viewing slot.
+// It implements the CommandHandler interface for the CreateViewingSlotCommand.
+// The handler stores a free slot the owner offers for viewings of the property.
+type CreateViewingSlotHandler decorator.CommandHandler[CreateViewingSlotCommand]
+
+type CreateViewingSlotHandlerImpl struct {
+	repository viewing.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewCreateViewingSlotHandler creates a new instance of CreateViewingSlotHandler,
+// applying necessary decorators for logging and validation.
+func NewCreateViewingSlotHandler(
+	repository viewing.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) CreateViewingSlotHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		CreateViewingSlotHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the create viewing slot command, an empty, too long or past slot is an invalid
+// argument and a slot overlapping another slot of the property is a failed precondition.
+func (cph CreateViewingSlotHandlerImpl) Handle(
+	c context.Context, cmd CreateViewingSlotCommand,
+) error {
+	if err := viewing.ValidateSlot(cmd.Start, cmd.End, time.Now()); err != nil {
+		return errors.NewInvalidArgumentError(err)
+	}
+	if _, err := cph.properties.Get(c, cmd.PropertyID); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	// The repository error keeps its code, FailedPrecondition when the slot overlaps another.
+	_, err := cph.repository.New(c, viewing.NewViewingParams{
+		ViewingID:  cmd.ViewingID,
+		PropertyID: cmd.PropertyID,
+		Start:      cmd.Start,
+		End:        cmd.End,
+	})
+	return err
+}
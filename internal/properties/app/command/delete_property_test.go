@@ -62,7 +62,7 @@ func (s *DeletePropertyTestSuite) SetupTest() {
 			Description:   "A beautiful property",
 			Title:         "Beautiful Property",
 			Category:      "House",
-			Available:     true,
+			Status:        property.Published,
 			AvailableDate: time.Now(),
 			SaleType:      1,
 		},
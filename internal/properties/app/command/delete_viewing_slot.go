@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DeleteViewingSlotCommand : This is the delete viewing slot request in a struct format.
+type DeleteViewingSlotCommand struct {
+	ViewingID string `validate:"required"`
+}
+
+// DeleteViewingSlotHandler is a CQRS endpoint that handles a command to delete a viewing slot.
+// It implements the CommandHandler interface for the DeleteViewingSlotCommand.
+// The handler removes a slot the owner no longer offers.
+type DeleteViewingSlotHandler decorator.CommandHandler[DeleteViewingSlotCommand]
+
+type DeleteViewingSlotHandlerImpl struct {
+	repository viewing.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewDeleteViewingSlotHandler creates a new instance of DeleteViewingSlotHandler,
+// applying necessary decorators for logging and validation.
+func NewDeleteViewingSlotHandler(
+	repository viewing.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) DeleteViewingSlotHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		DeleteViewingSlotHandlerImpl{
+			repository: repository,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the delete viewing slot command, an unknown slot is not found and a booked slot is a
+// failed precondition, its booking has to be cancelled first.
+func (cph DeleteViewingSlotHandlerImpl) Handle(
+	c context.Context, cmd DeleteViewingSlotCommand,
+) error {
+	// The repository errors keep their code.
+	if _, err := cph.repository.Get(c, cmd.ViewingID); err != nil {
+		return err
+	}
+	return cph.repository.Delete(c, cmd.ViewingID)
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"property-service/pkg/address"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+)
+
+// geocodeAddress returns the canonical address with its GeoJSON point, an address without
+// coordinates is resolved by the geocoder. An address that is not valid or the geocoder cannot
+// resolve is an invalid argument and an application error of the geocoder, e.g. Unavailable,
+// keeps its code.
+func geocodeAddress(
+	c context.Context,
+	geocoder address.Geocoder,
+	addr address.Address,
+) (address.Address, error) {
+	addr, err := address.Normalise(addr)
+	if err != nil {
+		return addr, errors.NewInvalidArgumentError(err)
+	}
+	if addr.GeoJSON != nil {
+		return addr, nil
+	}
+	point, err := geocoder.Geocode(c, addr.String())
+	var appErr errors.AppError
+	switch {
+	case errors.AsAppError(err, &appErr):
+		return addr, err
+	case errors.Compare(err, address.ErrAddressNotFound), errors.Compare(err, address.ErrEmptyAddress):
+		return addr, errors.NewInvalidArgumentError(err)
+	case err != nil:
+		return addr, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	addr.GeoJSON = point
+	return addr, nil
+}
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ImportAreasCommand : This is the import areas request in a struct format.
+type ImportAreasCommand struct {
+	Areas []area.NewAreaParams `validate:"required,min=1,dive"`
+}
+
+// ImportAreasHandler is a CQRS endpoint that handles a command to import named areas.
+// It implements the CommandHandler interface for the ImportAreasCommand.
+// The handler saves every area and tags the properties inside an area whose boundary changed.
+type ImportAreasHandler decorator.CommandHandler[ImportAreasCommand]
+
+type ImportAreasHandlerImpl struct {
+	areas      area.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewImportAreasHandler creates a new instance of ImportAreasHandler,
+// applying necessary decorators for logging and validation.
+func NewImportAreasHandler(
+	areas area.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) ImportAreasHandler {
+	if areas == nil {
+		logger.Panic("nil area repository")
+	}
+	if properties == nil {
+		logger.Panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		ImportAreasHandlerImpl{
+			areas:      areas,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the import areas command, the tags of an area are only recomputed when its
+// boundary is new or has changed.
+func (iah ImportAreasHandlerImpl) Handle(
+	c context.Context, cmd ImportAreasCommand,
+) error {
+	for _, params := range cmd.Areas {
+		saved, changed, err := iah.areas.Save(c, params)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		if err := iah.properties.TagArea(c, saved.Key, saved.Boundary); err != nil {
+			return errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+//go:build cse
+// +build cse
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ImportAreasTestSuite is the test suite for the import areas command.
+type ImportAreasTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    command.ImportAreasHandler
+	create     command.CreatePropertyHandler
+	name       string
+	ServiceDep service.Dependencies
+}
+
+// SetupTest initializes the test suite.
+func (s *ImportAreasTestSuite) SetupTest() {
+	s.handler = command.NewImportAreasHandler(
+		s.ServiceDep.Repo.AreaRepository,
+		s.ServiceDep.Repo.PropertyRepository,
+		s.log,
+		s.validator,
+	)
+	s.create = command.NewCreatePropertyHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		testGeocoder,
+		s.ServiceDep.Repo.AreaRepository,
+		s.ServiceDep.Repo.POIRepository,
+		s.log,
+		s.validator,
+	)
+	// A new area per test so the tags of other tests do not interfere.
+	s.name = "Test Area " + database.NewStringID()
+}
+
+// squareAround returns a boundary of about 2 km around the point.
+func squareAround(lat, lng float64) address.GeoJSONMultiPolygon {
+	return address.NewMultiPolygon(address.GeoJSONPolygon{
+		Type: "Polygon",
+		Coordinates: [][][2]float64{{
+			{lng - 0.01, lat - 0.01},
+			{lng + 0.01, lat - 0.01},
+			{lng + 0.01, lat + 0.01},
+			{lng - 0.01, lat + 0.01},
+			{lng - 0.01, lat - 0.01},
+		}},
+	})
+}
+
+// createProperty creates a property at the testPoint postcode and returns its ID.
+func (s *ImportAreasTestSuite) createProperty() string {
+	id := database.NewStringID()
+	err := s.create.Handle(s.ctx, command.CreatePropertyCommand{
+		PropertyID: id,
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "42",
+			Street:     "Triq ic-Cangar",
+			City:       "Victoria",
+			Country:    "Malta",
+			PostalCode: "VCT2162",
+		},
+		Description:   "A property inside an area",
+		Title:         "Area Property",
+		Category:      "House",
+		AvailableDate: time.Now(),
+		SaleType:      1,
+		Rent:          &property.Rent{Price: money.New(120000, "EUR"), Period: property.Monthly},
+	})
+	s.Require().NoError(err, "Expected no error when creating a property")
+	return id
+}
+
+// TestImportAreaTagsNewProperties tests that a property created inside an area is tagged.
+func (s *ImportAreasTestSuite) TestImportAreaTagsNewProperties() {
+	err := s.handler.Handle(s.ctx, command.ImportAreasCommand{
+		Areas: []area.NewAreaParams{{
+			Name:     s.name,
+			Kind:     "neighbourhood",
+			Boundary: squareAround(36.0443, 14.2394),
+		}},
+	})
+	s.Require().NoError(err, "Expected no error when importing an area")
+
+	id := s.createProperty()
+	prop, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, id)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.Contains(prop.Areas, area.Key(s.name), "Expected the property to be tagged with the area")
+}
+
+// TestImportAreaRetagsChangedBoundary tests that moving the boundary of an area removes its
+// tag from the properties left outside and adds it to the properties inside.
+func (s *ImportAreasTestSuite) TestImportAreaRetagsChangedBoundary() {
+	id := s.createProperty()
+	key := area.Key(s.name)
+
+	err := s.handler.Handle(s.ctx, command.ImportAreasCommand{
+		Areas: []area.NewAreaParams{{Name: s.name, Boundary: squareAround(36.0443, 14.2394)}},
+	})
+	s.Require().NoError(err, "Expected no error when importing an area")
+	prop, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, id)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.Contains(prop.Areas, key, "Expected the existing property to be tagged with the area")
+
+	err = s.handler.Handle(s.ctx, command.ImportAreasCommand{
+		Areas: []area.NewAreaParams{{Name: s.name, Boundary: squareAround(35.8989, 14.5146)}},
+	})
+	s.Require().NoError(err, "Expected no error when importing the area again")
+	prop, err = s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, id)
+	s.Require().NoError(err, "Expected no error when finding the property")
+	s.NotContains(prop.Areas, key, "Expected the tag to be removed outside the new boundary")
+}
+
+// TestImportAreaConcurrently tests that an area imported by several requests at once is
+// stored once, a single save creates it.
+func (s *ImportAreasTestSuite) TestImportAreaConcurrently() {
+	params := area.NewAreaParams{Name: s.name, Boundary: squareAround(36.0443, 14.2394)}
+	type saved struct {
+		changed bool
+		err     error
+	}
+	results := make(chan saved, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, changed, err := s.ServiceDep.Repo.AreaRepository.Save(s.ctx, params)
+			results <- saved{changed, err}
+		}()
+	}
+	created := 0
+	for i := 0; i < 5; i++ {
+		result := <-results
+		s.Require().NoError(result.err, "Expected no error when saving the area")
+		if result.changed {
+			created++
+		}
+	}
+	s.Equal(1, created, "Expected the area to be created once")
+}
+
+// TestImportAreaInvalidBoundary tests that an invalid boundary is an invalid argument for a new
+// area and an existing one, and so is a name without a key.
+func (s *ImportAreasTestSuite) TestImportAreaInvalidBoundary() {
+	_, _, err := s.ServiceDep.Repo.AreaRepository.Save(s.ctx, area.NewAreaParams{
+		Name: "!!!", Boundary: squareAround(36.0443, 14.2394),
+	})
+	s.requireCode(err, codes.InvalidArgument)
+
+	invalid := address.NewMultiPolygon(address.GeoJSONPolygon{
+		Type:        "Polygon",
+		Coordinates: [][][2]float64{{{14.23, 36.04}, {14.24, 36.04}, {14.24, 36.05}}},
+	})
+	_, _, err = s.ServiceDep.Repo.AreaRepository.Save(s.ctx, area.NewAreaParams{Name: s.name, Boundary: invalid})
+	s.requireCode(err, codes.InvalidArgument)
+
+	_, _, err = s.ServiceDep.Repo.AreaRepository.Save(s.ctx, area.NewAreaParams{
+		Name: s.name, Boundary: squareAround(36.0443, 14.2394),
+	})
+	s.Require().NoError(err, "Expected no error when saving the area")
+	_, _, err = s.ServiceDep.Repo.AreaRepository.Save(s.ctx, area.NewAreaParams{Name: s.name, Boundary: invalid})
+	s.requireCode(err, codes.InvalidArgument)
+}
+
+// requireCode checks that the error is an application error with the code.
+func (s *ImportAreasTestSuite) requireCode(err error, code codes.Code) {
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(code, appErr.Code(), "Expected a %s error", code)
+}
+
+// TestImportAreasInvalidCommand tests that an empty import is rejected.
+func (s *ImportAreasTestSuite) TestImportAreasInvalidCommand() {
+	err := s.handler.Handle(s.ctx, command.ImportAreasCommand{})
+	s.Error(err, "Expected an error when importing no areas")
+}
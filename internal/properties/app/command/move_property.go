@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// MovePropertyCommand : This is the request to move a property to another listing status in a struct format.
+type MovePropertyCommand struct {
+	PropertyID string `validate:"required"`
+}
+
+// MovePropertyHandler is a CQRS endpoint that handles a command to move a property to the
+// listing status of the handler, e.g. to publish it or mark it as sold.
+type MovePropertyHandler decorator.CommandHandler[MovePropertyCommand]
+
+type MovePropertyHandlerImpl struct {
+	repository property.Repository
+	to         property.Status
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewMovePropertyHandler creates a new instance of MovePropertyHandler moving properties to
+// the status, applying necessary decorators for logging and validation.
+func NewMovePropertyHandler(
+	repository property.Repository,
+	to property.Status,
+	logger log.Logger,
+	validator *validator.Validate,
+) MovePropertyHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		MovePropertyHandlerImpl{
+			repository: repository,
+			to:         to,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the move property command, see property.ValidateTransition for the statuses a
+// property can be moved from.
+func (cph MovePropertyHandlerImpl) Handle(
+	c context.Context, cmd MovePropertyCommand,
+) error {
+	return moveProperty(c, cph.repository, cmd.PropertyID, cph.to)
+}
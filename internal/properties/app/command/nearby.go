@@ -0,0 +1,26 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/poi"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/address"
+)
+
+// nearbyPOIs returns the nearest point of interest of every type within poi.DefaultMaxDistance
+// of the address by type, an address without coordinates has none.
+func nearbyPOIs(
+	c context.Context,
+	pois poi.Repository,
+	addr address.Address,
+) (map[string]property.NearbyPOI, error) {
+	if addr.GeoJSON == nil {
+		return nil, nil
+	}
+	found, err := pois.NearestPerType(c, *addr.GeoJSON, poi.DefaultMaxDistance)
+	if err != nil {
+		return nil, err
+	}
+	return poi.Nearby(found), nil
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+)
+
+// answerableOffer returns an offer the owner may answer with the status, an answer the domain
+// does not allow from its current status is a failed precondition.
+func answerableOffer(
+	c context.Context,
+	repository offer.Repository,
+	id string,
+	to offer.Status,
+) (*offer.Offer, error) {
+	// The repository error keeps its code, NotFound for an unknown offer.
+	answered, err := repository.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := offer.ValidateResponse(answered.Status, to); err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.FailedPrecondition,
+		)
+	}
+	return answered, nil
+}
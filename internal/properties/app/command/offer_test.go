@@ -0,0 +1,221 @@
+//go:build cse
+// +build cse
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/offer"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// OfferTestSuite is the test suite of the offer commands.
+type OfferTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	submit     command.SubmitOfferHandler
+	accept     command.AcceptOfferHandler
+	reject     command.RejectOfferHandler
+	counter    command.CounterOfferHandler
+	propertyID string
+	ServiceDep service.Dependencies
+}
+
+// SetupTest initializes the command handlers and a published property for sale.
+func (s *OfferTestSuite) SetupTest() {
+	offers := s.ServiceDep.Repo.OfferRepository
+	properties := s.ServiceDep.Repo.PropertyRepository
+	s.submit = command.NewSubmitOfferHandler(offers, properties, s.log, s.validator)
+	s.accept = command.NewAcceptOfferHandler(offers, properties, s.log, s.validator)
+	s.reject = command.NewRejectOfferHandler(offers, s.log, s.validator)
+	s.counter = command.NewCounterOfferHandler(offers, s.log, s.validator)
+	s.propertyID = database.NewStringID()
+	askingPrice := money.New(35000000, "EUR")
+	if _, err := properties.New(
+		s.ctx,
+		property.NewPropertyParams{
+			PropertyID: s.propertyID,
+			OwnerID:    database.NewStringID(),
+			Address: address.Address{
+				FirstLine:  "7",
+				Street:     "Triq San Pawl",
+				City:       "Valletta",
+				Country:    "Malta",
+				PostalCode: "VLT1212",
+			},
+			Description:   "A townhouse taking offers",
+			Title:         "Offer Property",
+			Category:      "House",
+			Status:        property.Published,
+			AvailableDate: time.Now(),
+			SaleType:      uint8(property.ForSale),
+			AskingPrice:   &askingPrice,
+		},
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+}
+
+// submitOffer submits an offer to buy the test property in euros and returns its ID.
+func (s *OfferTestSuite) submitOffer(amount int64) string {
+	id := database.NewStringID()
+	s.Require().NoError(s.submit.Handle(s.ctx, command.SubmitOfferCommand{
+		OfferID:    id,
+		PropertyID: s.propertyID,
+		Kind:       offer.Purchase,
+		Name:       "Anna Vella",
+		Email:      "anna.vella@example.com",
+		Amount:     amount,
+		Currency:   "eur",
+		Conditions: []string{"Subject to survey"},
+		Message:    "We love the courtyard.",
+	}), "Expected no error when submitting an offer")
+	return id
+}
+
+// requireStatus checks the stored status of an offer.
+func (s *OfferTestSuite) requireStatus(id string, status offer.Status) *offer.Offer {
+	found, err := s.ServiceDep.Repo.OfferRepository.Get(s.ctx, id)
+	s.Require().NoError(err, "Expected no error when reading the offer")
+	s.Require().Equal(status, found.Status, "Expected the offer to be %s", status)
+	return found
+}
+
+// requireCode checks that the error is an application error with the code.
+func (s *OfferTestSuite) requireCode(err error, code codes.Code) {
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(code, appErr.Code(), "Expected a %s error", code)
+}
+
+// TestSubmitOfferNotForSaleType tests that an application to rent a property for sale and an
+// offer in another currency are rejected.
+func (s *OfferTestSuite) TestSubmitOfferNotForSaleType() {
+	err := s.submit.Handle(s.ctx, command.SubmitOfferCommand{
+		OfferID:    database.NewStringID(),
+		PropertyID: s.propertyID,
+		Kind:       offer.Rental,
+		Name:       "Anna Vella",
+		Email:      "anna.vella@example.com",
+		Amount:     150000,
+		Currency:   "EUR",
+	})
+	s.requireCode(err, codes.FailedPrecondition)
+
+	err = s.submit.Handle(s.ctx, command.SubmitOfferCommand{
+		OfferID:    database.NewStringID(),
+		PropertyID: s.propertyID,
+		Kind:       offer.Purchase,
+		Name:       "Anna Vella",
+		Email:      "anna.vella@example.com",
+		Amount:     30000000,
+		Currency:   "GBP",
+	})
+	s.requireCode(err, codes.FailedPrecondition)
+}
+
+// TestRejectAndCounterOffer tests that a pending offer is answered once.
+func (s *OfferTestSuite) TestRejectAndCounterOffer() {
+	rejected := s.submitOffer(30000000)
+	s.Require().NoError(s.reject.Handle(s.ctx, command.RejectOfferCommand{OfferID: rejected}))
+	s.requireStatus(rejected, offer.Rejected)
+
+	countered := s.submitOffer(32000000)
+	s.Require().NoError(s.counter.Handle(s.ctx, command.CounterOfferCommand{
+		OfferID: countered, Amount: 34000000, Message: "We can meet you at 340,000.",
+	}))
+	found := s.requireStatus(countered, offer.Countered)
+	s.Require().NotNil(found.Counter, "Expected the counter offer")
+	s.Equal(money.New(34000000, "EUR"), found.Counter.Amount)
+
+	err := s.reject.Handle(s.ctx, command.RejectOfferCommand{OfferID: countered})
+	s.requireCode(err, codes.FailedPrecondition)
+}
+
+// TestAcceptOffer tests that accepting an offer declines the other open offers and puts the
+// listing under offer, after which it takes no more offers.
+func (s *OfferTestSuite) TestAcceptOffer() {
+	accepted := s.submitOffer(34000000)
+	pending := s.submitOffer(33000000)
+	countered := s.submitOffer(31000000)
+	rejected := s.submitOffer(20000000)
+	s.Require().NoError(s.counter.Handle(s.ctx, command.CounterOfferCommand{OfferID: countered, Amount: 34000000}))
+	s.Require().NoError(s.reject.Handle(s.ctx, command.RejectOfferCommand{OfferID: rejected}))
+
+	s.Require().NoError(s.accept.Handle(s.ctx, command.AcceptOfferCommand{OfferID: accepted}))
+	s.requireStatus(accepted, offer.Accepted)
+	s.requireStatus(pending, offer.Declined)
+	s.requireStatus(countered, offer.Declined)
+	s.requireStatus(rejected, offer.Rejected)
+	listing, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, s.propertyID)
+	s.Require().NoError(err)
+	s.Equal(property.UnderOffer, listing.Status, "Expected the listing to be under offer")
+
+	err = s.submit.Handle(s.ctx, command.SubmitOfferCommand{
+		OfferID:    database.NewStringID(),
+		PropertyID: s.propertyID,
+		Kind:       offer.Purchase,
+		Name:       "Anna Vella",
+		Email:      "anna.vella@example.com",
+		Amount:     36000000,
+		Currency:   "EUR",
+	})
+	s.requireCode(err, codes.FailedPrecondition)
+}
+
+// TestAcceptOfferRepository tests that the repository accepts an offer, declines the other
+// open offers on the property in the same transaction and puts the listing under offer.
+func (s *OfferTestSuite) TestAcceptOfferRepository() {
+	accepted := s.submitOffer(34000000)
+	others := []string{s.submitOffer(33000000), s.submitOffer(32000000)}
+
+	found := s.requireStatus(accepted, offer.Pending)
+	s.Require().NoError(s.ServiceDep.Repo.OfferRepository.Accept(s.ctx, *found))
+	s.requireStatus(accepted, offer.Accepted)
+	for i := 0; i < len(others); i++ {
+		s.requireStatus(others[i], offer.Declined)
+	}
+	listing, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, s.propertyID)
+	s.Require().NoError(err)
+	s.Equal(property.UnderOffer, listing.Status, "Expected the listing to be under offer")
+}
+
+// TestAcceptOfferNotAvailable tests that nothing is changed when the listing cannot be put
+// under offer.
+func (s *OfferTestSuite) TestAcceptOfferNotAvailable() {
+	first := s.submitOffer(34000000)
+	second := s.submitOffer(33000000)
+	// The owner withdraws the listing before answering the offers.
+	s.Require().NoError(s.ServiceDep.Repo.PropertyRepository.UpdateStatus(
+		s.ctx, s.propertyID, property.Published, property.Withdrawn,
+	))
+
+	err := s.accept.Handle(s.ctx, command.AcceptOfferCommand{OfferID: first})
+	s.requireCode(err, codes.FailedPrecondition)
+	s.requireStatus(first, offer.Pending)
+	s.requireStatus(second, offer.Pending)
+}
+
+// TearDownTest deletes the test property, its offers are kept for the record.
+func (s *OfferTestSuite) TearDownTest() {
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.propertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
@@ -0,0 +1,145 @@
+//go:build cse
+// +build cse
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// PropertyStatusTestSuite is the test suite of the listing status commands.
+type PropertyStatusTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	publish    command.MovePropertyHandler
+	underOffer command.MovePropertyHandler
+	let        command.MovePropertyHandler
+	sold       command.MovePropertyHandler
+	archive    command.MovePropertyHandler
+	propertyID string
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the command handlers.
+func (s *PropertyStatusTestSuite) SetupSuite() {
+	repo := s.ServiceDep.Repo.PropertyRepository
+	s.publish = command.NewMovePropertyHandler(repo, property.Published, s.log, s.validator)
+	s.underOffer = command.NewMovePropertyHandler(repo, property.UnderOffer, s.log, s.validator)
+	s.let = command.NewMovePropertyHandler(repo, property.Let, s.log, s.validator)
+	s.sold = command.NewMovePropertyHandler(repo, property.Sold, s.log, s.validator)
+	s.archive = command.NewMovePropertyHandler(repo, property.Archived, s.log, s.validator)
+}
+
+// SetupTest creates a draft property for rent.
+func (s *PropertyStatusTestSuite) SetupTest() {
+	s.propertyID = database.NewStringID()
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+		s.ctx,
+		property.NewPropertyParams{
+			PropertyID: s.propertyID,
+			OwnerID:    database.NewStringID(),
+			Address: address.Address{
+				FirstLine:  "42",
+				Street:     "Triq ic-Cangar",
+				City:       "Victoria",
+				Country:    "Malta",
+				PostalCode: "VCT2162",
+			},
+			Description:   "A property moving through its listing lifecycle",
+			Title:         "Lifecycle Property",
+			Category:      "House",
+			Status:        property.Draft,
+			AvailableDate: time.Now(),
+			SaleType:      uint8(property.ForRent),
+		},
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+}
+
+// requireStatus checks the stored status of the test property.
+func (s *PropertyStatusTestSuite) requireStatus(status property.Status) {
+	prop, err := s.ServiceDep.Repo.PropertyRepository.Get(s.ctx, s.propertyID)
+	s.Require().NoError(err, "Expected no error when reading the property")
+	s.Require().Equal(status, prop.Status, "Expected the property to be %s", status)
+}
+
+// requireFailedPrecondition checks that a command failed with a failed precondition.
+func (s *PropertyStatusTestSuite) requireFailedPrecondition(err error) {
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.FailedPrecondition, appErr.Code(), "Expected a failed precondition error")
+}
+
+// TestPropertyStatusLifecycle tests that a property for rent is published, goes under offer,
+// is let and is archived.
+func (s *PropertyStatusTestSuite) TestPropertyStatusLifecycle() {
+	s.requireStatus(property.Draft)
+	s.Require().NoError(s.publish.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID}))
+	s.requireStatus(property.Published)
+	s.Require().NoError(s.underOffer.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID}))
+	s.requireStatus(property.UnderOffer)
+	s.Require().NoError(s.let.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID}))
+	s.requireStatus(property.Let)
+	s.Require().NoError(s.archive.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID}))
+	s.requireStatus(property.Archived)
+}
+
+// TestPropertyStatusRepository tests that the repository moves a draft to published and
+// does not move it again from the status it has left.
+func (s *PropertyStatusTestSuite) TestPropertyStatusRepository() {
+	repo := s.ServiceDep.Repo.PropertyRepository
+	s.Require().NoError(repo.UpdateStatus(s.ctx, s.propertyID, property.Draft, property.Published))
+	s.requireStatus(property.Published)
+
+	err := repo.UpdateStatus(s.ctx, s.propertyID, property.Draft, property.Published)
+	s.requireFailedPrecondition(err)
+	s.requireStatus(property.Published)
+}
+
+// TestPropertyStatusIllegalTransition tests that a draft cannot go under offer.
+func (s *PropertyStatusTestSuite) TestPropertyStatusIllegalTransition() {
+	err := s.underOffer.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID})
+	s.requireFailedPrecondition(err)
+	s.requireStatus(property.Draft)
+}
+
+// TestPropertyStatusSoldNotForSale tests that a property for rent cannot be sold.
+func (s *PropertyStatusTestSuite) TestPropertyStatusSoldNotForSale() {
+	s.Require().NoError(s.publish.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID}))
+	err := s.sold.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID})
+	s.requireFailedPrecondition(err)
+	s.requireStatus(property.Published)
+}
+
+// TestPropertyStatusArchivedIsFinal tests that an archived property cannot be published again.
+func (s *PropertyStatusTestSuite) TestPropertyStatusArchivedIsFinal() {
+	s.Require().NoError(s.archive.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID}))
+	err := s.publish.Handle(s.ctx, command.MovePropertyCommand{PropertyID: s.propertyID})
+	s.requireFailedPrecondition(err)
+	s.requireStatus(property.Archived)
+}
+
+func (s *PropertyStatusTestSuite) TearDownTest() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.propertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
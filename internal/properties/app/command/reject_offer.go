@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RejectOfferCommand : This is the reject offer request in a struct format.
+type RejectOfferCommand struct {
+	OfferID string `validate:"required"`
+}
+
+// RejectOfferHandler is a CQRS endpoint that handles a command to reject an offer.
+// It implements the CommandHandler interface for the RejectOfferCommand.
+// The handler marks a pending offer as rejected.
+type RejectOfferHandler decorator.CommandHandler[RejectOfferCommand]
+
+type RejectOfferHandlerImpl struct {
+	repository offer.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewRejectOfferHandler creates a new instance of RejectOfferHandler,
+// applying necessary decorators for logging and validation.
+func NewRejectOfferHandler(
+	repository offer.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) RejectOfferHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		RejectOfferHandlerImpl{
+			repository: repository,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the reject offer command, an offer that is not pending is a failed precondition.
+func (cph RejectOfferHandlerImpl) Handle(
+	c context.Context, cmd RejectOfferCommand,
+) error {
+	rejected, err := answerableOffer(c, cph.repository, cmd.OfferID, offer.Rejected)
+	if err != nil {
+		return err
+	}
+	// The repository error keeps its code, FailedPrecondition when the offer was answered
+	// since it was read.
+	return cph.repository.Respond(c, rejected.ID, rejected.Status, offer.Rejected, nil)
+}
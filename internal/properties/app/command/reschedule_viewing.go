@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RescheduleViewingCommand : This is the reschedule viewing request in a struct format, the
+// booking moves from the ViewingID slot to the NewViewingID slot of the same property.
+type RescheduleViewingCommand struct {
+	ViewingID    string `validate:"required"`
+	BookingID    string `validate:"required"`
+	NewViewingID string `validate:"required,nefield=ViewingID"`
+}
+
+// RescheduleViewingHandler is a CQRS endpoint that handles a command to reschedule a viewing.
+// It implements the CommandHandler interface for the RescheduleViewingCommand.
+// The handler books the new slot for the booking and frees the slot it held.
+type RescheduleViewingHandler decorator.CommandHandler[RescheduleViewingCommand]
+
+type RescheduleViewingHandlerImpl struct {
+	repository viewing.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewRescheduleViewingHandler creates a new instance of RescheduleViewingHandler,
+// applying necessary decorators for logging and validation.
+func NewRescheduleViewingHandler(
+	repository viewing.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) RescheduleViewingHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		RescheduleViewingHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the reschedule viewing command, a slot not booked by the booking is not found, a new
+// slot of another property is an invalid argument and a new slot that cannot be booked is a
+// failed precondition. The booking keeps its slot when the new one cannot be booked.
+func (cph RescheduleViewingHandlerImpl) Handle(
+	c context.Context, cmd RescheduleViewingCommand,
+) error {
+	slot, err := cph.repository.Get(c, cmd.ViewingID)
+	if err != nil {
+		return err
+	}
+	if !slot.IsBooked() || slot.Booking.ID != cmd.BookingID {
+		return errors.NewHandlerError(
+			viewing.ErrBookingNotFound,
+			codes.NotFound,
+		)
+	}
+	newSlot, err := bookableViewing(c, cph.properties, cph.repository, cmd.NewViewingID)
+	if err != nil {
+		return err
+	}
+	if newSlot.PropertyID != slot.PropertyID {
+		return errors.NewInvalidArgumentError(viewing.ErrOtherProperty)
+	}
+
+	// The repository error keeps its code, FailedPrecondition when the new slot was booked
+	// in the meantime.
+	booking := *slot.Booking
+	booking.BookedAt = time.Now()
+	return cph.repository.Reschedule(c, cmd.ViewingID, cmd.NewViewingID, booking)
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+)
+
+// moveProperty moves a property to the listing status, a transition the domain does not
+// allow from its current status is a failed precondition. So is a status changed by another
+// request since it was read.
+func moveProperty(
+	c context.Context,
+	repository property.Repository,
+	id string,
+	to property.Status,
+) error {
+	prop, err := repository.Get(c, id)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if err := property.ValidateTransition(prop.Status, to, prop.SaleType); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.FailedPrecondition,
+		)
+	}
+	// The repository error keeps its code, FailedPrecondition when the status changed.
+	return repository.UpdateStatus(c, id, prop.Status, to)
+}
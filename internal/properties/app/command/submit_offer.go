@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// SubmitOfferCommand : This is the submit offer request in a struct format, the Amount is in
+// the minor units of the Currency.
+type SubmitOfferCommand struct {
+	OfferID    string     `validate:"required"`
+	PropertyID string     `validate:"required"`
+	Kind       offer.Kind `validate:"oneof=1 2"`
+	Name       string     `validate:"required,lt=100"`
+	Email      string     `validate:"required,email"`
+	Telephone  string     `validate:"omitempty,gte=7,lte=15"`
+	Amount     int64      `validate:"gt=0"`
+	Currency   string     `validate:"required,iso4217"`
+	Conditions []string   `validate:"omitempty,max=20,dive,max=500"`
+	Message    string     `validate:"omitempty,max=2000"`
+}
+
+// SubmitOfferHandler is a CQRS endpoint that handles a command to submit an offer or application.
+// It implements the CommandHandler interface for the SubmitOfferCommand.
+// The handler stores a pending offer on a published property for the owner to answer.
+type SubmitOfferHandler decorator.CommandHandler[SubmitOfferCommand]
+
+type SubmitOfferHandlerImpl struct {
+	repository offer.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewSubmitOfferHandler creates a new instance of SubmitOfferHandler,
+// applying necessary decorators for logging and validation.
+func NewSubmitOfferHandler(
+	repository offer.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) SubmitOfferHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		SubmitOfferHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the submit offer command, an offer on a listing that is not published, an offer to
+// buy a property not for sale, an application to rent a property not for rent and an amount
+// not in the currency of the listing price are a failed precondition.
+func (cph SubmitOfferHandlerImpl) Handle(
+	c context.Context, cmd SubmitOfferCommand,
+) error {
+	listing, err := cph.properties.Get(c, cmd.PropertyID)
+	if err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	amount := money.New(cmd.Amount, cmd.Currency)
+	if err := offer.ValidateListing(cmd.Kind, amount, *listing); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.FailedPrecondition,
+		)
+	}
+	if _, err := cph.repository.New(c, offer.NewOfferParams{
+		OfferID:    cmd.OfferID,
+		PropertyID: cmd.PropertyID,
+		Kind:       cmd.Kind,
+		Applicant: offer.Applicant{
+			Name:      cmd.Name,
+			Email:     cmd.Email,
+			Telephone: cmd.Telephone,
+		},
+		Amount:     amount,
+		Conditions: cmd.Conditions,
+		Message:    cmd.Message,
+	}); err != nil {
+		return errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return nil
+}
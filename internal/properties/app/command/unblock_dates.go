@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// UnblockDatesCommand : This is the unblock dates request in a struct format, the dates from
+// Start up to but excluding End are freed.
+type UnblockDatesCommand struct {
+	PropertyID string    `validate:"required"`
+	Start      time.Time `validate:"required"`
+	End        time.Time `validate:"required"`
+}
+
+// UnblockDatesHandler is a CQRS endpoint that handles a command to free the dates of a property.
+// It implements the CommandHandler interface for the UnblockDatesCommand.
+// The handler removes the dates from the availability calendar of the property, whether
+// they were blocked or booked.
+type UnblockDatesHandler decorator.CommandHandler[UnblockDatesCommand]
+
+type UnblockDatesHandlerImpl struct {
+	repository calendar.Repository
+	properties property.Repository
+	validator  *validator.Validate
+	log        log.Logger
+}
+
+// NewUnblockDatesHandler creates a new instance of UnblockDatesHandler,
+// applying necessary decorators for logging and validation.
+func NewUnblockDatesHandler(
+	repository calendar.Repository,
+	properties property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) UnblockDatesHandler {
+	if repository == nil {
+		logger.Panic("nil repository")
+	}
+	if properties == nil {
+		logger.Panic("nil property repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		UnblockDatesHandlerImpl{
+			repository: repository,
+			properties: properties,
+			validator:  validator,
+			log:        logger,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handle the unblock dates command, the ranges partly within the dates are shortened or split
+// and nothing is stored when none of the dates was blocked or booked.
+func (cph UnblockDatesHandlerImpl) Handle(
+	c context.Context, cmd UnblockDatesCommand,
+) error {
+	propertyCalendar, period, err := propertyCalendar(
+		c, cph.properties, cph.repository, cmd.PropertyID, cmd.Start, cmd.End,
+	)
+	if err != nil {
+		return err
+	}
+	if !propertyCalendar.Unblock(period) {
+		return nil
+	}
+	// The repository error keeps its code, Aborted when the calendar changed.
+	return cph.repository.Save(c, propertyCalendar)
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"time"
 
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
 	"property-service/pkg/address"
 	"property-service/pkg/decorator"
 	"property-service/pkg/errors"
 	"property-service/pkg/errors/codes"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -21,9 +24,14 @@ type UpdatePropertyCommand struct {
 	Description   string
 	Title         string
 	Category      string
-	Address       address.Address
-	SaleType      uint8
-	Server        string `validate:"required"`
+	Address       address.Address `validate:"address"`
+	// SaleType is updated with its prices, see CreatePropertyCommand.
+	SaleType    uint8          `validate:"required_with=Rent AskingPrice"`
+	Rent        *property.Rent `validate:"omitempty"`
+	AskingPrice *money.Money   `validate:"omitempty"`
+	// Attributes replace the stored attributes when they are set.
+	Attributes *property.Attributes `validate:"omitempty"`
+	Server     string               `validate:"required"`
 }
 
 // UpdatePropertyHandler is a CQRS endpoint that handles a command to update a property.
@@ -33,6 +41,9 @@ type UpdatePropertyHandler decorator.CommandHandler[UpdatePropertyCommand]
 
 type UpdatePropertyHandlerImpl struct {
 	repository property.Repository
+	geocoder   address.Geocoder
+	areas      area.Repository
+	pois       poi.Repository
 	validator  *validator.Validate
 	log        log.Logger
 }
@@ -41,15 +52,30 @@ type UpdatePropertyHandlerImpl struct {
 // applying necessary decorators for logging and validation.
 func NewUpdatePropertyHandler(
 	repository property.Repository,
+	geocoder address.Geocoder,
+	areas area.Repository,
+	pois poi.Repository,
 	logger log.Logger,
 	validator *validator.Validate,
 ) UpdatePropertyHandler {
 	if repository == nil {
 		logger.Panic("nil repository")
 	}
+	if geocoder == nil {
+		logger.Panic("nil geocoder")
+	}
+	if areas == nil {
+		logger.Panic("nil area repository")
+	}
+	if pois == nil {
+		logger.Panic("nil point of interest repository")
+	}
 	return decorator.ApplyCommandDecorators(
 		UpdatePropertyHandlerImpl{
 			repository: repository,
+			geocoder:   geocoder,
+			areas:      areas,
+			pois:       pois,
 			validator:  validator,
 			log:        logger,
 		},
@@ -58,10 +84,32 @@ func NewUpdatePropertyHandler(
 	)
 }
 
-// Handle the update property command.
+// Handle the update property command, a changed address without coordinates is geocoded and
+// the area tags and nearest points of interest of the property follow the address.
 func (cph UpdatePropertyHandlerImpl) Handle(
 	c context.Context, cmd UpdatePropertyCommand,
 ) error {
+	if cmd.SaleType != 0 {
+		if err := property.ValidatePricing(cmd.SaleType, cmd.Rent, cmd.AskingPrice); err != nil {
+			return errors.NewInvalidArgumentError(err)
+		}
+	}
+	propertyAddress := cmd.Address
+	var areas []string
+	var nearby map[string]property.NearbyPOI
+	if !propertyAddress.IsEmpty() {
+		var err error
+		propertyAddress, err = geocodeAddress(c, cph.geocoder, propertyAddress)
+		if err != nil {
+			return err
+		}
+		if areas, err = containingAreas(c, cph.areas, propertyAddress); err != nil {
+			return err
+		}
+		if nearby, err = nearbyPOIs(c, cph.pois, propertyAddress); err != nil {
+			return err
+		}
+	}
 	if registerErr := cph.repository.Update(
 		c,
 		cmd.PropertyID,
@@ -70,8 +118,13 @@ func (cph UpdatePropertyHandlerImpl) Handle(
 			Description:   cmd.Description,
 			Title:         cmd.Title,
 			Category:      cmd.Category,
-			Address:       cmd.Address,
+			Address:       propertyAddress,
 			SaleType:      cmd.SaleType,
+			Areas:         areas,
+			Nearby:        nearby,
+			Rent:          cmd.Rent,
+			AskingPrice:   cmd.AskingPrice,
+			Attributes:    cmd.Attributes,
 		},
 	); registerErr != nil {
 		return errors.NewHandlerError(
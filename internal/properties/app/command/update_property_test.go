@@ -12,6 +12,7 @@ import (
 	"property-service/pkg/configs"
 	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -36,6 +37,9 @@ func (s *UpdatePropertyTestSuite) SetupSuite() {
 	// Initialize the command handler
 	s.handler = command.NewUpdatePropertyHandler(
 		s.ServiceDep.Repo.PropertyRepository,
+		testGeocoder,
+		s.ServiceDep.Repo.AreaRepository,
+		s.ServiceDep.Repo.POIRepository,
 		s.log,
 		s.validator,
 	)
@@ -54,6 +58,7 @@ func (s *UpdatePropertyTestSuite) SetupSuite() {
 		Category:      "House",
 		AvailableDate: time.Now(),
 		SaleType:      2,
+		AskingPrice:   &money.Money{Amount: 35000000, Currency: "EUR"},
 		Server:        "Test",
 	}
 }
@@ -76,7 +81,7 @@ func (s *UpdatePropertyTestSuite) SetupTest() {
 			Description:   "A beautiful property",
 			Title:         "Beautiful Property",
 			Category:      "House",
-			Available:     true,
+			Status:        property.Published,
 			AvailableDate: s.params.AvailableDate,
 			SaleType:      s.params.SaleType,
 		},
@@ -98,6 +103,18 @@ func (s *UpdatePropertyTestSuite) TestUpdatePropertyHandler() {
 	s.NotNil(property, "Expected property to be found")
 	s.Equal(s.params.Title, property.Title, "Expected property title to match")
 	s.Equal(s.params.Description, property.Description, "Expected property description to match")
+	s.Require().NotNil(property.AskingPrice, "Expected the asking price to be updated")
+	s.Equal(*s.params.AskingPrice, *property.AskingPrice)
+}
+
+// TestUpdatePropertyPricingMismatch tests that a price is updated with its sale type.
+func (s *UpdatePropertyTestSuite) TestUpdatePropertyPricingMismatch() {
+	params := s.params
+	params.SaleType = 0
+	s.Error(s.handler.Handle(s.ctx, params), "Expected an error for a price without a sale type")
+
+	params.SaleType = uint8(property.ForRent)
+	s.Error(s.handler.Handle(s.ctx, params), "Expected an error for a property for rent without a rent")
 }
 
 // func (s *UpdatePropertyTestSuite) TearDownSuite() {
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+)
+
+// bookableViewing returns a viewing slot that can be booked, a slot that has started or whose
+// listing is not published is a failed precondition. The slot may still be booked by the time
+// it is booked, the repository write rejects it then.
+func bookableViewing(
+	c context.Context,
+	properties property.Repository,
+	viewings viewing.Repository,
+	id string,
+) (*viewing.Viewing, error) {
+	// The repository error keeps its code, NotFound for an unknown slot.
+	slot, err := viewings.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if slot.Start.Before(time.Now()) {
+		return nil, errors.NewHandlerError(
+			viewing.ErrSlotInPast,
+			codes.FailedPrecondition,
+		)
+	}
+	if slot.IsBooked() {
+		return nil, errors.NewHandlerError(
+			viewing.ErrSlotBooked,
+			codes.FailedPrecondition,
+		)
+	}
+	prop, err := properties.Get(c, slot.PropertyID)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if err := viewing.ValidateListing(prop.Status); err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.FailedPrecondition,
+		)
+	}
+	return slot, nil
+}
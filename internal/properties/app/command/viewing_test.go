@@ -0,0 +1,294 @@
+//go:build cse
+// +build cse
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ViewingTestSuite is the test suite of the viewing commands.
+type ViewingTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	create     command.CreateViewingSlotHandler
+	remove     command.DeleteViewingSlotHandler
+	book       command.BookViewingHandler
+	cancel     command.CancelViewingHandler
+	reschedule command.RescheduleViewingHandler
+	propertyID string
+	draftID    string
+	start      time.Time
+	slots      []string
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the command handlers, a published property and a draft.
+func (s *ViewingTestSuite) SetupSuite() {
+	viewings := s.ServiceDep.Repo.ViewingRepository
+	properties := s.ServiceDep.Repo.PropertyRepository
+	s.create = command.NewCreateViewingSlotHandler(viewings, properties, s.log, s.validator)
+	s.remove = command.NewDeleteViewingSlotHandler(viewings, s.log, s.validator)
+	s.book = command.NewBookViewingHandler(viewings, properties, s.log, s.validator)
+	s.cancel = command.NewCancelViewingHandler(viewings, s.log, s.validator)
+	s.reschedule = command.NewRescheduleViewingHandler(viewings, properties, s.log, s.validator)
+	s.start = time.Now().AddDate(0, 0, 7).Truncate(time.Hour)
+	s.propertyID = database.NewStringID()
+	s.draftID = database.NewStringID()
+	for id, status := range map[string]property.Status{
+		s.propertyID: property.Published,
+		s.draftID:    property.Draft,
+	} {
+		if _, err := properties.New(
+			s.ctx,
+			property.NewPropertyParams{
+				PropertyID: id,
+				OwnerID:    database.NewStringID(),
+				Address: address.Address{
+					FirstLine:  "12",
+					Street:     "Triq il-Kbira",
+					City:       "Mosta",
+					Country:    "Malta",
+					PostalCode: "MST1000",
+				},
+				Description:   "A flat with viewing slots",
+				Title:         "Viewing Property",
+				Category:      "Apartment",
+				Status:        status,
+				AvailableDate: time.Now(),
+				SaleType:      uint8(property.ForRent),
+			},
+		); err != nil {
+			s.Fail("Failed to create property for testing", err)
+		}
+	}
+}
+
+// slot creates a viewing slot of the property starting hours after the start, lasting an hour.
+func (s *ViewingTestSuite) slot(propertyID string, hours int) string {
+	id := database.NewStringID()
+	start := s.start.Add(time.Duration(hours) * time.Hour)
+	s.Require().NoError(s.create.Handle(s.ctx, command.CreateViewingSlotCommand{
+		ViewingID:  id,
+		PropertyID: propertyID,
+		Start:      start,
+		End:        start.Add(time.Hour),
+	}), "Expected no error when creating a viewing slot")
+	s.slots = append(s.slots, id)
+	return id
+}
+
+// bookViewing books the slot and returns the booking ID.
+func (s *ViewingTestSuite) bookViewing(id string) (string, error) {
+	bookingID := database.NewStringID()
+	return bookingID, s.book.Handle(s.ctx, command.BookViewingCommand{
+		ViewingID: id,
+		BookingID: bookingID,
+		Name:      "Maria Borg",
+		Email:     "maria.borg@example.com",
+		Telephone: "35621000000",
+	})
+}
+
+// requireCode checks that the error is an application error with the code.
+func (s *ViewingTestSuite) requireCode(err error, code codes.Code) {
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(code, appErr.Code(), "Expected a %s error", code)
+}
+
+// TestCreateViewingSlot tests that an overlapping or past slot is rejected.
+func (s *ViewingTestSuite) TestCreateViewingSlot() {
+	s.slot(s.propertyID, 0)
+	err := s.create.Handle(s.ctx, command.CreateViewingSlotCommand{
+		ViewingID:  database.NewStringID(),
+		PropertyID: s.propertyID,
+		Start:      s.start.Add(30 * time.Minute),
+		End:        s.start.Add(90 * time.Minute),
+	})
+	s.requireCode(err, codes.FailedPrecondition)
+
+	err = s.create.Handle(s.ctx, command.CreateViewingSlotCommand{
+		ViewingID:  database.NewStringID(),
+		PropertyID: s.propertyID,
+		Start:      time.Now().Add(-time.Hour),
+		End:        time.Now(),
+	})
+	s.requireCode(err, codes.InvalidArgument)
+}
+
+// TestCreateViewingSlotConcurrently tests that only one of several overlapping slots created
+// at the same time is stored.
+func (s *ViewingTestSuite) TestCreateViewingSlotConcurrently() {
+	start := s.start.Add(20 * time.Hour)
+	results := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		offset := time.Duration(i) * 10 * time.Minute
+		go func() {
+			results <- s.create.Handle(s.ctx, command.CreateViewingSlotCommand{
+				ViewingID:  database.NewStringID(),
+				PropertyID: s.propertyID,
+				Start:      start.Add(offset),
+				End:        start.Add(offset + time.Hour),
+			})
+		}()
+	}
+	created := 0
+	for i := 0; i < 5; i++ {
+		if err := <-results; err == nil {
+			created++
+		} else {
+			s.requireCode(err, codes.FailedPrecondition)
+		}
+	}
+	stored, err := s.ServiceDep.Repo.ViewingRepository.ListByProperty(s.ctx, s.propertyID, start, start.Add(2*time.Hour))
+	s.Require().NoError(err, "Expected no error when listing the viewing slots")
+	for _, slot := range stored {
+		s.slots = append(s.slots, slot.ID)
+	}
+	s.Equal(1, created, "Expected a single slot to be created")
+	s.Len(stored, 1, "Expected a single slot to be stored")
+}
+
+// TestBookViewing tests that a slot can only be booked once.
+func (s *ViewingTestSuite) TestBookViewing() {
+	id := s.slot(s.propertyID, 2)
+	_, err := s.bookViewing(id)
+	s.Require().NoError(err, "Expected no error when booking a free slot")
+
+	_, err = s.bookViewing(id)
+	s.requireCode(err, codes.FailedPrecondition)
+}
+
+// TestBookViewingConcurrently tests that only one of several concurrent bookings succeeds.
+func (s *ViewingTestSuite) TestBookViewingConcurrently() {
+	id := s.slot(s.propertyID, 4)
+	results := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, err := s.bookViewing(id)
+			results <- err
+		}()
+	}
+	booked := 0
+	for i := 0; i < 5; i++ {
+		if err := <-results; err == nil {
+			booked++
+		}
+	}
+	s.Equal(1, booked, "Expected a single booking to succeed")
+}
+
+// TestBookViewingNotAvailable tests that a slot of a listing that is not published cannot be booked.
+func (s *ViewingTestSuite) TestBookViewingNotAvailable() {
+	id := s.slot(s.draftID, 0)
+	_, err := s.bookViewing(id)
+	s.requireCode(err, codes.FailedPrecondition)
+}
+
+// TestCancelViewing tests that a cancelled slot can be booked again and deleted.
+func (s *ViewingTestSuite) TestCancelViewing() {
+	id := s.slot(s.propertyID, 6)
+	bookingID, err := s.bookViewing(id)
+	s.Require().NoError(err)
+	s.requireCode(s.remove.Handle(s.ctx, command.DeleteViewingSlotCommand{ViewingID: id}), codes.FailedPrecondition)
+
+	err = s.cancel.Handle(s.ctx, command.CancelViewingCommand{ViewingID: id, BookingID: database.NewStringID()})
+	s.requireCode(err, codes.NotFound)
+	s.Require().NoError(s.cancel.Handle(s.ctx, command.CancelViewingCommand{ViewingID: id, BookingID: bookingID}))
+
+	_, err = s.bookViewing(id)
+	s.Require().NoError(err, "Expected a cancelled slot to be bookable")
+}
+
+// TestRescheduleViewing tests that a booking moves to a free slot and not to a booked one.
+func (s *ViewingTestSuite) TestRescheduleViewing() {
+	first := s.slot(s.propertyID, 8)
+	second := s.slot(s.propertyID, 10)
+	taken := s.slot(s.propertyID, 12)
+	bookingID, err := s.bookViewing(first)
+	s.Require().NoError(err)
+	_, err = s.bookViewing(taken)
+	s.Require().NoError(err)
+
+	err = s.reschedule.Handle(s.ctx, command.RescheduleViewingCommand{
+		ViewingID: first, BookingID: bookingID, NewViewingID: taken,
+	})
+	s.requireCode(err, codes.FailedPrecondition)
+
+	s.Require().NoError(s.reschedule.Handle(s.ctx, command.RescheduleViewingCommand{
+		ViewingID: first, BookingID: bookingID, NewViewingID: second,
+	}))
+	moved, err := s.ServiceDep.Repo.ViewingRepository.Get(s.ctx, second)
+	s.Require().NoError(err)
+	s.Require().True(moved.IsBooked(), "Expected the new slot to be booked")
+	s.Equal(bookingID, moved.Booking.ID)
+	freed, err := s.ServiceDep.Repo.ViewingRepository.Get(s.ctx, first)
+	s.Require().NoError(err)
+	s.False(freed.IsBooked(), "Expected the old slot to be free")
+
+	other := s.slot(s.draftID, 14)
+	err = s.reschedule.Handle(s.ctx, command.RescheduleViewingCommand{
+		ViewingID: second, BookingID: bookingID, NewViewingID: other,
+	})
+	s.Error(err, "Expected an error for a slot of another property")
+}
+
+// TestRescheduleViewingRollsBack tests that the new slot stays free when the old slot is not
+// booked by the booking, the two writes are one transaction.
+func (s *ViewingTestSuite) TestRescheduleViewingRollsBack() {
+	first := s.slot(s.propertyID, 16)
+	second := s.slot(s.propertyID, 18)
+	_, err := s.bookViewing(first)
+	s.Require().NoError(err)
+
+	err = s.ServiceDep.Repo.ViewingRepository.Reschedule(s.ctx, first, second, viewing.Booking{
+		ID:       database.NewStringID(),
+		Name:     "Maria Borg",
+		Email:    "maria.borg@example.com",
+		BookedAt: time.Now(),
+	})
+	s.requireCode(err, codes.NotFound)
+	free, err := s.ServiceDep.Repo.ViewingRepository.Get(s.ctx, second)
+	s.Require().NoError(err)
+	s.False(free.IsBooked(), "Expected the new slot to stay free")
+}
+
+func (s *ViewingTestSuite) TearDownSuite() {
+	// Clean up the test data
+	for _, id := range s.slots {
+		slot, err := s.ServiceDep.Repo.ViewingRepository.Get(s.ctx, id)
+		if err == nil && slot.IsBooked() {
+			err = s.ServiceDep.Repo.ViewingRepository.Cancel(s.ctx, id, slot.Booking.ID)
+		}
+		if err == nil {
+			err = s.ServiceDep.Repo.ViewingRepository.Delete(s.ctx, id)
+		}
+		if err != nil {
+			s.log.Error("Failed to delete viewing slot after test", err)
+		}
+	}
+	for _, id := range []string{s.propertyID, s.draftID} {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, id); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"property-service/internal/properties/service"
+	"property-service/pkg/address"
 	"property-service/pkg/configs"
 	"property-service/pkg/infrastructure/log"
 
@@ -16,6 +17,21 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+// testGeocoder resolves the addresses of testdata/postcodes.csv without network access.
+var testGeocoder = func() address.Geocoder {
+	geocoder, err := address.NewOfflineGeocoderClient("testdata/postcodes.csv")
+	if err != nil {
+		panic("can not load the test geocoder dataset " + err.Error())
+	}
+	return geocoder
+}()
+
+// unknownCity is a city that is not in the test dataset.
+const unknownCity = "Nowhere"
+
+// testPoint is the centroid of the VCT2162 postcode of the test properties.
+var testPoint = address.NewPoint(36.0443, 14.2394)
+
 func TestCommandTestSuite(t *testing.T) {
 	// Load env from file.
 	envLoadingError := godotenv.Load("../../../../dev.env")
@@ -27,6 +43,9 @@ func TestCommandTestSuite(t *testing.T) {
 	config := configs.New()
 	log := log.NewZapImpl(&config.Backend)
 	v := validator.New()
+	if err := address.RegisterValidation(v); err != nil {
+		panic("can not register the address validation " + err.Error())
+	}
 	s := service.BuildDependencies(config)
 	// Initialize the test suite
 	suite.Run(t, &NewPropertyTestSuite{
@@ -71,4 +90,39 @@ func TestCommandTestSuite(t *testing.T) {
 		ctx:        context.Background(),
 		ServiceDep: s,
 	})
+	suite.Run(t, &ImportAreasTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &PropertyStatusTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &AvailabilityCalendarTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ViewingTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &OfferTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
 }
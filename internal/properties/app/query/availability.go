@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+)
+
+// excludeUnavailable returns the filter with the properties that have a blocked or booked
+// date from its FreeFrom up to its FreeTo excluded, the filter is unchanged when neither is set.
+func excludeUnavailable(
+	c context.Context,
+	calendars calendar.Repository,
+	filter property.SearchFilter,
+) (property.SearchFilter, error) {
+	unavailable, err := unavailableIDs(c, calendars, filter.FreeFrom, filter.FreeTo)
+	if err != nil {
+		return filter, err
+	}
+	if len(unavailable) > 0 {
+		filter.ExcludedIDs = append(append([]string{}, filter.ExcludedIDs...), unavailable...)
+	}
+	return filter, nil
+}
+
+// unavailableIDs returns the IDs of the properties with a blocked or booked date from
+// freeFrom up to freeTo, none when neither is set. A period with more unavailable properties
+// than a list can exclude is an invalid argument.
+func unavailableIDs(
+	c context.Context,
+	calendars calendar.Repository,
+	freeFrom time.Time,
+	freeTo time.Time,
+) ([]string, error) {
+	if freeFrom.IsZero() && freeTo.IsZero() {
+		return nil, nil
+	}
+	period, err := calendar.NewPeriod(freeFrom, freeTo)
+	if err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	unavailable, err := calendars.ListUnavailable(c, period)
+	switch {
+	case errors.Compare(err, calendar.ErrTooManyUnavailable):
+		return nil, errors.NewInvalidArgumentError(err)
+	case err != nil:
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return unavailable, nil
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/address"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ClusterPropertiesQuery : This is used to cluster the properties inside a map viewport.
+type ClusterPropertiesQuery struct {
+	BoundingBox address.BoundingBox
+	Zoom        uint8  `validate:"lte=22"`            // Map zoom level, 0 shows the whole world.
+	Threshold   uint16 `validate:"omitempty,lte=100"` // Cells with fewer properties return them, 0 uses the default.
+}
+
+// ClusterPropertiesHandler is a CQRS endpoint that handles a query to cluster the properties of a map viewport.
+// It implements the QueryHandler interface for the ClusterPropertiesQuery.
+// The handler returns a cluster per dense grid cell and the properties of the other cells.
+type ClusterPropertiesHandler decorator.QueryHandler[ClusterPropertiesQuery, *ClusterPropertiesResult]
+
+type ClusterPropertiesHandlerImpl struct {
+	repository property.Repository
+	validator  *validator.Validate
+}
+
+// NewClusterPropertiesHandler creates a new instance of ClusterPropertiesHandler,
+// applying decorators for logging and validation.
+func NewClusterPropertiesHandler(
+	propRepo property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) ClusterPropertiesHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		ClusterPropertiesHandlerImpl{
+			repository: propRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ClusterPropertiesResult
+// and an error.
+func (guh ClusterPropertiesHandlerImpl) Handle(c context.Context, cmd ClusterPropertiesQuery,
+) (*ClusterPropertiesResult, error) {
+	if err := cmd.BoundingBox.Validate(); err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	threshold := cmd.Threshold
+	if threshold == 0 {
+		threshold = property.DefaultClusterThreshold
+	}
+	clusters, err := guh.repository.ClusterWithinBox(
+		c,
+		cmd.BoundingBox,
+		cmd.Zoom,
+		threshold,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return &ClusterPropertiesResult{
+		Clusters: *clusters,
+	}, nil
+}
+
+type ClusterPropertiesResult struct {
+	Clusters property.Clusters `json:"clusters"`
+}
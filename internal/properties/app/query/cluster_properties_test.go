@@ -0,0 +1,151 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ClusterPropertiesTestSuite is the test suite for the ClusterProperties query.
+type ClusterPropertiesTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ClusterPropertiesHandler
+	params     query.ClusterPropertiesQuery
+	ids        []string
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *ClusterPropertiesTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewClusterPropertiesHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.log,
+		s.validator,
+	)
+	// Three properties close together on Bouvet Island, away from the other test data.
+	for _, coordinates := range [][2]float64{{3.351, -54.421}, {3.352, -54.422}, {3.353, -54.423}} {
+		id := database.NewStringID()
+		if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+			s.ctx,
+			property.NewPropertyParams{
+				PropertyID: id,
+				OwnerID:    database.NewStringID(),
+				Address: address.Address{
+					FirstLine: "1",
+					Street:    "Cluster Road",
+					City:      "Bouvet",
+					GeoJSON:   address.NewPoint(coordinates[1], coordinates[0]),
+				},
+				Description:   "A clustered property",
+				Title:         "Clustered Property",
+				Category:      "House",
+				Status:        property.Published,
+				AvailableDate: time.Now(),
+				SaleType:      1,
+			},
+		); err != nil {
+			s.Fail("Failed to create property for testing", err)
+		}
+		s.ids = append(s.ids, id)
+	}
+	s.params = query.ClusterPropertiesQuery{
+		BoundingBox: address.BoundingBox{
+			BottomLeft: *address.NewPoint(-54.5, 3.3),
+			TopRight:   *address.NewPoint(-54.3, 3.4),
+		},
+		Zoom: 8,
+	}
+}
+
+// TestClusterPropertiesReturnsPropertiesBelowThreshold tests that a cell with fewer properties
+// than the threshold returns its properties.
+func (s *ClusterPropertiesTestSuite) TestClusterPropertiesReturnsPropertiesBelowThreshold() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.Require().NoError(err, "Expected no error when clustering properties")
+	s.Empty(result.Clusters.Clusters, "Expected no cluster below the default threshold")
+	s.Len(result.Clusters.Properties, len(s.ids), "Expected the test properties")
+}
+
+// TestClusterPropertiesClustersDenseCells tests that a cell with at least threshold properties
+// is returned as a cluster.
+func (s *ClusterPropertiesTestSuite) TestClusterPropertiesClustersDenseCells() {
+	params := s.params
+	params.Threshold = 2
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when clustering properties")
+	s.Require().Len(result.Clusters.Clusters, 1, "Expected the test properties in one cluster")
+	cluster := result.Clusters.Clusters[0]
+	s.Equal(int64(len(s.ids)), cluster.Count, "Expected every test property to be counted")
+	s.Contains(s.ids, cluster.SampleID, "Expected a test property as the sample")
+	s.InDelta(3.352, cluster.Centroid.Coordinates[0], 1e-6, "Expected the mean longitude")
+	s.InDelta(-54.422, cluster.Centroid.Coordinates[1], 1e-6, "Expected the mean latitude")
+	s.Empty(result.Clusters.Properties, "Expected no individual properties")
+}
+
+// TestClusterPropertiesWholeWorld tests that the viewport of the whole world at zoom 0 is
+// matched on the geo index and counts the test properties.
+func (s *ClusterPropertiesTestSuite) TestClusterPropertiesWholeWorld() {
+	result, err := s.handler.Handle(s.ctx, query.ClusterPropertiesQuery{
+		BoundingBox: address.BoundingBox{
+			BottomLeft: *address.NewPoint(-85, -180),
+			TopRight:   *address.NewPoint(85, 180),
+		},
+		Threshold: 1,
+	})
+	s.Require().NoError(err, "Expected no error when clustering the whole world")
+	s.LessOrEqual(len(result.Clusters.Clusters), property.MaxClusterCells, "Expected the cells to be capped")
+	var count int64
+	for _, cluster := range result.Clusters.Clusters {
+		count += cluster.Count
+	}
+	s.GreaterOrEqual(count, int64(len(s.ids)), "Expected the test properties to be counted")
+}
+
+// TestClusterPropertiesOutsideBox tests that the properties just outside the viewport are
+// left out.
+func (s *ClusterPropertiesTestSuite) TestClusterPropertiesOutsideBox() {
+	params := s.params
+	params.BoundingBox.TopRight = *address.NewPoint(-54.4235, 3.4)
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when clustering properties")
+	s.Empty(result.Clusters.Clusters, "Expected no cluster south of the test properties")
+	for _, prop := range result.Clusters.Properties {
+		s.NotContains(s.ids, prop.ID, "Expected the test properties to be outside the box")
+	}
+}
+
+// TestClusterPropertiesInvalidBox tests that a box with its bottom left north of its top
+// right is rejected.
+func (s *ClusterPropertiesTestSuite) TestClusterPropertiesInvalidBox() {
+	params := s.params
+	params.BoundingBox.BottomLeft, params.BoundingBox.TopRight = params.BoundingBox.TopRight, params.BoundingBox.BottomLeft
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an invalid bounding box")
+}
+
+func (s *ClusterPropertiesTestSuite) TearDownSuite() {
+	// Clean up the test data
+	for _, id := range s.ids {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, id); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}
+}
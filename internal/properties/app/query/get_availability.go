@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// GetAvailabilityQuery : This is used to retrieve the blocked and booked dates of a property
+// from Start up to but excluding End.
+type GetAvailabilityQuery struct {
+	PropertyID string    `validate:"required"`
+	Start      time.Time `validate:"required"`
+	End        time.Time `validate:"required"`
+}
+
+// GetAvailabilityHandler is a CQRS endpoint that handles a query to retrieve the availability of a property.
+// It implements the QueryHandler interface for the GetAvailabilityQuery.
+// The handler reads the availability calendar of the property and returns the ranges within the period.
+type GetAvailabilityHandler decorator.QueryHandler[GetAvailabilityQuery, *GetAvailabilityResult]
+
+type GetAvailabilityHandlerImpl struct {
+	repository calendar.Repository
+	validator  *validator.Validate
+}
+
+// NewGetAvailabilityHandler creates a new instance of GetAvailabilityHandler,
+// applying decorators for logging and validation.
+func NewGetAvailabilityHandler(
+	calendarRepo calendar.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) GetAvailabilityHandler {
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		GetAvailabilityHandlerImpl{
+			repository: calendarRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a GetAvailabilityResult
+// and an error. A property without a calendar is available on every date.
+func (guh GetAvailabilityHandlerImpl) Handle(c context.Context, cmd GetAvailabilityQuery,
+) (*GetAvailabilityResult, error) {
+	period, err := calendar.NewPeriod(cmd.Start, cmd.End)
+	if err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	propertyCalendar, err := guh.repository.Get(c, cmd.PropertyID)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	ranges := propertyCalendar.Between(period)
+	return &GetAvailabilityResult{
+		PropertyID: cmd.PropertyID,
+		Period:     period,
+		Ranges:     ranges,
+		Available:  len(ranges) == 0,
+	}, nil
+}
+
+// GetAvailabilityResult holds the blocked and booked ranges of a property with a date in the
+// period, a range may start before or end after it.
+type GetAvailabilityResult struct {
+	PropertyID string               `json:"propertyId"`
+	Period     calendar.Period      `json:"period"`
+	Ranges     []calendar.DateRange `json:"ranges"`
+	Available  bool                 `json:"available"` // No date of the period is blocked or booked.
+}
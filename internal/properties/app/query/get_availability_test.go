@@ -0,0 +1,89 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/service"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// GetAvailabilityTestSuite is the test suite for the GetAvailability query.
+type GetAvailabilityTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.GetAvailabilityHandler
+	propertyID string
+	booked     calendar.Period
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite with a calendar booked for a week.
+func (s *GetAvailabilityTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewGetAvailabilityHandler(
+		s.ServiceDep.Repo.CalendarRepository,
+		s.log,
+		s.validator,
+	)
+	s.propertyID = database.NewStringID()
+	start := time.Date(2030, time.August, 1, 0, 0, 0, 0, time.UTC)
+	booked, err := calendar.NewPeriod(start, start.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	s.booked = booked
+	propertyCalendar, err := s.ServiceDep.Repo.CalendarRepository.Get(s.ctx, s.propertyID)
+	s.Require().NoError(err, "Expected no error when reading the calendar")
+	s.Require().NoError(propertyCalendar.Block(calendar.DateRange{Period: booked, Kind: calendar.Booked}))
+	if err := s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar); err != nil {
+		s.Fail("Failed to create calendar for testing", err)
+	}
+}
+
+// TestGetAvailabilityHandler tests that the booked range is returned for an overlapping period.
+func (s *GetAvailabilityTestSuite) TestGetAvailabilityHandler() {
+	result, err := s.handler.Handle(s.ctx, query.GetAvailabilityQuery{
+		PropertyID: s.propertyID,
+		Start:      s.booked.Start.AddDate(0, 0, 5),
+		End:        s.booked.End.AddDate(0, 0, 5),
+	})
+	s.Require().NoError(err, "Expected no error when getting the availability")
+	s.False(result.Available, "Expected the property to be unavailable")
+	s.Require().Len(result.Ranges, 1, "Expected the booked range")
+	s.Equal(calendar.Booked, result.Ranges[0].Kind)
+}
+
+// TestGetAvailabilityFree tests that a property is available from the end of a booking.
+func (s *GetAvailabilityTestSuite) TestGetAvailabilityFree() {
+	result, err := s.handler.Handle(s.ctx, query.GetAvailabilityQuery{
+		PropertyID: s.propertyID,
+		Start:      s.booked.End,
+		End:        s.booked.End.AddDate(0, 0, 7),
+	})
+	s.Require().NoError(err, "Expected no error when getting the availability")
+	s.True(result.Available, "Expected the property to be available")
+	s.Empty(result.Ranges, "Expected no range")
+}
+
+func (s *GetAvailabilityTestSuite) TearDownSuite() {
+	// Clean up the test data
+	propertyCalendar, err := s.ServiceDep.Repo.CalendarRepository.Get(s.ctx, s.propertyID)
+	if err == nil && propertyCalendar.Unblock(s.booked) {
+		err = s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar)
+	}
+	if err != nil {
+		s.log.Error("Failed to free the dates after test", err)
+	}
+}
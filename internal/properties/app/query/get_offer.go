@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/pkg/decorator"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// GetOfferQuery : This is used to retrieve an offer or application.
+type GetOfferQuery struct {
+	ID string `validate:"required"`
+}
+
+// GetOfferHandler is a CQRS endpoint that handles a query to retrieve an offer.
+// It implements the QueryHandler interface for the GetOfferQuery.
+// The handler returns the offer with its status and the owner's counter offer.
+type GetOfferHandler decorator.QueryHandler[GetOfferQuery, *offer.Offer]
+
+type GetOfferHandlerImpl struct {
+	repository offer.Repository
+	validator  *validator.Validate
+}
+
+// NewGetOfferHandler creates a new instance of GetOfferHandler,
+// applying decorators for logging and validation.
+func NewGetOfferHandler(
+	offerRepo offer.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) GetOfferHandler {
+	if offerRepo == nil {
+		panic("nil offer repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		GetOfferHandlerImpl{
+			repository: offerRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns an offer and an error, an unknown offer is not
+// found.
+func (guh GetOfferHandlerImpl) Handle(c context.Context, cmd GetOfferQuery,
+) (*offer.Offer, error) {
+	// The repository error keeps its code.
+	return guh.repository.Get(c, cmd.ID)
+}
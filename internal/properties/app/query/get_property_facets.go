@@ -0,0 +1,82 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// GetPropertyFacetsQuery : This is used to count the properties matching a filter per facet value.
+type GetPropertyFacetsQuery struct {
+	Filter property.SearchFilter
+}
+
+// GetPropertyFacetsHandler is a CQRS endpoint that handles a query to retrieve the facet counts of a filter.
+// It implements the QueryHandler interface for the GetPropertyFacetsQuery.
+// The handler counts the matching properties in the database and returns the buckets to the caller.
+type GetPropertyFacetsHandler decorator.QueryHandler[GetPropertyFacetsQuery, *GetPropertyFacetsResult]
+
+type GetPropertyFacetsHandlerImpl struct {
+	repository property.Repository
+	calendars  calendar.Repository
+	validator  *validator.Validate
+}
+
+// NewGetPropertyFacetsHandler creates a new instance of GetPropertyFacetsHandler,
+// applying decorators for logging and validation.
+func NewGetPropertyFacetsHandler(
+	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) GetPropertyFacetsHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		GetPropertyFacetsHandlerImpl{
+			repository: propRepo,
+			calendars:  calendarRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a GetPropertyFacetsResult
+// and an error.
+func (guh GetPropertyFacetsHandlerImpl) Handle(c context.Context, cmd GetPropertyFacetsQuery,
+) (*GetPropertyFacetsResult, error) {
+	filter, err := excludeUnavailable(c, guh.calendars, cmd.Filter)
+	if err != nil {
+		return nil, err
+	}
+	facets, err := guh.repository.Facets(c, filter)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return &GetPropertyFacetsResult{
+		Filter: cmd.Filter,
+		Facets: *facets,
+	}, nil
+}
+
+// GetPropertyFacetsResult holds the facet buckets next to the filter that produced them.
+type GetPropertyFacetsResult struct {
+	Filter property.SearchFilter `json:"filter"`
+	Facets property.Facets       `json:"facets"`
+}
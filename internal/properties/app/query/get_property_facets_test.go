@@ -0,0 +1,103 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// GetPropertyFacetsTestSuite is the test suite for the GetPropertyFacets query.
+type GetPropertyFacetsTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.GetPropertyFacetsHandler
+	params     query.GetPropertyFacetsQuery
+	newParams  property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *GetPropertyFacetsTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewGetPropertyFacetsHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		s.log,
+		s.validator,
+	)
+	s.newParams = property.NewPropertyParams{
+		PropertyID: database.NewStringID(),
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "7",
+			Street:     "Triq il-Kbira",
+			City:       "Xaghra",
+			County:     "",
+			Country:    "Malta",
+			PostalCode: "XRA1000",
+			GeoJSON: &address.GeoJSONCoordinates{
+				Type:        "Point",
+				Coordinates: [2]float64{14.2647, 36.0500},
+			},
+		},
+		Description:   "A flat with a view",
+		Title:         "Flat With A View",
+		Category:      "Flat",
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      2,
+	}
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+		s.ctx,
+		s.newParams,
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+	s.params = query.GetPropertyFacetsQuery{
+		Filter: property.SearchFilter{
+			City: "Xaghra",
+		},
+	}
+}
+
+// TestGetPropertyFacetsHandler tests that the test property is counted in its buckets.
+func (s *GetPropertyFacetsTestSuite) TestGetPropertyFacetsHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.Require().NoError(err, "Expected no error when getting the facets")
+	s.Equal(s.params.Filter, result.Filter, "Expected the filter next to the facets")
+	s.Contains(bucketValues(result.Facets.Categories), "Flat")
+	s.Contains(bucketValues(result.Facets.SaleTypes), "2")
+	s.Contains(bucketValues(result.Facets.Cities), "Xaghra")
+	s.Contains(bucketValues(result.Facets.Statuses), "2")
+}
+
+func (s *GetPropertyFacetsTestSuite) TearDownSuite() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.newParams.PropertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
+
+// bucketValues returns the values of facet buckets.
+func bucketValues(buckets []property.FacetBucket) []string {
+	values := make([]string, 0, len(buckets))
+	for _, bucket := range buckets {
+		values = append(values, bucket.Value)
+	}
+	return values
+}
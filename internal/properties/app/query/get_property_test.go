@@ -57,7 +57,7 @@ func (s *GetPropertyTestSuite) SetupSuite() {
 		Description:   "A beautiful property",
 		Title:         "Beautiful Property",
 		Category:      "House",
-		Available:     true,
+		Status:        property.Published,
 		AvailableDate: time.Now(),
 		SaleType:      1,
 	}
@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/offer"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ListOffersQuery : This is used to retrieve the offers and applications on a property, in any
+// of the Statuses or every status when none are given.
+type ListOffersQuery struct {
+	PropertyID string         `validate:"required"`
+	Statuses   []offer.Status `validate:"omitempty,dive,gte=1,lte=5"`
+}
+
+// ListOffersHandler is a CQRS endpoint that handles a query to list the offers on a property.
+// It implements the QueryHandler interface for the ListOffersQuery.
+// The handler returns the offers of the property, the newest first.
+type ListOffersHandler decorator.QueryHandler[ListOffersQuery, []offer.Offer]
+
+type ListOffersHandlerImpl struct {
+	repository offer.Repository
+	validator  *validator.Validate
+}
+
+// NewListOffersHandler creates a new instance of ListOffersHandler,
+// applying decorators for logging and validation.
+func NewListOffersHandler(
+	offerRepo offer.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) ListOffersHandler {
+	if offerRepo == nil {
+		panic("nil offer repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		ListOffersHandlerImpl{
+			repository: offerRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns the offers on the property and an error.
+func (guh ListOffersHandlerImpl) Handle(c context.Context, cmd ListOffersQuery,
+) ([]offer.Offer, error) {
+	offers, err := guh.repository.ListByProperty(c, cmd.PropertyID, cmd.Statuses)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return offers, nil
+}
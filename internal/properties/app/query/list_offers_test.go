@@ -0,0 +1,108 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/offer"
+	"property-service/internal/properties/service"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ListOffersTestSuite is the test suite for the ListOffers and GetOffer queries.
+type ListOffersTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ListOffersHandler
+	get        query.GetOfferHandler
+	propertyID string
+	offers     []string
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite with three offers on a property, the second rejected.
+func (s *ListOffersTestSuite) SetupSuite() {
+	// Initialize the query handlers
+	s.handler = query.NewListOffersHandler(
+		s.ServiceDep.Repo.OfferRepository,
+		s.log,
+		s.validator,
+	)
+	s.get = query.NewGetOfferHandler(
+		s.ServiceDep.Repo.OfferRepository,
+		s.log,
+		s.validator,
+	)
+	s.propertyID = database.NewStringID()
+	for i := 0; i < 3; i++ {
+		created, err := s.ServiceDep.Repo.OfferRepository.New(s.ctx, offer.NewOfferParams{
+			PropertyID: s.propertyID,
+			Kind:       offer.Rental,
+			Applicant: offer.Applicant{
+				Name:  "Joe Camilleri",
+				Email: "joe.camilleri@example.com",
+			},
+			Amount: money.New(int64(120000+i*10000), "EUR"),
+		})
+		if err != nil {
+			s.Fail("Failed to create offer for testing", err)
+			return
+		}
+		s.offers = append(s.offers, created.ID)
+	}
+	if err := s.ServiceDep.Repo.OfferRepository.Respond(
+		s.ctx, s.offers[1], offer.Pending, offer.Rejected, nil,
+	); err != nil {
+		s.Fail("Failed to reject offer for testing", err)
+	}
+}
+
+// TestListOffersHandler tests that every offer is listed, the newest first.
+func (s *ListOffersTestSuite) TestListOffersHandler() {
+	offers, err := s.handler.Handle(s.ctx, query.ListOffersQuery{
+		PropertyID: s.propertyID,
+	})
+	s.Require().NoError(err, "Expected no error when listing the offers")
+	s.Require().Len(offers, 3, "Expected every offer on the property")
+	for i, found := range offers {
+		s.Equal(s.offers[len(s.offers)-1-i], found.ID, "Expected the offers newest first")
+	}
+}
+
+// TestListOffersByStatus tests that only the offers in the statuses are listed.
+func (s *ListOffersTestSuite) TestListOffersByStatus() {
+	offers, err := s.handler.Handle(s.ctx, query.ListOffersQuery{
+		PropertyID: s.propertyID,
+		Statuses:   []offer.Status{offer.Rejected},
+	})
+	s.Require().NoError(err, "Expected no error when listing the offers")
+	s.Require().Len(offers, 1, "Expected the rejected offer")
+	s.Equal(s.offers[1], offers[0].ID)
+}
+
+// TestGetOfferHandler tests that an offer is read and an unknown one is not found.
+func (s *ListOffersTestSuite) TestGetOfferHandler() {
+	found, err := s.get.Handle(s.ctx, query.GetOfferQuery{ID: s.offers[0]})
+	s.Require().NoError(err, "Expected no error when reading the offer")
+	s.Equal(offer.Pending, found.Status)
+	s.Equal(money.New(120000, "EUR"), found.Amount)
+
+	_, err = s.get.Handle(s.ctx, query.GetOfferQuery{ID: database.NewStringID()})
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.NotFound, appErr.Code())
+}
@@ -2,23 +2,31 @@ package query
 
 import (
 	"context"
+	"time"
 
+	"property-service/internal/properties/domain/calendar"
 	"property-service/internal/properties/domain/property"
 	"property-service/pkg/decorator"
 	"property-service/pkg/errors"
 	"property-service/pkg/errors/codes"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/pagination"
 
 	"github.com/go-playground/validator/v10"
 )
 
 // ListPropertiesByCategoryQuery : This is used to update the property profile.
 type ListPropertiesByCategoryQuery struct {
-	Category        string `validate:"required"`
-	Sort            uint8  `validate:"required"`
-	Search          uint8  `validate:"omitempty"`
-	Limit           uint16 `validate:"required"`
-	PaginationToken string `validate:"omitempty"`
+	Category string `validate:"required"`
+	Area     string `validate:"omitempty"` // Key or name of a named area.
+	// FreeFrom and FreeTo limit the properties to the ones without a blocked or booked date
+	// from FreeFrom up to but excluding FreeTo in their availability calendar.
+	FreeFrom        time.Time     `validate:"omitempty"`
+	FreeTo          time.Time     `validate:"omitempty"`
+	Sort            property.Sort `validate:"omitempty,dive"` // Empty sorts by title.
+	Limit           uint16        `validate:"required"`
+	PaginationToken string        `validate:"omitempty"` // Page token of a previous result.
+	TotalCount      bool          // Also count every matching property.
 }
 
 // ListPropertiesByCategoryHandler is a CQRS endpoint that handles a command to retrieve a list of properties by category.
@@ -28,6 +36,8 @@ type ListPropertiesByCategoryHandler decorator.QueryHandler[ListPropertiesByCate
 
 type ListPropertyHandlerImpl struct {
 	repository property.Repository
+	calendars  calendar.Repository
+	tokens     pagination.Manager
 	validator  *validator.Validate
 }
 
@@ -35,15 +45,25 @@ type ListPropertyHandlerImpl struct {
 // applying decorators for logging and validation.
 func NewListPropertiesByCategoryHandler(
 	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	tokens pagination.Manager,
 	logger log.Logger,
 	validator *validator.Validate,
 ) ListPropertiesByCategoryHandler {
 	if propRepo == nil {
 		panic("nil property repository")
 	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	if tokens == nil {
+		panic("nil pagination manager")
+	}
 	return decorator.ApplyQueryDecorators(
 		ListPropertyHandlerImpl{
 			repository: propRepo,
+			calendars:  calendarRepo,
+			tokens:     tokens,
 			validator:  validator,
 		},
 		logger,
@@ -55,13 +75,33 @@ func NewListPropertiesByCategoryHandler(
 // and an error.
 func (guh ListPropertyHandlerImpl) Handle(c context.Context, cmd ListPropertiesByCategoryQuery,
 ) (*ListPropertiesByCategoryResult, error) {
-	property, err := guh.repository.ListByCategory(
+	if err := cmd.Sort.Validate(); err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	unavailable, err := unavailableIDs(c, guh.calendars, cmd.FreeFrom, cmd.FreeTo)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := newPager(guh.tokens, cmd.Sort, []any{cmd.Category, cmd.Area, cmd.FreeFrom, cmd.FreeTo})
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	cursor, search, err := pages.open(cmd.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := guh.repository.ListByCategory(
 		c,
 		cmd.Category,
+		cmd.Area,
+		unavailable,
 		cmd.Sort,
-		cmd.Limit,
-		cmd.PaginationToken,
-		cmd.Search,
+		fetchLimit(cmd.Limit),
+		cursor,
+		search,
 	)
 	if err != nil {
 		return nil, errors.NewHandlerError(
@@ -69,11 +109,30 @@ func (guh ListPropertyHandlerImpl) Handle(c context.Context, cmd ListPropertiesB
 			codes.Internal,
 		)
 	}
+	properties, page, err := pages.page(properties, cmd.Limit, search)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if cmd.TotalCount {
+		total, err := guh.repository.CountByCategory(c, cmd.Category, cmd.Area, unavailable)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		page.TotalCount = &total
+	}
 	return &ListPropertiesByCategoryResult{
-		Properties: property,
+		Properties: properties,
+		Page:       page,
 	}, nil
 }
 
 type ListPropertiesByCategoryResult struct {
 	Properties []property.Property `json:"properties"`
+	Page       Page                `json:"page"`
 }
@@ -6,10 +6,15 @@ package query_test
 import (
 	"context"
 	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/calendar"
 	"property-service/internal/properties/domain/property"
 	"property-service/internal/properties/service"
+	"property-service/pkg/address"
 	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/suite"
@@ -18,15 +23,19 @@ import (
 // TestListPropertiesByCategoryTestSuite is the test suite for the command package.
 type ListPropertiesByCategoryTestSuite struct {
 	suite.Suite
-	ctx        context.Context
-	log        log.Logger
-	config     configs.Config
-	validator  *validator.Validate
-	handler    query.ListPropertiesByCategoryHandler
-	propRepo   property.Repository
-	params     query.ListPropertiesByCategoryQuery
-	newParams  property.NewPropertyParams
-	ServiceDep service.Dependencies
+	ctx           context.Context
+	log           log.Logger
+	config        configs.Config
+	validator     *validator.Validate
+	handler       query.ListPropertiesByCategoryHandler
+	propRepo      property.Repository
+	params        query.ListPropertiesByCategoryQuery
+	newParams     property.NewPropertyParams
+	category      string
+	created       []string
+	priceCategory string
+	priced        []string
+	ServiceDep    service.Dependencies
 }
 
 // SetupSuite initializes the test suite.
@@ -34,15 +43,84 @@ func (s *ListPropertiesByCategoryTestSuite) SetupSuite() {
 	// Initialize the command handler
 	s.handler = query.NewListPropertiesByCategoryHandler(
 		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		s.ServiceDep.Pages,
 		s.log,
 		s.validator,
 	)
 	s.params = query.ListPropertiesByCategoryQuery{
 		Category: "House",
-		Sort:     1,
-		Search:   1,
-		Limit:    2,
+		Sort: property.Sort{
+			{Field: property.SortByAvailableDate, Descending: true},
+			{Field: property.SortByCreatedAt},
+		},
+		Limit: 2,
+	}
+	// Properties in a category of their own, created in turn, to page through.
+	s.category = "Paging " + database.NewStringID()
+	for i := 0; i < 5; i++ {
+		s.created = append(s.created, s.pagingProperty(s.category, nil))
+		// The creation times are stored to the millisecond.
+		time.Sleep(5 * time.Millisecond)
+	}
+	// Properties for sale with asking prices and for rent without, in another category.
+	s.priceCategory = "Paging " + database.NewStringID()
+	for _, amount := range []int64{30000000, 0, 45000000, 0, 25000000} {
+		var askingPrice *money.Money
+		if amount > 0 {
+			price := money.New(amount, "EUR")
+			askingPrice = &price
+		}
+		s.priced = append(s.priced, s.pagingProperty(s.priceCategory, askingPrice))
+	}
+}
+
+// pagingProperty creates a published property of the category, for sale at the asking price
+// or for rent without one, and returns its ID.
+func (s *ListPropertiesByCategoryTestSuite) pagingProperty(category string, askingPrice *money.Money) string {
+	id := database.NewStringID()
+	saleType := property.ForRent
+	if askingPrice != nil {
+		saleType = property.ForSale
+	}
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(s.ctx, property.NewPropertyParams{
+		PropertyID: id,
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "3",
+			Street:     "Triq il-Mitħna",
+			City:       "Rabat",
+			Country:    "Malta",
+			PostalCode: "RBT1010",
+		},
+		Description:   "A property to page through",
+		Title:         "Paging Property",
+		Category:      category,
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      uint8(saleType),
+		AskingPrice:   askingPrice,
+	}); err != nil {
+		s.Fail("Failed to create property for testing", err)
 	}
+	return id
+}
+
+// pageThrough lists every page of the query and returns the IDs of the listed properties.
+func (s *ListPropertiesByCategoryTestSuite) pageThrough(params query.ListPropertiesByCategoryQuery) []string {
+	listed := make([]string, 0)
+	for pages := 0; pages < 10; pages++ {
+		result, err := s.handler.Handle(s.ctx, params)
+		s.Require().NoError(err, "Expected no error when listing a page")
+		for _, prop := range result.Properties {
+			listed = append(listed, prop.ID)
+		}
+		if !result.Page.HasMore {
+			break
+		}
+		params.PaginationToken = result.Page.NextPageToken
+	}
+	return listed
 }
 
 // TestCreatePropertyHandler tests the CreatePropertyHandler.
@@ -53,6 +131,85 @@ func (s *ListPropertiesByCategoryTestSuite) TestCreatePropertyHandler() {
 	s.log.Info("Result: ", result)
 }
 
+// TestListPropertiesByCategoryUnsupportedSort tests that an unsupported sort field is rejected.
+func (s *ListPropertiesByCategoryTestSuite) TestListPropertiesByCategoryUnsupportedSort() {
+	params := s.params
+	params.Sort = property.Sort{{Field: "description"}}
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an unsupported sort field")
+}
+
+// TestListPropertiesByCategoryPagesByCreatedAt tests that the pages of a created_at sort
+// follow each other, every property listed once in creation order.
+func (s *ListPropertiesByCategoryTestSuite) TestListPropertiesByCategoryPagesByCreatedAt() {
+	listed := s.pageThrough(query.ListPropertiesByCategoryQuery{
+		Category: s.category,
+		Sort:     property.Sort{{Field: property.SortByCreatedAt}},
+		Limit:    2,
+	})
+	s.Equal(s.created, listed, "Expected every property once, in creation order")
+}
+
+// TestListPropertiesByCategoryPagesByPriceDescending tests that the properties without an
+// asking price follow the priced ones on the later pages of a descending price sort.
+func (s *ListPropertiesByCategoryTestSuite) TestListPropertiesByCategoryPagesByPriceDescending() {
+	listed := s.pageThrough(query.ListPropertiesByCategoryQuery{
+		Category: s.priceCategory,
+		Sort:     property.Sort{{Field: property.SortByAskingPrice, Descending: true}},
+		Limit:    2,
+	})
+	s.Require().Len(listed, len(s.priced), "Expected every property once")
+	s.Equal([]string{s.priced[2], s.priced[0], s.priced[4]}, listed[:3], "Expected the highest price first")
+	s.ElementsMatch([]string{s.priced[1], s.priced[3]}, listed[3:], "Expected the unpriced properties last")
+}
+
+// TestListPropertiesByCategoryFreeBetween tests that a property with booked dates is left out
+// of every page and of the total count while the dates are looked for.
+func (s *ListPropertiesByCategoryTestSuite) TestListPropertiesByCategoryFreeBetween() {
+	start := time.Now().AddDate(0, 1, 0)
+	booked := s.created[1]
+	propertyCalendar, err := s.ServiceDep.Repo.CalendarRepository.Get(s.ctx, booked)
+	s.Require().NoError(err, "Expected no error when reading the calendar")
+	period, err := calendar.NewPeriod(start, start.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	s.Require().NoError(propertyCalendar.Block(calendar.DateRange{Period: period, Kind: calendar.Booked}))
+	s.Require().NoError(s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar))
+	defer func() {
+		propertyCalendar.Unblock(period)
+		if err := s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar); err != nil {
+			s.log.Error("Failed to free the dates after test", err)
+		}
+	}()
+
+	params := query.ListPropertiesByCategoryQuery{
+		Category:   s.category,
+		Sort:       property.Sort{{Field: property.SortByCreatedAt}},
+		Limit:      2,
+		TotalCount: true,
+		FreeFrom:   start.AddDate(0, 0, 3),
+		FreeTo:     start.AddDate(0, 0, 10),
+	}
+	listed := s.pageThrough(params)
+	s.Equal(append([]string{s.created[0]}, s.created[2:]...), listed, "Expected the booked property to be left out")
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when counting the free properties")
+	s.Require().NotNil(result.Page.TotalCount, "Expected the total count")
+	s.Equal(int64(len(s.created)-1), *result.Page.TotalCount, "Expected the booked property not to be counted")
+
+	params.FreeFrom = start.AddDate(0, 0, 7)
+	params.FreeTo = start.AddDate(0, 0, 14)
+	s.Equal(s.created, s.pageThrough(params), "Expected every property to be free after the booking")
+
+	params.FreeTo = time.Time{}
+	_, err = s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for a free period without an end")
+}
+
 func (s *ListPropertiesByCategoryTestSuite) TearDownSuite() {
 	// Clean up the test data
+	for _, id := range append(s.created, s.priced...) {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, id); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}
 }
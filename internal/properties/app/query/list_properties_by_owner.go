@@ -2,24 +2,35 @@ package query
 
 import (
 	"context"
+	"time"
 
+	"property-service/internal/properties/domain/calendar"
 	"property-service/internal/properties/domain/property"
 	"property-service/pkg/decorator"
 	"property-service/pkg/errors"
 	"property-service/pkg/errors/codes"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/pagination"
 
 	"github.com/go-playground/validator/v10"
 )
 
 // ListPropertiesByOwnerQuery : This is used to update the property profile.
 type ListPropertiesByOwnerQuery struct {
-	Owner           string `validate:"required"`
-	Sort            uint8  `validate:"required"`
-	Search          uint8  `validate:"omitempty"`
-	Limit           uint16 `validate:"required"`
-	PaginationToken string `validate:"omitempty"`
-	Server          string `validate:"required"`
+	Owner string `validate:"required"`
+	Area  string `validate:"omitempty"` // Key or name of a named area.
+	// Statuses are the listing statuses to include, the owner's drafts for example, the
+	// published properties are listed when there are none.
+	Statuses []property.Status `validate:"omitempty,dive,gte=1,lte=7"`
+	// FreeFrom and FreeTo limit the properties to the ones without a blocked or booked date
+	// from FreeFrom up to but excluding FreeTo in their availability calendar.
+	FreeFrom        time.Time     `validate:"omitempty"`
+	FreeTo          time.Time     `validate:"omitempty"`
+	Sort            property.Sort `validate:"omitempty,dive"` // Empty sorts by title.
+	Limit           uint16        `validate:"required"`
+	PaginationToken string        `validate:"omitempty"` // Page token of a previous result.
+	TotalCount      bool          // Also count every matching property.
+	Server          string        `validate:"required"`
 }
 
 // ListPropertiesByOwnerHandler is a CQRS endpoint that handles a command to retrieve a list of properties by category.
@@ -29,6 +40,8 @@ type ListPropertiesByOwnerHandler decorator.QueryHandler[ListPropertiesByOwnerQu
 
 type ListPropertyByOwnerHandlerImpl struct {
 	repository property.Repository
+	calendars  calendar.Repository
+	tokens     pagination.Manager
 	validator  *validator.Validate
 }
 
@@ -36,15 +49,25 @@ type ListPropertyByOwnerHandlerImpl struct {
 // applying decorators for logging and validation.
 func NewListPropertiesByOwnerHandler(
 	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	tokens pagination.Manager,
 	logger log.Logger,
 	validator *validator.Validate,
 ) ListPropertiesByOwnerHandler {
 	if propRepo == nil {
 		panic("nil property repository")
 	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	if tokens == nil {
+		panic("nil pagination manager")
+	}
 	return decorator.ApplyQueryDecorators(
 		ListPropertyByOwnerHandlerImpl{
 			repository: propRepo,
+			calendars:  calendarRepo,
+			tokens:     tokens,
 			validator:  validator,
 		},
 		logger,
@@ -56,13 +79,34 @@ func NewListPropertiesByOwnerHandler(
 // and an error.
 func (guh ListPropertyByOwnerHandlerImpl) Handle(c context.Context, cmd ListPropertiesByOwnerQuery,
 ) (*ListPropertiesByOwnerResult, error) {
-	property, err := guh.repository.ListByOwner(
+	if err := cmd.Sort.Validate(); err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	unavailable, err := unavailableIDs(c, guh.calendars, cmd.FreeFrom, cmd.FreeTo)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := newPager(guh.tokens, cmd.Sort, []any{cmd.Owner, cmd.Area, cmd.Statuses, cmd.FreeFrom, cmd.FreeTo})
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	cursor, search, err := pages.open(cmd.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := guh.repository.ListByOwner(
 		c,
 		cmd.Owner,
+		cmd.Area,
+		cmd.Statuses,
+		unavailable,
 		cmd.Sort,
-		cmd.Limit,
-		cmd.PaginationToken,
-		cmd.Search,
+		fetchLimit(cmd.Limit),
+		cursor,
+		search,
 	)
 	if err != nil {
 		return nil, errors.NewHandlerError(
@@ -70,11 +114,30 @@ func (guh ListPropertyByOwnerHandlerImpl) Handle(c context.Context, cmd ListProp
 			codes.Internal,
 		)
 	}
+	properties, page, err := pages.page(properties, cmd.Limit, search)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if cmd.TotalCount {
+		total, err := guh.repository.CountByOwner(c, cmd.Owner, cmd.Area, cmd.Statuses, unavailable)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		page.TotalCount = &total
+	}
 	return &ListPropertiesByOwnerResult{
-		Properties: property,
+		Properties: properties,
+		Page:       page,
 	}, nil
 }
 
 type ListPropertiesByOwnerResult struct {
 	Properties []property.Property `json:"properties"`
+	Page       Page                `json:"page"`
 }
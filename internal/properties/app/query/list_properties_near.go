@@ -0,0 +1,103 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ListPropertiesNearQuery : This is used to list the properties around a point.
+type ListPropertiesNearQuery struct {
+	Latitude  float64 `validate:"latitude"`
+	Longitude float64 `validate:"longitude"`
+	Radius    float64 `validate:"required,gt=0"`
+	Category  string  `validate:"omitempty"`
+	SaleType  uint8   `validate:"omitempty,lte=3"`
+	Area      string  `validate:"omitempty"` // Key or name of a named area.
+	Limit     uint16  `validate:"required"`
+	// Attributes limits the properties to the ones with the rooms, floor area and features.
+	Attributes property.AttributeFilter
+	// FreeFrom and FreeTo limit the properties to the ones without a blocked or booked date
+	// from FreeFrom up to but excluding FreeTo in their availability calendar.
+	FreeFrom time.Time `validate:"omitempty"`
+	FreeTo   time.Time `validate:"omitempty"`
+}
+
+// ListPropertiesNearHandler is a CQRS endpoint that handles a query to retrieve the properties around a point.
+// It implements the QueryHandler interface for the ListPropertiesNearQuery.
+// The handler returns the properties nearest first, each with its distance in metres.
+type ListPropertiesNearHandler decorator.QueryHandler[ListPropertiesNearQuery, *ListPropertiesNearResult]
+
+type ListPropertiesNearHandlerImpl struct {
+	repository property.Repository
+	calendars  calendar.Repository
+	validator  *validator.Validate
+}
+
+// NewListPropertiesNearHandler creates a new instance of ListPropertiesNearHandler,
+// applying decorators for logging and validation.
+func NewListPropertiesNearHandler(
+	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) ListPropertiesNearHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		ListPropertiesNearHandlerImpl{
+			repository: propRepo,
+			calendars:  calendarRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ListPropertiesNearResult
+// and an error.
+func (guh ListPropertiesNearHandlerImpl) Handle(c context.Context, cmd ListPropertiesNearQuery,
+) (*ListPropertiesNearResult, error) {
+	unavailable, err := unavailableIDs(c, guh.calendars, cmd.FreeFrom, cmd.FreeTo)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := guh.repository.ListNear(
+		c,
+		cmd.Latitude,
+		cmd.Longitude,
+		cmd.Radius,
+		cmd.Category,
+		cmd.SaleType,
+		cmd.Area,
+		cmd.Attributes,
+		unavailable,
+		cmd.Limit,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return &ListPropertiesNearResult{
+		Properties: properties,
+	}, nil
+}
+
+type ListPropertiesNearResult struct {
+	Properties []property.Property `json:"properties"`
+}
@@ -0,0 +1,96 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ListPropertiesNearTestSuite is the test suite for the ListPropertiesNear query.
+type ListPropertiesNearTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ListPropertiesNearHandler
+	params     query.ListPropertiesNearQuery
+	newParams  property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *ListPropertiesNearTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewListPropertiesNearHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		s.log,
+		s.validator,
+	)
+	s.newParams = property.NewPropertyParams{
+		PropertyID: database.NewStringID(),
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "42",
+			Street:     "Triq ic-Cangar",
+			City:       "Victoria",
+			County:     "",
+			Country:    "Malta",
+			PostalCode: "VCT2162",
+			GeoJSON: &address.GeoJSONCoordinates{
+				Type:        "Point",
+				Coordinates: [2]float64{14.2394, 36.0443},
+			},
+		},
+		Description:   "A beautiful property",
+		Title:         "Beautiful Property",
+		Category:      "House",
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      1,
+	}
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+		s.ctx,
+		s.newParams,
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+	s.params = query.ListPropertiesNearQuery{
+		Latitude:  36.0450,
+		Longitude: 14.2400,
+		Radius:    1000,
+		Category:  "House",
+		Limit:     10,
+	}
+}
+
+// TestListPropertiesNearHandler tests the ListPropertiesNearHandler.
+func (s *ListPropertiesNearTestSuite) TestListPropertiesNearHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.NoError(err, "Expected no error when listing properties near the point")
+	s.NotEmpty(result.Properties, "Expected the test property to be found")
+	for i := 1; i < len(result.Properties); i++ {
+		s.LessOrEqual(result.Properties[i-1].Distance, result.Properties[i].Distance,
+			"Expected properties to be sorted nearest first")
+	}
+}
+
+func (s *ListPropertiesNearTestSuite) TearDownSuite() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.newParams.PropertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
@@ -0,0 +1,138 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/pagination"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ListPropertiesWithinAreaQuery : This is used to list the properties inside a bounding box or polygon.
+type ListPropertiesWithinAreaQuery struct {
+	Area property.SearchArea
+	// FreeFrom and FreeTo limit the properties to the ones without a blocked or booked date
+	// from FreeFrom up to but excluding FreeTo in their availability calendar.
+	FreeFrom        time.Time `validate:"omitempty"`
+	FreeTo          time.Time `validate:"omitempty"`
+	Sort            uint8     `validate:"required"`
+	Limit           uint16    `validate:"required"`
+	PaginationToken string    `validate:"omitempty"` // Page token of a previous result.
+	TotalCount      bool      // Also count every matching property.
+}
+
+// ListPropertiesWithinAreaHandler is a CQRS endpoint that handles a query to retrieve the properties inside an area.
+// It implements the QueryHandler interface for the ListPropertiesWithinAreaQuery.
+// The handler retrieves the property models from the database and returns them to the caller.
+type ListPropertiesWithinAreaHandler decorator.QueryHandler[
+	ListPropertiesWithinAreaQuery, *ListPropertiesWithinAreaResult,
+]
+
+type ListPropertiesWithinAreaHandlerImpl struct {
+	repository property.Repository
+	calendars  calendar.Repository
+	tokens     pagination.Manager
+	validator  *validator.Validate
+}
+
+// NewListPropertiesWithinAreaHandler creates a new instance of ListPropertiesWithinAreaHandler,
+// applying decorators for logging and validation.
+func NewListPropertiesWithinAreaHandler(
+	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	tokens pagination.Manager,
+	logger log.Logger,
+	validator *validator.Validate,
+) ListPropertiesWithinAreaHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	if tokens == nil {
+		panic("nil pagination manager")
+	}
+	return decorator.ApplyQueryDecorators(
+		ListPropertiesWithinAreaHandlerImpl{
+			repository: propRepo,
+			calendars:  calendarRepo,
+			tokens:     tokens,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ListPropertiesWithinAreaResult
+// and an error.
+func (guh ListPropertiesWithinAreaHandlerImpl) Handle(c context.Context, cmd ListPropertiesWithinAreaQuery,
+) (*ListPropertiesWithinAreaResult, error) {
+	if err := cmd.Area.Validate(); err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	unavailable, err := unavailableIDs(c, guh.calendars, cmd.FreeFrom, cmd.FreeTo)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := newPager(guh.tokens, cmd.Sort, []any{cmd.Area, cmd.FreeFrom, cmd.FreeTo})
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	cursor, search, err := pages.open(cmd.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := guh.repository.ListWithinArea(
+		c,
+		cmd.Area,
+		unavailable,
+		cmd.Sort,
+		fetchLimit(cmd.Limit),
+		cursor,
+		search,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	properties, page, err := pages.page(properties, cmd.Limit, search)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if cmd.TotalCount {
+		total, err := guh.repository.CountWithinArea(c, cmd.Area, unavailable)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		page.TotalCount = &total
+	}
+	return &ListPropertiesWithinAreaResult{
+		Properties: properties,
+		Page:       page,
+	}, nil
+}
+
+type ListPropertiesWithinAreaResult struct {
+	Properties []property.Property `json:"properties"`
+	Page       Page                `json:"page"`
+}
@@ -0,0 +1,143 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ListPropertiesWithinAreaTestSuite is the test suite for the ListPropertiesWithinArea query.
+type ListPropertiesWithinAreaTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ListPropertiesWithinAreaHandler
+	params     query.ListPropertiesWithinAreaQuery
+	newParams  property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *ListPropertiesWithinAreaTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewListPropertiesWithinAreaHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		s.ServiceDep.Pages,
+		s.log,
+		s.validator,
+	)
+	s.newParams = property.NewPropertyParams{
+		PropertyID: database.NewStringID(),
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "42",
+			Street:     "Triq ic-Cangar",
+			City:       "Victoria",
+			County:     "",
+			Country:    "Malta",
+			PostalCode: "VCT2162",
+			GeoJSON: &address.GeoJSONCoordinates{
+				Type:        "Point",
+				Coordinates: [2]float64{14.2394, 36.0443},
+			},
+		},
+		Description:   "A beautiful property",
+		Title:         "Beautiful Property",
+		Category:      "House",
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      1,
+	}
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+		s.ctx,
+		s.newParams,
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+	s.params = query.ListPropertiesWithinAreaQuery{
+		Area: property.SearchArea{
+			Polygon: &address.GeoJSONPolygon{
+				Type: "Polygon",
+				Coordinates: [][][2]float64{{
+					{14.23, 36.04}, {14.25, 36.04}, {14.25, 36.05}, {14.23, 36.05}, {14.23, 36.04},
+				}},
+			},
+		},
+		Sort:  1,
+		Limit: 10,
+	}
+}
+
+// TestListPropertiesWithinAreaHandler tests the ListPropertiesWithinAreaHandler.
+func (s *ListPropertiesWithinAreaTestSuite) TestListPropertiesWithinAreaHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.NoError(err, "Expected no error when listing properties within the area")
+	s.NotEmpty(result.Properties, "Expected the test property to be found")
+}
+
+// TestListPropertiesWithinAreaOpenRing tests that an open polygon ring is rejected.
+func (s *ListPropertiesWithinAreaTestSuite) TestListPropertiesWithinAreaOpenRing() {
+	params := s.params
+	params.Area = property.SearchArea{
+		Polygon: &address.GeoJSONPolygon{
+			Type:        "Polygon",
+			Coordinates: [][][2]float64{{{14.23, 36.04}, {14.25, 36.04}, {14.25, 36.05}, {14.23, 36.05}}},
+		},
+	}
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an open polygon ring")
+}
+
+// TestListPropertiesWithinAreaFreeBetween tests that a property with blocked dates is left
+// out when it is not free between the dates.
+func (s *ListPropertiesWithinAreaTestSuite) TestListPropertiesWithinAreaFreeBetween() {
+	start := time.Now().AddDate(0, 1, 0)
+	propertyCalendar, err := s.ServiceDep.Repo.CalendarRepository.Get(s.ctx, s.newParams.PropertyID)
+	s.Require().NoError(err, "Expected no error when reading the calendar")
+	period, err := calendar.NewPeriod(start, start.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	s.Require().NoError(propertyCalendar.Block(calendar.DateRange{Period: period, Kind: calendar.Blocked}))
+	s.Require().NoError(s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar))
+	defer func() {
+		propertyCalendar.Unblock(period)
+		if err := s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar); err != nil {
+			s.log.Error("Failed to free the dates after test", err)
+		}
+	}()
+
+	params := s.params
+	params.FreeFrom = start.AddDate(0, 0, 3)
+	params.FreeTo = start.AddDate(0, 0, 10)
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when listing free properties")
+	s.False(containsProperty(result.Properties, s.newParams.PropertyID), "Expected the blocked property to be left out")
+
+	params.FreeFrom = start.AddDate(0, 0, 7)
+	params.FreeTo = start.AddDate(0, 0, 14)
+	result, err = s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when listing free properties")
+	s.True(containsProperty(result.Properties, s.newParams.PropertyID), "Expected the property to be free after the blocked dates")
+}
+
+func (s *ListPropertiesWithinAreaTestSuite) TearDownSuite() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.newParams.PropertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
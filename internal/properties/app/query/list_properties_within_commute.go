@@ -0,0 +1,188 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/address"
+	"property-service/pkg/commute"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// commuteBand is the range of travel times whose properties are read at once, the bands are
+// read quickest first until the limit is reached.
+const commuteBand = 10 * time.Minute
+
+// commuteMinBand is the narrowest band, a band with more properties than are still needed is
+// split in halves down to this width so the quickest ones are listed.
+const commuteMinBand = time.Minute
+
+// ListPropertiesWithinCommuteQuery : This is used to list the properties within a journey time
+// of a destination, e.g. a workplace.
+type ListPropertiesWithinCommuteQuery struct {
+	Latitude   float64   `validate:"latitude"`
+	Longitude  float64   `validate:"longitude"`
+	Departure  time.Time `validate:"required"`
+	MaxMinutes uint16    `validate:"required,lte=120"`
+	Category   string    `validate:"omitempty"`
+	SaleType   uint8     `validate:"omitempty,lte=3"`
+	Limit      uint16    `validate:"required"`
+	// Attributes limits the properties to the ones with the rooms, floor area and features.
+	Attributes property.AttributeFilter
+	// FreeFrom and FreeTo limit the properties to the ones without a blocked or booked date
+	// from FreeFrom up to but excluding FreeTo in their availability calendar.
+	FreeFrom time.Time `validate:"omitempty"`
+	FreeTo   time.Time `validate:"omitempty"`
+}
+
+// ListPropertiesWithinCommuteHandler is a CQRS endpoint that handles a query to retrieve the
+// properties within a journey time of a destination.
+// It implements the QueryHandler interface for the ListPropertiesWithinCommuteQuery.
+// The handler returns the properties quickest first, each with its travel time.
+type ListPropertiesWithinCommuteHandler decorator.QueryHandler[ListPropertiesWithinCommuteQuery, *ListPropertiesWithinCommuteResult]
+
+type ListPropertiesWithinCommuteHandlerImpl struct {
+	repository property.Repository
+	calendars  calendar.Repository
+	router     commute.Router
+	validator  *validator.Validate
+}
+
+// NewListPropertiesWithinCommuteHandler creates a new instance of
+// ListPropertiesWithinCommuteHandler, applying decorators for logging and validation.
+func NewListPropertiesWithinCommuteHandler(
+	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	router commute.Router,
+	logger log.Logger,
+	validator *validator.Validate,
+) ListPropertiesWithinCommuteHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	if router == nil {
+		panic("nil commute router")
+	}
+	return decorator.ApplyQueryDecorators(
+		ListPropertiesWithinCommuteHandlerImpl{
+			repository: propRepo,
+			calendars:  calendarRepo,
+			router:     router,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ListPropertiesWithinCommuteResult
+// and an error. The journeys start at the destination at the departure time.
+func (guh ListPropertiesWithinCommuteHandlerImpl) Handle(c context.Context, cmd ListPropertiesWithinCommuteQuery,
+) (*ListPropertiesWithinCommuteResult, error) {
+	unavailable, err := unavailableIDs(c, guh.calendars, cmd.FreeFrom, cmd.FreeTo)
+	if err != nil {
+		return nil, err
+	}
+	isochrone, err := guh.router.Isochrone(
+		c,
+		*address.NewPoint(cmd.Latitude, cmd.Longitude),
+		cmd.Departure,
+		time.Duration(cmd.MaxMinutes)*time.Minute,
+	)
+	switch {
+	case errors.Compare(err, commute.ErrUnavailable):
+		return nil, errors.NewUnavailableError(err)
+	case err != nil:
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+
+	properties := make([]property.Property, 0, cmd.Limit)
+	for from := time.Duration(0); from <= isochrone.MaxDuration && len(properties) < int(cmd.Limit); from += commuteBand {
+		band, err := guh.band(c, cmd, isochrone, unavailable, from, from+commuteBand, cmd.Limit-uint16(len(properties)))
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		properties = append(properties, band...)
+	}
+	return &ListPropertiesWithinCommuteResult{
+		Properties: properties,
+	}, nil
+}
+
+// band returns at most limit properties reached in at least from and less than to, quickest
+// first with their travel time. Within a band of commuteMinBand the properties read are not
+// always the quickest ones.
+func (guh ListPropertiesWithinCommuteHandlerImpl) band(
+	c context.Context,
+	cmd ListPropertiesWithinCommuteQuery,
+	isochrone *commute.Isochrone,
+	unavailable []string,
+	from time.Duration,
+	to time.Duration,
+	limit uint16,
+) ([]property.Property, error) {
+	found, err := guh.repository.ListWithinBoundary(
+		c,
+		isochrone.Area(from, to),
+		cmd.Category,
+		cmd.SaleType,
+		cmd.Attributes,
+		unavailable,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == int(limit) && to-from > commuteMinBand {
+		// Some properties of the band were left out, the quicker half is read first.
+		half := from + (to-from)/2
+		quicker, err := guh.band(c, cmd, isochrone, unavailable, from, half, limit)
+		if err != nil || len(quicker) == int(limit) {
+			return quicker, err
+		}
+		slower, err := guh.band(c, cmd, isochrone, unavailable, half, to, limit-uint16(len(quicker)))
+		return append(quicker, slower...), err
+	}
+
+	// A property on the edge of a box may be in a cell of another band.
+	band := make([]property.Property, 0, len(found))
+	for _, prop := range found {
+		if prop.Address.GeoJSON == nil {
+			continue
+		}
+		travelTime, ok := isochrone.TravelTime(*prop.Address.GeoJSON)
+		if !ok || travelTime < from || travelTime >= to {
+			continue
+		}
+		prop.TravelTime = travelTime
+		band = append(band, prop)
+	}
+	sort.SliceStable(band, func(a, b int) bool {
+		if band[a].TravelTime != band[b].TravelTime {
+			return band[a].TravelTime < band[b].TravelTime
+		}
+		return band[a].ID < band[b].ID
+	})
+	return band, nil
+}
+
+type ListPropertiesWithinCommuteResult struct {
+	Properties []property.Property `json:"properties"`
+}
@@ -0,0 +1,180 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/commute"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ListPropertiesWithinCommuteTestSuite is the test suite for the ListPropertiesWithinCommute query.
+type ListPropertiesWithinCommuteTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ListPropertiesWithinCommuteHandler
+	params     query.ListPropertiesWithinCommuteQuery
+	newParams  []property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *ListPropertiesWithinCommuteTestSuite) SetupSuite() {
+	// The test timetable runs a bus from Mgarr Harbour at 08:05 and 09:05 reaching the
+	// Victoria terminus 20 minutes later.
+	router, err := commute.NewLocalRouter("testdata/gtfs", "testdata/walking.geojson")
+	s.Require().NoError(err, "Expected the test timetable to load")
+	// Initialize the query handler
+	s.handler = query.NewListPropertiesWithinCommuteHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		router,
+		s.log,
+		s.validator,
+	)
+	malta, err := time.LoadLocation("Europe/Malta")
+	s.Require().NoError(err)
+	for _, location := range []struct {
+		title       string
+		street      string
+		coordinates [2]float64
+	}{
+		{title: "Victoria Commuter House", street: "Triq ic-Cangar", coordinates: [2]float64{14.2394, 36.0443}},
+		{title: "Xlendi Commuter House", street: "Triq il-Port", coordinates: [2]float64{14.2160, 36.0290}},
+		{title: "Harbour Commuter House", street: "Triq ix-Xatt", coordinates: [2]float64{14.2995, 36.0255}},
+		{title: "Quay Commuter House", street: "Triq ix-Xatt", coordinates: [2]float64{14.2975, 36.0262}},
+	} {
+		params := property.NewPropertyParams{
+			PropertyID: database.NewStringID(),
+			OwnerID:    database.NewStringID(),
+			Address: address.Address{
+				FirstLine: "42",
+				Street:    location.street,
+				City:      "Victoria",
+				Country:   "Malta",
+				GeoJSON: &address.GeoJSONCoordinates{
+					Type:        "Point",
+					Coordinates: location.coordinates,
+				},
+			},
+			Description:   "A property within the commute of the harbour",
+			Title:         location.title,
+			Category:      "House",
+			Status:        property.Published,
+			AvailableDate: time.Now(),
+			SaleType:      1,
+		}
+		if _, err := s.ServiceDep.Repo.PropertyRepository.New(s.ctx, params); err != nil {
+			s.Fail("Failed to create property for testing", err)
+		}
+		s.newParams = append(s.newParams, params)
+	}
+	s.params = query.ListPropertiesWithinCommuteQuery{
+		Latitude:   36.0250,
+		Longitude:  14.2990,
+		Departure:  time.Date(2026, time.October, 19, 8, 0, 0, 0, malta),
+		MaxMinutes: 45,
+		Category:   "House",
+		Limit:      10,
+	}
+}
+
+// TestListPropertiesWithinCommuteHandler tests that the property reached by bus is returned
+// with its travel time and the one out of reach is not.
+func (s *ListPropertiesWithinCommuteTestSuite) TestListPropertiesWithinCommuteHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.Require().NoError(err, "Expected no error when listing properties within the commute")
+	var found bool
+	for _, prop := range result.Properties {
+		s.NotEqual(s.newParams[1].PropertyID, prop.ID, "Expected the property out of reach to be left out")
+		if prop.ID == s.newParams[0].PropertyID {
+			found = true
+			s.Greater(prop.TravelTime, 25*time.Minute, "Expected the bus ride and the walk")
+			s.LessOrEqual(prop.TravelTime, 30*time.Minute, "Expected the bus of 08:05")
+		}
+	}
+	s.True(found, "Expected the property near the terminus to be found")
+	for i := 1; i < len(result.Properties); i++ {
+		s.LessOrEqual(result.Properties[i-1].TravelTime, result.Properties[i].TravelTime,
+			"Expected properties to be sorted quickest first")
+	}
+}
+
+// TestListPropertiesWithinCommuteLimit tests that the quickest properties are listed when
+// the band they are in holds more properties than the limit.
+func (s *ListPropertiesWithinCommuteTestSuite) TestListPropertiesWithinCommuteLimit() {
+	params := s.params
+	params.Limit = 2
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when listing properties within the commute")
+	s.Require().Len(result.Properties, 2, "Expected the limit to be reached")
+	s.Equal(s.newParams[2].PropertyID, result.Properties[0].ID, "Expected the property at the harbour first")
+	s.Equal(s.newParams[3].PropertyID, result.Properties[1].ID, "Expected the property along the quay next")
+
+	params.Limit = 1
+	result, err = s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when listing properties within the commute")
+	s.Require().Len(result.Properties, 1, "Expected the limit to be reached")
+	s.Equal(s.newParams[2].PropertyID, result.Properties[0].ID, "Expected the quickest property")
+}
+
+// TestListPropertiesWithinCommuteMissedBus tests that leaving after the bus does not reach
+// the property within the maximum travel time.
+func (s *ListPropertiesWithinCommuteTestSuite) TestListPropertiesWithinCommuteMissedBus() {
+	params := s.params
+	params.Departure = params.Departure.Add(10 * time.Minute)
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when listing properties within the commute")
+	for _, prop := range result.Properties {
+		s.NotEqual(s.newParams[0].PropertyID, prop.ID, "Expected the next bus to be too late")
+	}
+}
+
+// TestListPropertiesWithinCommuteUnavailable tests that the search is unavailable without a
+// timetable.
+func (s *ListPropertiesWithinCommuteTestSuite) TestListPropertiesWithinCommuteUnavailable() {
+	handler := query.NewListPropertiesWithinCommuteHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		commute.NewUnavailableRouter(),
+		s.log,
+		s.validator,
+	)
+	_, err := handler.Handle(s.ctx, s.params)
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.Unavailable, appErr.Code(), "Expected an unavailable error")
+}
+
+// TestListPropertiesWithinCommuteInvalid tests that a travel time over two hours is rejected.
+func (s *ListPropertiesWithinCommuteTestSuite) TestListPropertiesWithinCommuteInvalid() {
+	params := s.params
+	params.MaxMinutes = 121
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for a travel time over two hours")
+}
+
+func (s *ListPropertiesWithinCommuteTestSuite) TearDownSuite() {
+	// Clean up the test data
+	for _, params := range s.newParams {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, params.PropertyID); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}
+}
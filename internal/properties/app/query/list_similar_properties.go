@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ListSimilarPropertiesQuery : This is used to list the properties similar to a property.
+type ListSimilarPropertiesQuery struct {
+	ID    string `validate:"required"`
+	Limit uint16 `validate:"required,max=50"`
+}
+
+// ListSimilarPropertiesHandler is a CQRS endpoint that handles a query to retrieve the properties similar to a property.
+// It implements the QueryHandler interface for the ListSimilarPropertiesQuery.
+// The handler scores the published properties on category, sale type, distance and wording in the database
+// and returns the most similar first, each with its score.
+type ListSimilarPropertiesHandler decorator.QueryHandler[ListSimilarPropertiesQuery, *ListSimilarPropertiesResult]
+
+type ListSimilarPropertiesHandlerImpl struct {
+	repository property.Repository
+	validator  *validator.Validate
+}
+
+// NewListSimilarPropertiesHandler creates a new instance of ListSimilarPropertiesHandler,
+// applying decorators for logging and validation.
+func NewListSimilarPropertiesHandler(
+	propRepo property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) ListSimilarPropertiesHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		ListSimilarPropertiesHandlerImpl{
+			repository: propRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ListSimilarPropertiesResult
+// and an error.
+func (guh ListSimilarPropertiesHandlerImpl) Handle(c context.Context, cmd ListSimilarPropertiesQuery,
+) (*ListSimilarPropertiesResult, error) {
+	source, err := guh.repository.Get(c, cmd.ID)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	properties, err := guh.repository.ListSimilar(c, *source, cmd.Limit)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return &ListSimilarPropertiesResult{
+		Properties: properties,
+	}, nil
+}
+
+type ListSimilarPropertiesResult struct {
+	Properties []property.Property `json:"properties"`
+}
@@ -0,0 +1,138 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ListSimilarPropertiesTestSuite is the test suite for the ListSimilarProperties query.
+type ListSimilarPropertiesTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ListSimilarPropertiesHandler
+	source     property.NewPropertyParams
+	similar    property.NewPropertyParams
+	different  property.NewPropertyParams
+	unlocated  property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// newSimilarTestProperty returns the parameters of a test property at a point.
+func newSimilarTestProperty(title, category string, saleType uint8, lng, lat float64) property.NewPropertyParams {
+	return property.NewPropertyParams{
+		PropertyID: database.NewStringID(),
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "12",
+			Street:     "Triq il-Mgarr",
+			City:       "Ghajnsielem",
+			Country:    "Malta",
+			PostalCode: "GSM1010",
+			GeoJSON: &address.GeoJSONCoordinates{
+				Type:        "Point",
+				Coordinates: [2]float64{lng, lat},
+			},
+		},
+		Description:   title + " with a sea view",
+		Title:         title,
+		Category:      category,
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      saleType,
+	}
+}
+
+// properties returns the parameters of the test properties.
+func (s *ListSimilarPropertiesTestSuite) properties() []property.NewPropertyParams {
+	return []property.NewPropertyParams{s.source, s.similar, s.different, s.unlocated}
+}
+
+// SetupSuite initializes the test suite.
+func (s *ListSimilarPropertiesTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewListSimilarPropertiesHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.log,
+		s.validator,
+	)
+	s.source = newSimilarTestProperty("Harbour Farmhouse", "House", 1, 14.2990, 36.0270)
+	s.similar = newSimilarTestProperty("Harbour Farmhouse Annex", "House", 1, 14.3000, 36.0275)
+	s.different = newSimilarTestProperty("City Office", "Office", 2, 14.5146, 35.8989)
+	s.unlocated = newSimilarTestProperty("Harbour Farmhouse Plot", "House", 1, 0, 0)
+	s.unlocated.Address.GeoJSON = nil
+	for _, params := range s.properties() {
+		if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+			s.ctx,
+			params,
+		); err != nil {
+			s.Fail("Failed to create property for testing", err)
+		}
+	}
+}
+
+// TestListSimilarPropertiesHandler tests that the most similar property is listed first and
+// the source is left out.
+func (s *ListSimilarPropertiesTestSuite) TestListSimilarPropertiesHandler() {
+	result, err := s.handler.Handle(s.ctx, query.ListSimilarPropertiesQuery{
+		ID:    s.source.PropertyID,
+		Limit: 10,
+	})
+	s.NoError(err, "Expected no error when listing similar properties")
+	s.Require().NotEmpty(result.Properties, "Expected similar properties to be found")
+	s.Equal(s.similar.PropertyID, result.Properties[0].ID, "Expected the most similar property first")
+	for i, similar := range result.Properties {
+		s.NotEqual(s.source.PropertyID, similar.ID, "Expected the source to be left out")
+		if i > 0 {
+			s.LessOrEqual(similar.SimilarityScore, result.Properties[i-1].SimilarityScore)
+		}
+	}
+}
+
+// TestListSimilarPropertiesWithoutLocation tests that a source without a location is compared
+// with the properties sharing its category or sale type only.
+func (s *ListSimilarPropertiesTestSuite) TestListSimilarPropertiesWithoutLocation() {
+	result, err := s.handler.Handle(s.ctx, query.ListSimilarPropertiesQuery{
+		ID:    s.unlocated.PropertyID,
+		Limit: 10,
+	})
+	s.NoError(err, "Expected no error when listing similar properties")
+	s.Require().NotEmpty(result.Properties, "Expected similar properties to be found")
+	for _, similar := range result.Properties {
+		s.NotEqual(s.unlocated.PropertyID, similar.ID, "Expected the source to be left out")
+		s.NotEqual(s.different.PropertyID, similar.ID, "Expected a property sharing nothing to be left out")
+	}
+}
+
+// TestListSimilarPropertiesInvalid tests that a missing id and limit are rejected.
+func (s *ListSimilarPropertiesTestSuite) TestListSimilarPropertiesInvalid() {
+	_, err := s.handler.Handle(s.ctx, query.ListSimilarPropertiesQuery{Limit: 10})
+	s.Error(err, "Expected an error for a missing id")
+
+	_, err = s.handler.Handle(s.ctx, query.ListSimilarPropertiesQuery{ID: s.source.PropertyID})
+	s.Error(err, "Expected an error for a missing limit")
+}
+
+func (s *ListSimilarPropertiesTestSuite) TearDownSuite() {
+	// Clean up the test data
+	for _, params := range s.properties() {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, params.PropertyID); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultViewingPeriod : how far ahead the viewing slots are listed when no end is given.
+const DefaultViewingPeriod = 14 * 24 * time.Hour
+
+// ListViewingSlotsQuery : This is used to retrieve the viewing slots of a property from Start,
+// now when unset, up to End, DefaultViewingPeriod after Start when unset.
+type ListViewingSlotsQuery struct {
+	PropertyID string    `validate:"required"`
+	Start      time.Time `validate:"omitempty"`
+	End        time.Time `validate:"omitempty"`
+	FreeOnly   bool      // Leave out the booked slots.
+}
+
+// ListViewingSlotsHandler is a CQRS endpoint that handles a query to list the viewing slots of a property.
+// It implements the QueryHandler interface for the ListViewingSlotsQuery.
+// The handler returns the slots of the property in the period, the earliest first.
+type ListViewingSlotsHandler decorator.QueryHandler[ListViewingSlotsQuery, *ListViewingSlotsResult]
+
+type ListViewingSlotsHandlerImpl struct {
+	repository viewing.Repository
+	validator  *validator.Validate
+}
+
+// NewListViewingSlotsHandler creates a new instance of ListViewingSlotsHandler,
+// applying decorators for logging and validation.
+func NewListViewingSlotsHandler(
+	viewingRepo viewing.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) ListViewingSlotsHandler {
+	if viewingRepo == nil {
+		panic("nil viewing repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		ListViewingSlotsHandlerImpl{
+			repository: viewingRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ListViewingSlotsResult
+// and an error. An end before the start is an invalid argument.
+func (guh ListViewingSlotsHandlerImpl) Handle(c context.Context, cmd ListViewingSlotsQuery,
+) (*ListViewingSlotsResult, error) {
+	start := cmd.Start
+	if start.IsZero() {
+		start = time.Now()
+	}
+	end := cmd.End
+	if end.IsZero() {
+		end = start.Add(DefaultViewingPeriod)
+	}
+	if !end.After(start) {
+		return nil, errors.NewInvalidArgumentError(errors.NewSimple("end must be after start"))
+	}
+	slots, err := guh.repository.ListByProperty(c, cmd.PropertyID, start, end)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	viewings := make([]viewing.Viewing, 0, len(slots))
+	for _, slot := range slots {
+		if !cmd.FreeOnly || !slot.IsBooked() {
+			viewings = append(viewings, slot)
+		}
+	}
+	return &ListViewingSlotsResult{
+		PropertyID: cmd.PropertyID,
+		Start:      start,
+		End:        end,
+		Viewings:   viewings,
+	}, nil
+}
+
+// ListViewingSlotsResult holds the viewing slots of a property overlapping the period.
+type ListViewingSlotsResult struct {
+	PropertyID string            `json:"propertyId"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Viewings   []viewing.Viewing `json:"viewings"`
+}
@@ -0,0 +1,108 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"time"
+
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/internal/properties/service"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ListViewingSlotsTestSuite is the test suite for the ListViewingSlots query.
+type ListViewingSlotsTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.ListViewingSlotsHandler
+	propertyID string
+	start      time.Time
+	slots      []string
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite with three slots of a property, the second booked.
+func (s *ListViewingSlotsTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewListViewingSlotsHandler(
+		s.ServiceDep.Repo.ViewingRepository,
+		s.log,
+		s.validator,
+	)
+	s.propertyID = database.NewStringID()
+	s.start = time.Now().AddDate(0, 0, 3).Truncate(time.Hour)
+	for i := 0; i < 3; i++ {
+		start := s.start.Add(time.Duration(i) * time.Hour)
+		slot, err := s.ServiceDep.Repo.ViewingRepository.New(s.ctx, viewing.NewViewingParams{
+			PropertyID: s.propertyID,
+			Start:      start,
+			End:        start.Add(30 * time.Minute),
+		})
+		if err != nil {
+			s.Fail("Failed to create viewing slot for testing", err)
+			return
+		}
+		s.slots = append(s.slots, slot.ID)
+	}
+	if err := s.ServiceDep.Repo.ViewingRepository.Book(s.ctx, s.slots[1], viewing.Booking{
+		ID:       database.NewStringID(),
+		Name:     "Joe Camilleri",
+		Email:    "joe.camilleri@example.com",
+		BookedAt: time.Now(),
+	}); err != nil {
+		s.Fail("Failed to book viewing slot for testing", err)
+	}
+}
+
+// TestListViewingSlotsHandler tests that the slots are listed earliest first.
+func (s *ListViewingSlotsTestSuite) TestListViewingSlotsHandler() {
+	result, err := s.handler.Handle(s.ctx, query.ListViewingSlotsQuery{
+		PropertyID: s.propertyID,
+	})
+	s.Require().NoError(err, "Expected no error when listing the viewing slots")
+	s.Require().Len(result.Viewings, 3, "Expected every slot within the default period")
+	for i, slot := range result.Viewings {
+		s.Equal(s.slots[i], slot.ID, "Expected the slots earliest first")
+	}
+	s.True(result.Viewings[1].IsBooked(), "Expected the second slot to be booked")
+}
+
+// TestListViewingSlotsFreeOnly tests that the booked slots are left out.
+func (s *ListViewingSlotsTestSuite) TestListViewingSlotsFreeOnly() {
+	result, err := s.handler.Handle(s.ctx, query.ListViewingSlotsQuery{
+		PropertyID: s.propertyID,
+		Start:      s.start,
+		End:        s.start.Add(2 * time.Hour),
+		FreeOnly:   true,
+	})
+	s.Require().NoError(err, "Expected no error when listing the viewing slots")
+	s.Require().Len(result.Viewings, 1, "Expected the free slot within the period")
+	s.Equal(s.slots[0], result.Viewings[0].ID)
+}
+
+func (s *ListViewingSlotsTestSuite) TearDownSuite() {
+	// Clean up the test data
+	for _, id := range s.slots {
+		slot, err := s.ServiceDep.Repo.ViewingRepository.Get(s.ctx, id)
+		if err == nil && slot.IsBooked() {
+			err = s.ServiceDep.Repo.ViewingRepository.Cancel(s.ctx, id, slot.Booking.ID)
+		}
+		if err == nil {
+			err = s.ServiceDep.Repo.ViewingRepository.Delete(s.ctx, id)
+		}
+		if err != nil {
+			s.log.Error("Failed to delete viewing slot after test", err)
+		}
+	}
+}
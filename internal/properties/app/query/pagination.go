@@ -0,0 +1,115 @@
+package query
+
+import (
+	"fmt"
+	"math"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+	"property-service/pkg/pagination"
+)
+
+// Page : The page level pagination of a list result.
+type Page struct {
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	PrevPageToken string `json:"prevPageToken,omitempty"`
+	HasMore       bool   `json:"hasMore"` // More properties follow in the direction the page was read.
+	TotalCount    *int64 `json:"totalCount,omitempty"`
+}
+
+// pager reads and issues the page tokens of a list query, a token is only accepted for the
+// sort and filter it was issued for.
+type pager struct {
+	tokens pagination.Manager
+	sort   string
+	filter string
+}
+
+// newPager creates the pager of a list query, the sort is compared in its printed form.
+func newPager(tokens pagination.Manager, sort any, filter any) (pager, error) {
+	fingerprint, err := pagination.Fingerprint(filter)
+	if err != nil {
+		return pager{}, err
+	}
+	return pager{
+		tokens: tokens,
+		sort:   fmt.Sprint(sort),
+		filter: fingerprint,
+	}, nil
+}
+
+// open decodes a page token into the database cursor and search direction, an empty token
+// opens the first page.
+func (p pager) open(pageToken string) (string, uint8, error) {
+	if pageToken == "" {
+		return "", 0, nil
+	}
+	token, err := p.tokens.Decode(pageToken)
+	if err != nil {
+		return "", 0, err
+	}
+	if token.Sort != p.sort || token.Filter != p.filter {
+		return "", 0, errors.NewInvalidArgumentError(errors.ErrPageTokenMismatch)
+	}
+	return token.Cursor, token.Direction, nil
+}
+
+// fetchLimit returns the number of properties to read for a page, one more than the limit
+// tells whether more properties follow.
+func fetchLimit(limit uint16) uint16 {
+	if limit == math.MaxUint16 {
+		return limit
+	}
+	return limit + 1
+}
+
+// page cuts the properties read with fetchLimit down to the limit and issues the tokens of
+// the pages before and after it.
+func (p pager) page(
+	properties []property.Property,
+	limit uint16,
+	search uint8,
+) ([]property.Property, Page, error) {
+	var page Page
+	page.HasMore = len(properties) > int(limit)
+	if page.HasMore {
+		// A page before the token is read backwards, so the extra property is the first one.
+		if search == pagination.Before {
+			properties = properties[len(properties)-int(limit):]
+		} else {
+			properties = properties[:limit]
+		}
+	}
+	if len(properties) == 0 {
+		return properties, page, nil
+	}
+
+	var err error
+	hasNext, hasPrev := page.HasMore, search == pagination.After
+	if search == pagination.Before {
+		hasNext, hasPrev = true, page.HasMore
+	}
+	if hasNext {
+		page.NextPageToken, err = p.tokens.Encode(pagination.Token{
+			Cursor:    properties[len(properties)-1].PaginationToken,
+			Direction: pagination.After,
+			Sort:      p.sort,
+			Filter:    p.filter,
+		})
+		if err != nil {
+			return nil, page, err
+		}
+	}
+	if hasPrev {
+		page.PrevPageToken, err = p.tokens.Encode(pagination.Token{
+			Cursor:    properties[0].PaginationToken,
+			Direction: pagination.Before,
+			Sort:      p.sort,
+			Filter:    p.filter,
+		})
+		if err != nil {
+			return nil, page, err
+		}
+	}
+	return properties, page, nil
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+
+	"property-service/pkg/address"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ReverseGeocodeQuery : This is used to find the address of a location, e.g. of a device.
+type ReverseGeocodeQuery struct {
+	Latitude  float64 `validate:"latitude"`
+	Longitude float64 `validate:"longitude"`
+}
+
+// ReverseGeocodeHandler is a CQRS endpoint that handles a query to retrieve the address of a location.
+// It implements the QueryHandler interface for the ReverseGeocodeQuery.
+// The handler resolves the location through the configured geocoder and returns the address,
+// in its canonical form when it is valid, with the location as its GeoJSON point.
+type ReverseGeocodeHandler decorator.QueryHandler[ReverseGeocodeQuery, *ReverseGeocodeResult]
+
+type ReverseGeocodeHandlerImpl struct {
+	geocoder  address.Geocoder
+	validator *validator.Validate
+}
+
+// NewReverseGeocodeHandler creates a new instance of ReverseGeocodeHandler,
+// applying decorators for logging and validation.
+func NewReverseGeocodeHandler(
+	geocoder address.Geocoder,
+	logger log.Logger,
+	validator *validator.Validate,
+) ReverseGeocodeHandler {
+	if geocoder == nil {
+		panic("nil geocoder")
+	}
+	return decorator.ApplyQueryDecorators(
+		ReverseGeocodeHandlerImpl{
+			geocoder:  geocoder,
+			validator: validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a ReverseGeocodeResult
+// and an error. A location without an address is not found and an application
+// error of the geocoder, e.g. Unavailable, keeps its code.
+func (guh ReverseGeocodeHandlerImpl) Handle(c context.Context, cmd ReverseGeocodeQuery,
+) (*ReverseGeocodeResult, error) {
+	found, err := guh.geocoder.ReverseGeocode(c, cmd.Latitude, cmd.Longitude)
+	var appErr errors.AppError
+	switch {
+	case errors.AsAppError(err, &appErr):
+		return nil, err
+	case errors.Compare(err, address.ErrAddressNotFound):
+		return nil, errors.NewHandlerError(
+			err,
+			codes.NotFound,
+		)
+	case err != nil:
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if canonical, normaliseErr := address.Normalise(found); normaliseErr == nil {
+		found = canonical
+	}
+	found.GeoJSON = address.NewPoint(cmd.Latitude, cmd.Longitude)
+	return &ReverseGeocodeResult{
+		Address: found,
+	}, nil
+}
+
+type ReverseGeocodeResult struct {
+	Address address.Address `json:"address"`
+}
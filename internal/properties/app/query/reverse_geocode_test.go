@@ -0,0 +1,70 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/pkg/configs"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// ReverseGeocodeTestSuite is the test suite for the ReverseGeocode query.
+type ReverseGeocodeTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	log       log.Logger
+	config    configs.Config
+	validator *validator.Validate
+	handler   query.ReverseGeocodeHandler
+	params    query.ReverseGeocodeQuery
+}
+
+// SetupSuite initializes the test suite.
+func (s *ReverseGeocodeTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewReverseGeocodeHandler(
+		testGeocoder,
+		s.log,
+		s.validator,
+	)
+	// Close to the VCT2162 centroid of the test dataset.
+	s.params = query.ReverseGeocodeQuery{
+		Latitude:  36.0444,
+		Longitude: 14.2395,
+	}
+}
+
+// TestReverseGeocodeHandler tests that the canonical address of the location is returned
+// with the location as its [lng, lat] GeoJSON point.
+func (s *ReverseGeocodeTestSuite) TestReverseGeocodeHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.Require().NoError(err, "Expected no error when reverse geocoding")
+	s.Equal("Victoria", result.Address.City)
+	s.Equal("Malta", result.Address.Country)
+	s.Equal("VCT 2162", result.Address.PostalCode, "Expected the postcode in its canonical form")
+	s.Require().NotNil(result.Address.GeoJSON, "Expected the location as the GeoJSON point")
+	s.Equal([2]float64{s.params.Longitude, s.params.Latitude}, result.Address.GeoJSON.Coordinates)
+}
+
+// TestReverseGeocodeNotFound tests that a location without an address is not found.
+func (s *ReverseGeocodeTestSuite) TestReverseGeocodeNotFound() {
+	_, err := s.handler.Handle(s.ctx, query.ReverseGeocodeQuery{Latitude: 51.5072, Longitude: -0.1276})
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.NotFound, appErr.Code(), "Expected a not found error")
+}
+
+// TestReverseGeocodeInvalid tests that a latitude out of range is rejected.
+func (s *ReverseGeocodeTestSuite) TestReverseGeocodeInvalid() {
+	params := s.params
+	params.Latitude = 91
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for a latitude out of range")
+}
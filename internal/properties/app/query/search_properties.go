@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/pagination"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// SearchPropertiesQuery : This is used to list the properties matching a filter.
+type SearchPropertiesQuery struct {
+	Filter          property.SearchFilter
+	Sort            uint8  `validate:"required"`
+	Limit           uint16 `validate:"required"`
+	PaginationToken string `validate:"omitempty"` // Page token of a previous result.
+	TotalCount      bool   // Also count every matching property.
+}
+
+// SearchPropertiesHandler is a CQRS endpoint that handles a query to retrieve the properties matching a filter.
+// It implements the QueryHandler interface for the SearchPropertiesQuery.
+// The handler retrieves the property models from the database and returns them to the caller.
+type SearchPropertiesHandler decorator.QueryHandler[SearchPropertiesQuery, *SearchPropertiesResult]
+
+type SearchPropertiesHandlerImpl struct {
+	repository property.Repository
+	calendars  calendar.Repository
+	tokens     pagination.Manager
+	validator  *validator.Validate
+}
+
+// NewSearchPropertiesHandler creates a new instance of SearchPropertiesHandler,
+// applying decorators for logging and validation.
+func NewSearchPropertiesHandler(
+	propRepo property.Repository,
+	calendarRepo calendar.Repository,
+	tokens pagination.Manager,
+	logger log.Logger,
+	validator *validator.Validate,
+) SearchPropertiesHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	if calendarRepo == nil {
+		panic("nil calendar repository")
+	}
+	if tokens == nil {
+		panic("nil pagination manager")
+	}
+	return decorator.ApplyQueryDecorators(
+		SearchPropertiesHandlerImpl{
+			repository: propRepo,
+			calendars:  calendarRepo,
+			tokens:     tokens,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a SearchPropertiesResult
+// and an error. The page tokens are bound to the filter as it was given, so a page stays
+// valid while the calendars of its properties change.
+func (guh SearchPropertiesHandlerImpl) Handle(c context.Context, cmd SearchPropertiesQuery,
+) (*SearchPropertiesResult, error) {
+	filter, err := excludeUnavailable(c, guh.calendars, cmd.Filter)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := newPager(guh.tokens, cmd.Sort, cmd.Filter)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	cursor, search, err := pages.open(cmd.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := guh.repository.Search(
+		c,
+		filter,
+		cmd.Sort,
+		fetchLimit(cmd.Limit),
+		cursor,
+		search,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	properties, page, err := pages.page(properties, cmd.Limit, search)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if cmd.TotalCount {
+		total, err := guh.repository.CountSearch(c, filter)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		page.TotalCount = &total
+	}
+	return &SearchPropertiesResult{
+		Properties: properties,
+		Page:       page,
+	}, nil
+}
+
+type SearchPropertiesResult struct {
+	Properties []property.Property `json:"properties"`
+	Page       Page                `json:"page"`
+}
@@ -0,0 +1,120 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/pagination"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// SearchPropertiesByTextQuery : This is used to list the properties matching a free text query.
+type SearchPropertiesByTextQuery struct {
+	Text            string        `validate:"required"`
+	Sort            property.Sort `validate:"omitempty,dive"` // Empty sorts by relevance.
+	Limit           uint16        `validate:"required"`
+	PaginationToken string        `validate:"omitempty"` // Page token of a previous result.
+	TotalCount      bool          // Also count every matching property.
+}
+
+// SearchPropertiesByTextHandler is a CQRS endpoint that handles a query to retrieve the properties
+// whose title, description or address match a text, tolerating misspellings.
+// It implements the QueryHandler interface for the SearchPropertiesByTextQuery.
+// The handler retrieves the property models with the matched passages highlighted and returns them to the caller.
+type SearchPropertiesByTextHandler decorator.QueryHandler[SearchPropertiesByTextQuery, *SearchPropertiesByTextResult]
+
+type SearchPropertiesByTextHandlerImpl struct {
+	repository property.Repository
+	tokens     pagination.Manager
+	validator  *validator.Validate
+}
+
+// NewSearchPropertiesByTextHandler creates a new instance of SearchPropertiesByTextHandler,
+// applying decorators for logging and validation.
+func NewSearchPropertiesByTextHandler(
+	propRepo property.Repository,
+	tokens pagination.Manager,
+	logger log.Logger,
+	validator *validator.Validate,
+) SearchPropertiesByTextHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	if tokens == nil {
+		panic("nil pagination manager")
+	}
+	return decorator.ApplyQueryDecorators(
+		SearchPropertiesByTextHandlerImpl{
+			repository: propRepo,
+			tokens:     tokens,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a SearchPropertiesByTextResult
+// and an error.
+func (guh SearchPropertiesByTextHandlerImpl) Handle(c context.Context, cmd SearchPropertiesByTextQuery,
+) (*SearchPropertiesByTextResult, error) {
+	if err := cmd.Sort.Validate(); err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	pages, err := newPager(guh.tokens, cmd.Sort, cmd.Text)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	cursor, search, err := pages.open(cmd.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := guh.repository.SearchText(
+		c,
+		cmd.Text,
+		cmd.Sort,
+		fetchLimit(cmd.Limit),
+		cursor,
+		search,
+	)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	properties, page, err := pages.page(properties, cmd.Limit, search)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	if cmd.TotalCount {
+		total, err := guh.repository.CountSearchText(c, cmd.Text)
+		if err != nil {
+			return nil, errors.NewHandlerError(
+				err,
+				codes.Internal,
+			)
+		}
+		page.TotalCount = &total
+	}
+	return &SearchPropertiesByTextResult{
+		Properties: properties,
+		Page:       page,
+	}, nil
+}
+
+type SearchPropertiesByTextResult struct {
+	Properties []property.Property `json:"properties"`
+	Page       Page                `json:"page"`
+}
@@ -0,0 +1,117 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// SearchPropertiesByTextTestSuite is the test suite for the SearchPropertiesByText query.
+type SearchPropertiesByTextTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.SearchPropertiesByTextHandler
+	params     query.SearchPropertiesByTextQuery
+	newParams  property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *SearchPropertiesByTextTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewSearchPropertiesByTextHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Pages,
+		s.log,
+		s.validator,
+	)
+	s.newParams = property.NewPropertyParams{
+		PropertyID: database.NewStringID(),
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "42",
+			Street:     "Triq ic-Cangar",
+			City:       "Victoria",
+			County:     "",
+			Country:    "Malta",
+			PostalCode: "VCT2162",
+			GeoJSON: &address.GeoJSONCoordinates{
+				Type:        "Point",
+				Coordinates: [2]float64{14.2394, 36.0443},
+			},
+		},
+		Description:   "A farmhouse with a view of the Citadel",
+		Title:         "Farmhouse in Victoria",
+		Category:      "House",
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      1,
+	}
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+		s.ctx,
+		s.newParams,
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+	s.params = query.SearchPropertiesByTextQuery{
+		Text:  "citadel",
+		Limit: 10,
+	}
+}
+
+// TestSearchPropertiesByTextHandler tests that a property is found with its matched passages.
+func (s *SearchPropertiesByTextTestSuite) TestSearchPropertiesByTextHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.NoError(err, "Expected no error when searching properties by text")
+	s.Require().NotEmpty(result.Properties, "Expected the test property to be found")
+	for _, found := range result.Properties {
+		if found.ID == s.newParams.PropertyID {
+			s.NotEmpty(found.Highlights, "Expected the matched passages to be highlighted")
+			return
+		}
+	}
+	s.Fail("Expected the test property to be found")
+}
+
+// TestSearchPropertiesByTextSort tests that a sort is accepted and an unsupported one rejected.
+func (s *SearchPropertiesByTextTestSuite) TestSearchPropertiesByTextSort() {
+	params := s.params
+	params.Sort = property.Sort{{Field: property.SortByTitle}}
+	result, err := s.handler.Handle(s.ctx, params)
+	s.NoError(err, "Expected no error when searching properties by text")
+	s.NotEmpty(result.Properties, "Expected the test property to be found")
+
+	params.Sort = property.Sort{{Field: "price"}}
+	_, err = s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an unsupported sort field")
+}
+
+// TestSearchPropertiesByTextEmpty tests that an empty text is rejected.
+func (s *SearchPropertiesByTextTestSuite) TestSearchPropertiesByTextEmpty() {
+	params := s.params
+	params.Text = ""
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an empty text")
+}
+
+func (s *SearchPropertiesByTextTestSuite) TearDownSuite() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.newParams.PropertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
@@ -0,0 +1,307 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/money"
+	"property-service/pkg/pagination"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// SearchPropertiesTestSuite is the test suite for the SearchProperties query.
+type SearchPropertiesTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.SearchPropertiesHandler
+	params     query.SearchPropertiesQuery
+	newParams  property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *SearchPropertiesTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewSearchPropertiesHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.ServiceDep.Repo.CalendarRepository,
+		s.ServiceDep.Pages,
+		s.log,
+		s.validator,
+	)
+	s.newParams = property.NewPropertyParams{
+		PropertyID: database.NewStringID(),
+		OwnerID:    database.NewStringID(),
+		Address: address.Address{
+			FirstLine:  "42",
+			Street:     "Triq ic-Cangar",
+			City:       "Victoria",
+			County:     "",
+			Country:    "Malta",
+			PostalCode: "VCT2162",
+			GeoJSON: &address.GeoJSONCoordinates{
+				Type:        "Point",
+				Coordinates: [2]float64{14.2394, 36.0443},
+			},
+		},
+		Description:   "A beautiful property",
+		Title:         "Beautiful Property",
+		Category:      "House",
+		Status:        property.Published,
+		AvailableDate: time.Now(),
+		SaleType:      1,
+		Nearby: map[string]property.NearbyPOI{
+			"station": {
+				Name:     "Victoria Bus Terminus",
+				Distance: 300,
+				Location: *address.NewPoint(36.0470, 14.2394),
+			},
+		},
+		Rent: &property.Rent{Price: money.New(30000, "EUR"), Period: property.Weekly},
+		Attributes: &property.Attributes{
+			Bedrooms:  2,
+			Bathrooms: 1,
+			FloorArea: &property.FloorArea{Size: 75, Unit: property.SquareMetres},
+			Parking:   property.StreetParking,
+			Amenities: []string{"Balcony", "Air Conditioning"},
+		},
+	}
+	if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+		s.ctx,
+		s.newParams,
+	); err != nil {
+		s.Fail("Failed to create property for testing", err)
+	}
+	s.params = query.SearchPropertiesQuery{
+		Filter: property.SearchFilter{
+			Categories:     []string{"House", "Flat"},
+			SaleType:       1,
+			Statuses:       []property.Status{property.Published},
+			AvailableFrom:  time.Now().AddDate(0, 0, -1),
+			AvailableTo:    time.Now().AddDate(0, 0, 1),
+			City:           "Victoria",
+			PostcodePrefix: "VCT",
+			Country:        "Malta",
+		},
+		Sort:  1,
+		Limit: 10,
+	}
+}
+
+// TestSearchPropertiesHandler tests the SearchPropertiesHandler.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.NoError(err, "Expected no error when searching properties")
+	s.NotEmpty(result.Properties, "Expected the test property to be found")
+}
+
+// TestSearchPropertiesNearPOI tests that the properties are filtered by the distance to the
+// nearest point of interest of a type.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesNearPOI() {
+	params := s.params
+	params.Filter.NearPOIs = []property.POIDistance{{Type: "Station", Within: 500}}
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching properties near a station")
+	s.NotEmpty(result.Properties, "Expected the test property within 500 metres of a station")
+
+	params.Filter.NearPOIs = []property.POIDistance{{Type: "Station", Within: 100}}
+	result, err = s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching properties near a station")
+	for _, found := range result.Properties {
+		s.NotEqual(s.newParams.PropertyID, found.ID, "Expected the test property to be filtered out")
+	}
+}
+
+// TestSearchPropertiesRentRange tests that the properties are filtered by their monthly rent,
+// 300.00 EUR a week is 1300.00 EUR a month.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesRentRange() {
+	params := s.params
+	params.Filter.Rent = &property.PriceRange{Min: 120000, Max: 140000, Currency: "EUR"}
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching properties by rent")
+	s.Require().NotEmpty(result.Properties, "Expected the test property within the rent range")
+	s.Require().NotNil(result.Properties[0].Rent, "Expected the rent to be listed")
+	s.Equal(int64(130000), result.Properties[0].Rent.MonthlyAmount, "Expected the weekly rent per month")
+
+	for _, priceRange := range []property.PriceRange{
+		{Min: 140000, Currency: "EUR"},
+		{Min: 120000, Max: 140000, Currency: "GBP"},
+	} {
+		params.Filter.Rent = &priceRange
+		result, err = s.handler.Handle(s.ctx, params)
+		s.Require().NoError(err, "Expected no error when searching properties by rent")
+		for _, found := range result.Properties {
+			s.NotEqual(s.newParams.PropertyID, found.ID, "Expected the test property to be filtered out")
+		}
+	}
+}
+
+// TestSearchPropertiesAttributes tests that the properties are filtered by their rooms, floor
+// area and amenities.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesAttributes() {
+	params := s.params
+	maxBedrooms, studios := uint8(3), uint8(0)
+	params.Filter.Attributes = property.AttributeFilter{
+		MinBedrooms:  2,
+		MaxBedrooms:  &maxBedrooms,
+		MinFloorArea: 70,
+		Parking:      true,
+		Amenities:    []string{"air conditioning", "balcony"},
+	}
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching properties by attributes")
+	s.Require().NotEmpty(result.Properties, "Expected the test property to match the attributes")
+	s.Require().NotNil(result.Properties[0].Attributes, "Expected the attributes to be listed")
+
+	garden := true
+	for name, attributes := range map[string]property.AttributeFilter{
+		"more bedrooms":     {MinBedrooms: 3},
+		"studios":           {MaxBedrooms: &studios},
+		"larger floor area": {MinFloorArea: 80},
+		"a missing amenity": {Amenities: []string{"balcony", "swimming pool"}},
+		"a garden":          {Garden: &garden},
+	} {
+		params.Filter.Attributes = attributes
+		result, err = s.handler.Handle(s.ctx, params)
+		s.Require().NoError(err, "Expected no error when searching properties with "+name)
+		for _, found := range result.Properties {
+			s.NotEqual(s.newParams.PropertyID, found.ID, "Expected the test property to be filtered out by "+name)
+		}
+	}
+}
+
+// TestSearchPropertiesDrafts tests that a draft is only listed when the drafts are requested.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesDrafts() {
+	draft := s.newParams
+	draft.PropertyID = database.NewStringID()
+	draft.Status = property.Draft
+	_, err := s.ServiceDep.Repo.PropertyRepository.New(s.ctx, draft)
+	s.Require().NoError(err, "Expected no error when creating a draft property")
+	defer func() {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, draft.PropertyID); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}()
+
+	params := s.params
+	params.Filter.Statuses = nil
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching properties")
+	for _, found := range result.Properties {
+		s.NotEqual(draft.PropertyID, found.ID, "Expected the draft to be hidden")
+	}
+
+	params.Filter.Statuses = []property.Status{property.Draft}
+	result, err = s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching draft properties")
+	s.Require().NotEmpty(result.Properties, "Expected the draft to be listed")
+	for _, found := range result.Properties {
+		s.Equal(property.Draft, found.Status, "Expected only drafts to be listed")
+	}
+}
+
+// TestSearchPropertiesFreeBetween tests that a property with booked dates is only listed when
+// it is free between the dates.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesFreeBetween() {
+	start := time.Now().AddDate(0, 1, 0)
+	propertyCalendar, err := s.ServiceDep.Repo.CalendarRepository.Get(s.ctx, s.newParams.PropertyID)
+	s.Require().NoError(err, "Expected no error when reading the calendar")
+	period, err := calendar.NewPeriod(start, start.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	s.Require().NoError(propertyCalendar.Block(calendar.DateRange{Period: period, Kind: calendar.Booked}))
+	s.Require().NoError(s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar))
+	defer func() {
+		propertyCalendar.Unblock(period)
+		if err := s.ServiceDep.Repo.CalendarRepository.Save(s.ctx, propertyCalendar); err != nil {
+			s.log.Error("Failed to free the dates after test", err)
+		}
+	}()
+
+	params := s.params
+	result, err := s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching properties")
+	s.Require().True(containsProperty(result.Properties, s.newParams.PropertyID), "Expected the property to be listed")
+
+	params.Filter.FreeFrom = start.AddDate(0, 0, 3)
+	params.Filter.FreeTo = start.AddDate(0, 0, 10)
+	result, err = s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching free properties")
+	s.False(containsProperty(result.Properties, s.newParams.PropertyID), "Expected the booked property to be filtered out")
+
+	params.Filter.FreeFrom = start.AddDate(0, 0, 7)
+	params.Filter.FreeTo = start.AddDate(0, 0, 14)
+	result, err = s.handler.Handle(s.ctx, params)
+	s.Require().NoError(err, "Expected no error when searching free properties")
+	s.NotEmpty(result.Properties, "Expected the property to be free after the booking")
+
+	params.Filter.FreeTo = time.Time{}
+	_, err = s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for a free period without an end")
+}
+
+// TestSearchPropertiesInvalidPriceRange tests that an inverted price range is rejected.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesInvalidPriceRange() {
+	params := s.params
+	params.Filter.AskingPrice = &property.PriceRange{Min: 200000, Max: 100000}
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an inverted price range")
+}
+
+// TestSearchPropertiesInvalidDateRange tests that an inverted date range is rejected.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesInvalidDateRange() {
+	params := s.params
+	params.Filter.AvailableFrom, params.Filter.AvailableTo = params.Filter.AvailableTo, params.Filter.AvailableFrom
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an inverted date range")
+}
+
+// TestSearchPropertiesTotalCount tests that the total count is returned when requested.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesTotalCount() {
+	params := s.params
+	params.TotalCount = true
+	result, err := s.handler.Handle(s.ctx, params)
+	s.NoError(err, "Expected no error when searching properties")
+	s.Require().NotNil(result.Page.TotalCount, "Expected the total count to be set")
+	s.GreaterOrEqual(*result.Page.TotalCount, int64(len(result.Properties)))
+}
+
+// TestSearchPropertiesPageTokenMismatch tests that a page token is rejected for another filter.
+func (s *SearchPropertiesTestSuite) TestSearchPropertiesPageTokenMismatch() {
+	pageToken, err := s.ServiceDep.Pages.Encode(pagination.Token{
+		Cursor:    "cursor",
+		Direction: pagination.After,
+		Sort:      "1",
+		Filter:    "another filter",
+	})
+	s.Require().NoError(err)
+	params := s.params
+	params.PaginationToken = pageToken
+	_, err = s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for a page token of another filter")
+
+	params.PaginationToken = pageToken + "x"
+	_, err = s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an altered page token")
+}
+
+func (s *SearchPropertiesTestSuite) TearDownSuite() {
+	// Clean up the test data
+	if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, s.newParams.PropertyID); err != nil {
+		s.log.Error("Failed to delete property after test", err)
+	}
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/decorator"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// SuggestPropertiesQuery : This is used to suggest locations and titles while a search is typed.
+type SuggestPropertiesQuery struct {
+	Prefix string `validate:"required"`
+	Limit  uint16 `validate:"required,max=50"` // Maximum number of suggestions per field.
+}
+
+// SuggestPropertiesHandler is a CQRS endpoint that handles a query to retrieve type-ahead suggestions.
+// It implements the QueryHandler interface for the SuggestPropertiesQuery.
+// The handler reads the distinct cities, counties, postcodes and titles starting with the prefix
+// from the database and returns them to the caller.
+type SuggestPropertiesHandler decorator.QueryHandler[SuggestPropertiesQuery, *SuggestPropertiesResult]
+
+type SuggestPropertiesHandlerImpl struct {
+	repository property.Repository
+	validator  *validator.Validate
+}
+
+// NewSuggestPropertiesHandler creates a new instance of SuggestPropertiesHandler,
+// applying decorators for logging and validation.
+func NewSuggestPropertiesHandler(
+	propRepo property.Repository,
+	logger log.Logger,
+	validator *validator.Validate,
+) SuggestPropertiesHandler {
+	if propRepo == nil {
+		panic("nil property repository")
+	}
+	return decorator.ApplyQueryDecorators(
+		SuggestPropertiesHandlerImpl{
+			repository: propRepo,
+			validator:  validator,
+		},
+		logger,
+		validator,
+	)
+}
+
+// Handler method takes a context and returns a SuggestPropertiesResult
+// and an error.
+func (guh SuggestPropertiesHandlerImpl) Handle(c context.Context, cmd SuggestPropertiesQuery,
+) (*SuggestPropertiesResult, error) {
+	suggestions, err := guh.repository.Suggest(c, cmd.Prefix, cmd.Limit)
+	if err != nil {
+		return nil, errors.NewHandlerError(
+			err,
+			codes.Internal,
+		)
+	}
+	return &SuggestPropertiesResult{
+		Suggestions: *suggestions,
+	}, nil
+}
+
+type SuggestPropertiesResult struct {
+	Suggestions property.Suggestions `json:"suggestions"`
+}
@@ -0,0 +1,117 @@
+//go:build cse
+// +build cse
+
+package query_test
+
+import (
+	"context"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/service"
+	"property-service/pkg/address"
+	"property-service/pkg/configs"
+	"property-service/pkg/infrastructure/database"
+	"property-service/pkg/infrastructure/log"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// SuggestPropertiesTestSuite is the test suite for the SuggestProperties query.
+type SuggestPropertiesTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	log        log.Logger
+	config     configs.Config
+	validator  *validator.Validate
+	handler    query.SuggestPropertiesHandler
+	params     query.SuggestPropertiesQuery
+	newParams  []property.NewPropertyParams
+	ServiceDep service.Dependencies
+}
+
+// SetupSuite initializes the test suite.
+func (s *SuggestPropertiesTestSuite) SetupSuite() {
+	// Initialize the query handler
+	s.handler = query.NewSuggestPropertiesHandler(
+		s.ServiceDep.Repo.PropertyRepository,
+		s.log,
+		s.validator,
+	)
+	// Two properties in the same city, written in a different case.
+	for _, city := range []string{"Xewkija", "XEWKIJA"} {
+		params := property.NewPropertyParams{
+			PropertyID: database.NewStringID(),
+			OwnerID:    database.NewStringID(),
+			Address: address.Address{
+				FirstLine:  "7",
+				Street:     "Triq il-Knisja",
+				City:       city,
+				County:     "Gozo",
+				Country:    "Malta",
+				PostalCode: "XWK1010",
+				GeoJSON: &address.GeoJSONCoordinates{
+					Type:        "Point",
+					Coordinates: [2]float64{14.2592, 36.0326},
+				},
+			},
+			Description:   "A house of character",
+			Title:         "Xewkija House of Character",
+			Category:      "House",
+			Status:        property.Published,
+			AvailableDate: time.Now(),
+			SaleType:      1,
+		}
+		if _, err := s.ServiceDep.Repo.PropertyRepository.New(
+			s.ctx,
+			params,
+		); err != nil {
+			s.Fail("Failed to create property for testing", err)
+		}
+		s.newParams = append(s.newParams, params)
+	}
+	s.params = query.SuggestPropertiesQuery{
+		Prefix: "xewk",
+		Limit:  5,
+	}
+}
+
+// TestSuggestPropertiesHandler tests that the matching values are suggested once per field.
+func (s *SuggestPropertiesTestSuite) TestSuggestPropertiesHandler() {
+	result, err := s.handler.Handle(s.ctx, s.params)
+	s.NoError(err, "Expected no error when suggesting properties")
+	cities := 0
+	for _, city := range result.Suggestions.Cities {
+		if strings.EqualFold(city, "Xewkija") {
+			cities++
+		}
+	}
+	s.Equal(1, cities, "Expected the city to be suggested once")
+	s.Contains(result.Suggestions.Postcodes, "XWK1010")
+	s.Contains(result.Suggestions.Titles, "Xewkija House of Character")
+	s.LessOrEqual(len(result.Suggestions.Titles), int(s.params.Limit))
+}
+
+// TestSuggestPropertiesInvalid tests that an empty prefix and a missing limit are rejected.
+func (s *SuggestPropertiesTestSuite) TestSuggestPropertiesInvalid() {
+	params := s.params
+	params.Prefix = ""
+	_, err := s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for an empty prefix")
+
+	params = s.params
+	params.Limit = 0
+	_, err = s.handler.Handle(s.ctx, params)
+	s.Error(err, "Expected an error for a missing limit")
+}
+
+func (s *SuggestPropertiesTestSuite) TearDownSuite() {
+	// Clean up the test data
+	for _, params := range s.newParams {
+		if err := s.ServiceDep.Repo.PropertyRepository.Delete(s.ctx, params.PropertyID); err != nil {
+			s.log.Error("Failed to delete property after test", err)
+		}
+	}
+}
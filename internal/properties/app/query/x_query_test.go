@@ -7,7 +7,9 @@ import (
 	"context"
 	"testing"
 
+	"property-service/internal/properties/domain/property"
 	"property-service/internal/properties/service"
+	"property-service/pkg/address"
 	"property-service/pkg/configs"
 	"property-service/pkg/infrastructure/log"
 
@@ -16,6 +18,25 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+// testGeocoder resolves the locations of testdata/postcodes.csv without network access.
+var testGeocoder = func() address.Geocoder {
+	geocoder, err := address.NewOfflineGeocoderClient("testdata/postcodes.csv")
+	if err != nil {
+		panic("can not load the test geocoder dataset " + err.Error())
+	}
+	return geocoder
+}()
+
+// containsProperty reports whether the property with the ID is listed.
+func containsProperty(properties []property.Property, id string) bool {
+	for _, found := range properties {
+		if found.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func TestQueryTestSuite(t *testing.T) {
 	// Load env from file.
 	envLoadingError := godotenv.Load("../../../../dev.env")
@@ -50,4 +71,94 @@ func TestQueryTestSuite(t *testing.T) {
 		ctx:        context.Background(),
 		ServiceDep: s,
 	})
+	suite.Run(t, &ListPropertiesNearTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ListSimilarPropertiesTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ListPropertiesWithinAreaTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ListPropertiesWithinCommuteTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &SearchPropertiesTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &SearchPropertiesByTextTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &GetPropertyFacetsTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &SuggestPropertiesTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &GetAvailabilityTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ListViewingSlotsTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ListOffersTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ClusterPropertiesTestSuite{
+		log:        log,
+		config:     config,
+		validator:  v,
+		ctx:        context.Background(),
+		ServiceDep: s,
+	})
+	suite.Run(t, &ReverseGeocodeTestSuite{
+		log:       log,
+		config:    config,
+		validator: v,
+		ctx:       context.Background(),
+	})
 }
@@ -0,0 +1,47 @@
+package area
+
+import (
+	"strings"
+	"unicode"
+
+	"property-service/pkg/address"
+	"property-service/pkg/helper/factory"
+)
+
+const (
+	// Factory Config Constants.
+	MaxSchemaVersion = 9999
+)
+
+type Factory[DatabaseID any] interface {
+	New(
+		area NewAreaParams,
+	) (*Area, error)
+	validate(a *Area) error
+	factory.Factory[Area, Model[DatabaseID]]
+}
+
+// FactoryConfig is a struct for configuring the factory.
+type FactoryConfig struct {
+	SchemaVersion int
+}
+
+// Validate validates the factory configuration and returns an error if it is invalid.
+func (p FactoryConfig) Validate() error {
+	return nil
+}
+
+type NewAreaParams struct {
+	Name     string                      `validate:"required"`
+	Kind     string                      `validate:"omitempty"`
+	Boundary address.GeoJSONMultiPolygon `validate:"required"`
+}
+
+// Key returns the key an area is identified and properties are tagged by, its name in lower
+// case with the words joined by hyphens, e.g. "Tower Hamlets" is "tower-hamlets".
+func Key(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
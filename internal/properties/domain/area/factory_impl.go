@@ -0,0 +1,108 @@
+package area
+
+import (
+	"time"
+
+	"property-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var _ Factory[any] = (*FactoryImpl[any])(nil)
+
+// Factory is a struct that creates and validates the model.
+type FactoryImpl[databaseID comparable] struct {
+	NewID             func() string
+	mapToDomainFunc   func(databaseID) (string, error)
+	mapToDatabaseFunc func(string) (databaseID, error)
+	mapToDomain       func(mapper func(databaseID) (string, error), his Model[databaseID]) (*Area, error)
+	mapToDatabase     func(mapper func(string) (databaseID, error), his Area) (*Model[databaseID], error)
+	v                 *validator.Validate // validator used for validating the factory configuration
+	fc                FactoryConfig       // configuration for the factory
+}
+
+// NewFactory creates a new Factory with the given configuration and returns an error if the configuration is invalid.
+func NewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Area, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Area) (*Model[databaseID], error),
+) (FactoryImpl[databaseID], error) {
+	if err := fc.Validate(); err != nil {
+		return FactoryImpl[databaseID]{}, errors.Join(err, errors.ErrInvalidConfigFactory)
+	}
+	return FactoryImpl[databaseID]{
+		fc:                fc,
+		v:                 v,
+		NewID:             newID,
+		mapToDomainFunc:   mappingFunc,
+		mapToDomain:       mapHistory,
+		mapToDatabase:     mapToDatabase,
+		mapToDatabaseFunc: mapToDatabaseFunc,
+	}, nil
+}
+
+// MustNewFactory creates a new Factory with the given configuration and panics if the configuration is invalid.
+func MustNewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Area, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Area) (*Model[databaseID], error),
+) FactoryImpl[databaseID] {
+	f, err := NewFactory[databaseID](fc, v, newID, mappingFunc, mapHistory, mapToDatabaseFunc, mapToDatabase)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Config returns the configuration for the factory.
+func (fi FactoryImpl[databaseID]) Config() FactoryConfig {
+	return fi.fc
+}
+
+func (fi FactoryImpl[databaseID]) validate(a *Area) error {
+	if err := fi.v.Struct(a); err != nil {
+		return err
+	}
+	return a.Boundary.Validate()
+}
+
+func (fi FactoryImpl[databaseID]) New(
+	area NewAreaParams,
+) (*Area, error) {
+	areaModel := &Area{
+		ID:       fi.NewID(),
+		Key:      Key(area.Name),
+		Name:     area.Name,
+		Kind:     area.Kind,
+		Boundary: area.Boundary,
+		Metadata: Metadata{
+			createdAt: time.Now(),
+			updatedAt: time.Time{},
+		},
+	}
+	return areaModel, fi.validate(areaModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDomain(areaDatabaseModel Model[databaseID]) (*Area, error) {
+	areaDomainModel, err := fi.mapToDomain(fi.mapToDomainFunc, areaDatabaseModel)
+	if err != nil {
+		return nil, err
+	}
+	return areaDomainModel, fi.validate(areaDomainModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDatabase(areaDomainModel Area) (*Model[databaseID], error) {
+	validationErr := fi.validate(&areaDomainModel)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	areaDatabaseModel, err := fi.mapToDatabase(fi.mapToDatabaseFunc, areaDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	return areaDatabaseModel, nil
+}
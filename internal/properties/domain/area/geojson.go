@@ -0,0 +1,79 @@
+package area
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"property-service/pkg/address"
+	"property-service/pkg/errors"
+)
+
+var (
+	// ErrInvalidFeatureCollection : The areas to import are not a GeoJSON FeatureCollection.
+	ErrInvalidFeatureCollection = errors.NewSimple("invalid GeoJSON FeatureCollection")
+	// ErrAreaNotFound : There is no area with the key.
+	ErrAreaNotFound = errors.NewSimple("area not found")
+)
+
+// DefaultNameProperty is the feature property an area is named by when none is given.
+const DefaultNameProperty = "name"
+
+// featureCollection is the part of a GeoJSON FeatureCollection read as areas.
+type featureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"features"`
+}
+
+// ParseFeatureCollection reads the Polygon and MultiPolygon features of a GeoJSON
+// FeatureCollection as areas of the kind, named by their nameProperty.
+func ParseFeatureCollection(data []byte, nameProperty string, kind string) ([]NewAreaParams, error) {
+	if nameProperty == "" {
+		nameProperty = DefaultNameProperty
+	}
+	var collection featureCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFeatureCollection, err)
+	}
+	if collection.Type != "FeatureCollection" || len(collection.Features) == 0 {
+		return nil, fmt.Errorf("%w: no features", ErrInvalidFeatureCollection)
+	}
+
+	areas := make([]NewAreaParams, 0, len(collection.Features))
+	for i, feature := range collection.Features {
+		name, _ := feature.Properties[nameProperty].(string)
+		if name = strings.TrimSpace(name); Key(name) == "" {
+			return nil, fmt.Errorf("%w: feature %d has no %q property", ErrInvalidFeatureCollection, i, nameProperty)
+		}
+		boundary := address.GeoJSONMultiPolygon{Type: "MultiPolygon"}
+		var err error
+		switch feature.Geometry.Type {
+		case "Polygon":
+			var rings [][][2]float64
+			err = json.Unmarshal(feature.Geometry.Coordinates, &rings)
+			boundary.Coordinates = [][][][2]float64{rings}
+		case "MultiPolygon":
+			err = json.Unmarshal(feature.Geometry.Coordinates, &boundary.Coordinates)
+		default:
+			return nil, fmt.Errorf("%w: feature %q is not a polygon", ErrInvalidFeatureCollection, name)
+		}
+		if err == nil {
+			err = boundary.Validate()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: feature %q: %v", ErrInvalidFeatureCollection, name, err)
+		}
+		areas = append(areas, NewAreaParams{
+			Name:     name,
+			Kind:     kind,
+			Boundary: boundary,
+		})
+	}
+	return areas, nil
+}
@@ -0,0 +1,91 @@
+package area
+
+import (
+	"time"
+
+	"property-service/pkg/address"
+)
+
+type Model[ID any] struct {
+	ID       ID                          `bson:"_id" validate:"required"`
+	Key      string                      `bson:"Key" validate:"required"`
+	Name     string                      `bson:"Name" validate:"required"`
+	Kind     string                      `bson:"Kind" validate:"omitempty"`
+	Boundary address.GeoJSONMultiPolygon `bson:"Boundary" validate:"required"`
+	Metadata MetadataModel               `bson:"Metadata" validate:"required"`
+}
+
+type MetadataModel struct {
+	CreatedAt time.Time `bson:"CreatedAt"`
+	UpdatedAt time.Time `bson:"UpdatedAt"`
+}
+
+func MapModelToArea[Old any](
+	mappingFunc func(Old) (string, error),
+	oldArea Model[Old],
+) (*Area, error) {
+	// Map IDs
+	areaID, err := mappingFunc(oldArea.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Area{
+		ID:       areaID,
+		Key:      oldArea.Key,
+		Name:     oldArea.Name,
+		Kind:     oldArea.Kind,
+		Boundary: oldArea.Boundary,
+		Metadata: Metadata{
+			createdAt: oldArea.Metadata.CreatedAt,
+			updatedAt: oldArea.Metadata.UpdatedAt,
+		},
+	}, nil
+}
+
+// Area : This domain model contains a named area, e.g. a neighbourhood or district, that the
+// properties inside its boundary are tagged with by its key.
+type Area struct {
+	ID       string                      `json:"id" validate:"required"`
+	Key      string                      `json:"key" validate:"required"`
+	Name     string                      `json:"name" validate:"required"`
+	Kind     string                      `json:"kind,omitempty" validate:"omitempty"`
+	Boundary address.GeoJSONMultiPolygon `json:"boundary" validate:"required"`
+	Metadata Metadata                    `json:"metadata" validate:"required"`
+}
+
+type Metadata struct {
+	createdAt time.Time `bson:"CreatedAt"`
+	updatedAt time.Time `bson:"UpdatedAt"`
+}
+
+// CreatedAt : returns when the area was imported.
+func (m Metadata) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// UpdatedAt : returns when the area was last imported again.
+func (m Metadata) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+func MapAreaToModel[New any](
+	mappingFunc func(string) (New, error),
+	oldArea Area,
+) (*Model[New], error) {
+	// Map IDs
+	areaID, err := mappingFunc(oldArea.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Model[New]{
+		ID:       areaID,
+		Key:      oldArea.Key,
+		Name:     oldArea.Name,
+		Kind:     oldArea.Kind,
+		Boundary: oldArea.Boundary,
+		Metadata: MetadataModel{
+			CreatedAt: oldArea.Metadata.createdAt,
+			UpdatedAt: oldArea.Metadata.updatedAt,
+		},
+	}, nil
+}
@@ -0,0 +1,18 @@
+package area
+
+import (
+	"context"
+
+	"property-service/pkg/address"
+)
+
+// Repository :  handles all the database actions for the named areas.
+type Repository interface {
+	// Save : creates the area or replaces the name, kind and boundary of the area with the
+	// same key, changed reports whether the boundary is new or has changed.
+	Save(c context.Context, params NewAreaParams) (area *Area, changed bool, err error)
+	// GetByKey : returns a single area by its key.
+	GetByKey(c context.Context, key string) (*Area, error)
+	// ListContaining : returns the areas whose boundary contains the point, by key.
+	ListContaining(c context.Context, point address.GeoJSONCoordinates) ([]Area, error)
+}
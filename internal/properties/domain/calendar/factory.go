@@ -0,0 +1,32 @@
+package calendar
+
+import (
+	"property-service/pkg/helper/factory"
+)
+
+const (
+	// Factory Config Constants.
+	MaxSchemaVersion = 9999
+)
+
+type Factory[DatabaseID any] interface {
+	New(
+		calendar NewCalendarParams,
+	) (*Calendar, error)
+	validate(c *Calendar) error
+	factory.Factory[Calendar, Model[DatabaseID]]
+}
+
+// FactoryConfig is a struct for configuring the factory.
+type FactoryConfig struct {
+	SchemaVersion int
+}
+
+// Validate validates the factory configuration and returns an error if it is invalid.
+func (p FactoryConfig) Validate() error {
+	return nil
+}
+
+type NewCalendarParams struct {
+	PropertyID string `validate:"required"`
+}
@@ -0,0 +1,106 @@
+package calendar
+
+import (
+	"time"
+
+	"property-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var _ Factory[any] = (*FactoryImpl[any])(nil)
+
+// Factory is a struct that creates and validates the model.
+type FactoryImpl[databaseID comparable] struct {
+	NewID             func() string
+	mapToDomainFunc   func(databaseID) (string, error)
+	mapToDatabaseFunc func(string) (databaseID, error)
+	mapToDomain       func(mapper func(databaseID) (string, error), his Model[databaseID]) (*Calendar, error)
+	mapToDatabase     func(mapper func(string) (databaseID, error), his Calendar) (*Model[databaseID], error)
+	v                 *validator.Validate // validator used for validating the factory configuration
+	fc                FactoryConfig       // configuration for the factory
+}
+
+// NewFactory creates a new Factory with the given configuration and returns an error if the configuration is invalid.
+func NewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Calendar, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Calendar) (*Model[databaseID], error),
+) (FactoryImpl[databaseID], error) {
+	if err := fc.Validate(); err != nil {
+		return FactoryImpl[databaseID]{}, errors.Join(err, errors.ErrInvalidConfigFactory)
+	}
+	return FactoryImpl[databaseID]{
+		fc:                fc,
+		v:                 v,
+		NewID:             newID,
+		mapToDomainFunc:   mappingFunc,
+		mapToDomain:       mapHistory,
+		mapToDatabase:     mapToDatabase,
+		mapToDatabaseFunc: mapToDatabaseFunc,
+	}, nil
+}
+
+// MustNewFactory creates a new Factory with the given configuration and panics if the configuration is invalid.
+func MustNewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Calendar, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Calendar) (*Model[databaseID], error),
+) FactoryImpl[databaseID] {
+	f, err := NewFactory[databaseID](fc, v, newID, mappingFunc, mapHistory, mapToDatabaseFunc, mapToDatabase)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Config returns the configuration for the factory.
+func (fi FactoryImpl[databaseID]) Config() FactoryConfig {
+	return fi.fc
+}
+
+func (fi FactoryImpl[databaseID]) validate(c *Calendar) error {
+	return fi.v.Struct(c)
+}
+
+// New returns an empty calendar of the property, it is stored the first time dates are
+// blocked.
+func (fi FactoryImpl[databaseID]) New(
+	calendar NewCalendarParams,
+) (*Calendar, error) {
+	now := time.Now()
+	calendarModel := &Calendar{
+		ID:         fi.NewID(),
+		PropertyID: calendar.PropertyID,
+		Ranges:     []DateRange{},
+		Metadata: Metadata{
+			createdAt: now,
+			updatedAt: now,
+		},
+	}
+	return calendarModel, fi.validate(calendarModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDomain(calendarDatabaseModel Model[databaseID]) (*Calendar, error) {
+	calendarDomainModel, err := fi.mapToDomain(fi.mapToDomainFunc, calendarDatabaseModel)
+	if err != nil {
+		return nil, err
+	}
+	return calendarDomainModel, fi.validate(calendarDomainModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDatabase(calendarDomainModel Calendar) (*Model[databaseID], error) {
+	validationErr := fi.validate(&calendarDomainModel)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	calendarDatabaseModel, err := fi.mapToDatabase(fi.mapToDatabaseFunc, calendarDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	return calendarDatabaseModel, nil
+}
@@ -0,0 +1,162 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+
+	"property-service/pkg/errors"
+)
+
+// Kind : why the dates of a range are unavailable.
+type Kind uint8
+
+const (
+	UnknownKind Kind = iota // 0: unknown
+	Blocked                 // 1: blocked by the owner, e.g. for maintenance
+	Booked                  // 2: booked by a tenant
+)
+
+// DateRange : a period of a calendar whose dates are unavailable.
+type DateRange struct {
+	Period `bson:",inline"`
+	Kind   Kind   `bson:"Kind" json:"kind" validate:"oneof=1 2"`
+	Note   string `bson:"Note,omitempty" json:"note,omitempty" validate:"omitempty,max=200"`
+}
+
+type Model[ID any] struct {
+	ID         ID            `bson:"_id" validate:"required"`
+	PropertyID string        `bson:"PropertyID" validate:"required"`
+	Ranges     []DateRange   `bson:"Ranges" validate:"omitempty,dive"`
+	Version    uint32        `bson:"Version"`
+	Metadata   MetadataModel `bson:"Metadata" validate:"required"`
+}
+
+type MetadataModel struct {
+	CreatedAt time.Time `bson:"CreatedAt"`
+	UpdatedAt time.Time `bson:"UpdatedAt"`
+}
+
+func MapModelToCalendar[Old any](
+	mappingFunc func(Old) (string, error),
+	oldCalendar Model[Old],
+) (*Calendar, error) {
+	// Map IDs
+	calendarID, err := mappingFunc(oldCalendar.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Calendar{
+		ID:         calendarID,
+		PropertyID: oldCalendar.PropertyID,
+		Ranges:     oldCalendar.Ranges,
+		Version:    oldCalendar.Version,
+		Metadata: Metadata{
+			createdAt: oldCalendar.Metadata.CreatedAt,
+			updatedAt: oldCalendar.Metadata.UpdatedAt,
+		},
+	}, nil
+}
+
+// Calendar : This domain model contains the availability calendar of a property, the ranges
+// of dates that are blocked by the owner or booked. The ranges are sorted by their start and
+// never overlap.
+type Calendar struct {
+	ID         string      `json:"id" validate:"required"`
+	PropertyID string      `json:"propertyId" validate:"required"`
+	Ranges     []DateRange `json:"ranges" validate:"omitempty,dive"`
+	Version    uint32      `json:"version"` // Incremented every time the calendar is stored.
+	Metadata   Metadata    `json:"metadata" validate:"required"`
+}
+
+type Metadata struct {
+	createdAt time.Time `bson:"CreatedAt"`
+	updatedAt time.Time `bson:"UpdatedAt"`
+}
+
+// CreatedAt : returns when the calendar was created.
+func (m Metadata) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// UpdatedAt : returns when the calendar was last stored.
+func (m Metadata) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+// Block adds a range to the calendar, it fails with ErrOverlap when any of its dates is
+// already blocked or booked.
+func (c *Calendar) Block(dateRange DateRange) error {
+	for _, existing := range c.Ranges {
+		if existing.Overlaps(dateRange.Period) {
+			return errors.Join(ErrOverlap, errors.NewSimple(existing.String()))
+		}
+	}
+	c.Ranges = append(c.Ranges, dateRange)
+	sort.Slice(c.Ranges, func(a, b int) bool {
+		return c.Ranges[a].Start.Before(c.Ranges[b].Start)
+	})
+	return nil
+}
+
+// Unblock frees the dates of the period, the ranges partly within it are shortened or split.
+// It reports whether any date was freed.
+func (c *Calendar) Unblock(period Period) bool {
+	ranges := make([]DateRange, 0, len(c.Ranges)+1)
+	changed := false
+	for _, existing := range c.Ranges {
+		if !existing.Overlaps(period) {
+			ranges = append(ranges, existing)
+			continue
+		}
+		changed = true
+		if existing.Start.Before(period.Start) {
+			before := existing
+			before.End = period.Start
+			ranges = append(ranges, before)
+		}
+		if existing.End.After(period.End) {
+			after := existing
+			after.Start = period.End
+			ranges = append(ranges, after)
+		}
+	}
+	c.Ranges = ranges
+	return changed
+}
+
+// Between returns the ranges with a date in the period.
+func (c Calendar) Between(period Period) []DateRange {
+	ranges := make([]DateRange, 0)
+	for _, existing := range c.Ranges {
+		if existing.Overlaps(period) {
+			ranges = append(ranges, existing)
+		}
+	}
+	return ranges
+}
+
+// IsAvailable reports whether no date of the period is blocked or booked.
+func (c Calendar) IsAvailable(period Period) bool {
+	return len(c.Between(period)) == 0
+}
+
+func MapCalendarToModel[New any](
+	mappingFunc func(string) (New, error),
+	oldCalendar Calendar,
+) (*Model[New], error) {
+	// Map IDs
+	calendarID, err := mappingFunc(oldCalendar.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Model[New]{
+		ID:         calendarID,
+		PropertyID: oldCalendar.PropertyID,
+		Ranges:     oldCalendar.Ranges,
+		Version:    oldCalendar.Version,
+		Metadata: MetadataModel{
+			CreatedAt: oldCalendar.Metadata.createdAt,
+			UpdatedAt: oldCalendar.Metadata.updatedAt,
+		},
+	}, nil
+}
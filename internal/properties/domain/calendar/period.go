@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"time"
+
+	"property-service/pkg/errors"
+)
+
+// MaxPeriod is the longest period that is blocked or looked up at once.
+const MaxPeriod = 3 * 366 * 24 * time.Hour
+
+var (
+	// ErrEmptyPeriod : the end of a period is not after its start.
+	ErrEmptyPeriod = errors.NewSimple("the end of the period must be after its start")
+	// ErrPeriodTooLong : a period is longer than MaxPeriod.
+	ErrPeriodTooLong = errors.NewSimple("the period is too long")
+	// ErrOverlap : a range overlaps a range already in the calendar.
+	ErrOverlap = errors.NewSimple("the dates overlap blocked or booked dates")
+)
+
+// Period : the days from Start up to but excluding End, e.g. the nights of a let, both at
+// midnight UTC.
+type Period struct {
+	Start time.Time `bson:"Start" json:"start" validate:"required"`
+	End   time.Time `bson:"End" json:"end" validate:"required,gtfield=Start"`
+}
+
+// NewPeriod returns the period of the days of start up to but excluding the day of end.
+func NewPeriod(start time.Time, end time.Time) (Period, error) {
+	period := Period{Start: day(start), End: day(end)}
+	if !period.End.After(period.Start) {
+		return Period{}, ErrEmptyPeriod
+	}
+	if period.End.Sub(period.Start) > MaxPeriod {
+		return Period{}, ErrPeriodTooLong
+	}
+	return period, nil
+}
+
+// day returns the midnight UTC of the date of t.
+func day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Overlaps reports whether the periods have a day in common.
+func (p Period) Overlaps(other Period) bool {
+	return p.Start.Before(other.End) && other.Start.Before(p.End)
+}
+
+// Days returns the number of days of the period.
+func (p Period) Days() int {
+	return int(p.End.Sub(p.Start).Hours() / 24)
+}
+
+// String returns the period as its first and its excluded last date.
+func (p Period) String() string {
+	return p.Start.Format(time.DateOnly) + " to " + p.End.Format(time.DateOnly)
+}
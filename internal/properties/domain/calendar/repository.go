@@ -0,0 +1,30 @@
+package calendar
+
+import (
+	"context"
+
+	"property-service/pkg/errors"
+)
+
+// ErrCalendarChanged : the calendar was stored by another request since it was read.
+var ErrCalendarChanged = errors.NewSimple("availability calendar changed concurrently")
+
+// ErrTooManyUnavailable : more than MaxUnavailable properties have a blocked or booked date in
+// the period.
+var ErrTooManyUnavailable = errors.NewSimple("too many properties are unavailable in the period, narrow it")
+
+// MaxUnavailable is the largest number of unavailable properties a list excludes, the IDs are
+// sent with the list query.
+const MaxUnavailable = 5000
+
+// Repository :  handles all the database actions for the availability calendars.
+type Repository interface {
+	// Get : returns the calendar of a property, an empty calendar when none is stored.
+	Get(c context.Context, propertyID string) (*Calendar, error)
+	// Save : stores a calendar read by Get and increments its version, it fails with
+	// ErrCalendarChanged when the stored calendar is no longer the version that was read.
+	Save(c context.Context, calendar *Calendar) error
+	// ListUnavailable : returns the IDs of the properties with a blocked or booked date in
+	// the period, it fails with ErrTooManyUnavailable when there are more than MaxUnavailable.
+	ListUnavailable(c context.Context, period Period) ([]string, error)
+}
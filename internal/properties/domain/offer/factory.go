@@ -0,0 +1,39 @@
+package offer
+
+import (
+	"property-service/pkg/helper/factory"
+	"property-service/pkg/money"
+)
+
+const (
+	// Factory Config Constants.
+	MaxSchemaVersion = 9999
+)
+
+type Factory[DatabaseID any] interface {
+	New(
+		offer NewOfferParams,
+	) (*Offer, error)
+	validate(o *Offer) error
+	factory.Factory[Offer, Model[DatabaseID]]
+}
+
+// FactoryConfig is a struct for configuring the factory.
+type FactoryConfig struct {
+	SchemaVersion int
+}
+
+// Validate validates the factory configuration and returns an error if it is invalid.
+func (p FactoryConfig) Validate() error {
+	return nil
+}
+
+type NewOfferParams struct {
+	OfferID    string      // A new ID is generated when empty.
+	PropertyID string      `validate:"required"`
+	Kind       Kind        `validate:"oneof=1 2"`
+	Applicant  Applicant   `validate:"required"`
+	Amount     money.Money `validate:"required"`
+	Conditions []string
+	Message    string
+}
@@ -0,0 +1,115 @@
+package offer
+
+import (
+	"time"
+
+	"property-service/pkg/errors"
+	"property-service/pkg/money"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var _ Factory[any] = (*FactoryImpl[any])(nil)
+
+// Factory is a struct that creates and validates the model.
+type FactoryImpl[databaseID comparable] struct {
+	NewID             func() string
+	mapToDomainFunc   func(databaseID) (string, error)
+	mapToDatabaseFunc func(string) (databaseID, error)
+	mapToDomain       func(mapper func(databaseID) (string, error), his Model[databaseID]) (*Offer, error)
+	mapToDatabase     func(mapper func(string) (databaseID, error), his Offer) (*Model[databaseID], error)
+	v                 *validator.Validate // validator used for validating the factory configuration
+	fc                FactoryConfig       // configuration for the factory
+}
+
+// NewFactory creates a new Factory with the given configuration and returns an error if the configuration is invalid.
+func NewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Offer, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Offer) (*Model[databaseID], error),
+) (FactoryImpl[databaseID], error) {
+	if err := fc.Validate(); err != nil {
+		return FactoryImpl[databaseID]{}, errors.Join(err, errors.ErrInvalidConfigFactory)
+	}
+	return FactoryImpl[databaseID]{
+		fc:                fc,
+		v:                 v,
+		NewID:             newID,
+		mapToDomainFunc:   mappingFunc,
+		mapToDomain:       mapHistory,
+		mapToDatabase:     mapToDatabase,
+		mapToDatabaseFunc: mapToDatabaseFunc,
+	}, nil
+}
+
+// MustNewFactory creates a new Factory with the given configuration and panics if the configuration is invalid.
+func MustNewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Offer, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Offer) (*Model[databaseID], error),
+) FactoryImpl[databaseID] {
+	f, err := NewFactory[databaseID](fc, v, newID, mappingFunc, mapHistory, mapToDatabaseFunc, mapToDatabase)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Config returns the configuration for the factory.
+func (fi FactoryImpl[databaseID]) Config() FactoryConfig {
+	return fi.fc
+}
+
+func (fi FactoryImpl[databaseID]) validate(o *Offer) error {
+	return fi.v.Struct(o)
+}
+
+// New returns a pending offer on the property, an offer without an ID is given a new one.
+func (fi FactoryImpl[databaseID]) New(
+	offer NewOfferParams,
+) (*Offer, error) {
+	now := time.Now()
+	id := offer.OfferID
+	if id == "" {
+		id = fi.NewID()
+	}
+	offerModel := &Offer{
+		ID:         id,
+		PropertyID: offer.PropertyID,
+		Kind:       offer.Kind,
+		Applicant:  offer.Applicant,
+		Amount:     money.New(offer.Amount.Amount, offer.Amount.Currency),
+		Conditions: offer.Conditions,
+		Message:    offer.Message,
+		Status:     Pending,
+		Metadata: Metadata{
+			createdAt: now,
+			updatedAt: now,
+		},
+	}
+	return offerModel, fi.validate(offerModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDomain(offerDatabaseModel Model[databaseID]) (*Offer, error) {
+	offerDomainModel, err := fi.mapToDomain(fi.mapToDomainFunc, offerDatabaseModel)
+	if err != nil {
+		return nil, err
+	}
+	return offerDomainModel, fi.validate(offerDomainModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDatabase(offerDomainModel Offer) (*Model[databaseID], error) {
+	validationErr := fi.validate(&offerDomainModel)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	offerDatabaseModel, err := fi.mapToDatabase(fi.mapToDatabaseFunc, offerDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	return offerDatabaseModel, nil
+}
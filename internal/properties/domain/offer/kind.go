@@ -0,0 +1,51 @@
+package offer
+
+import (
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+	"property-service/pkg/money"
+)
+
+var (
+	// ErrKindNotForSaleType : an offer to buy a property not for sale or an application to
+	// rent a property not for rent.
+	ErrKindNotForSaleType = errors.NewSimple("offer kind does not match the sale type of the listing")
+	// ErrCurrencyMismatch : the amount is not in the currency of the listing price.
+	ErrCurrencyMismatch = errors.NewSimple("offer currency does not match the listing price")
+	// ErrListingNotAvailable : the listing is not published so it takes no offers.
+	ErrListingNotAvailable = errors.NewSimple("listing is not available for offers")
+)
+
+// Kind : whether a property is offered on or applied for.
+type Kind uint8
+
+const (
+	UnknownKind Kind = iota // 0: unknown
+	Purchase                // 1: an offer to buy a property for sale
+	Rental                  // 2: an application to rent a property for rent
+)
+
+// ValidateListing checks that an offer of the kind and amount can be made on the listing, it
+// must be published, for sale to be bought or for rent to be rented, and the amount in the
+// currency of its asking price or rent.
+func ValidateListing(kind Kind, amount money.Money, listing property.Property) error {
+	if listing.Status != property.Published {
+		return errors.Join(ErrListingNotAvailable, errors.NewSimple(listing.Status.String()))
+	}
+	saleType := property.SaleType(listing.SaleType)
+	var price *money.Money
+	switch {
+	case kind == Purchase && (saleType == property.ForSale || saleType == property.ForBoth):
+		price = listing.AskingPrice
+	case kind == Rental && (saleType == property.ForRent || saleType == property.ForBoth):
+		if listing.Rent != nil {
+			price = &listing.Rent.Price
+		}
+	default:
+		return ErrKindNotForSaleType
+	}
+	if price != nil && price.Currency != money.NormaliseCurrency(amount.Currency) {
+		return errors.Join(ErrCurrencyMismatch, errors.NewSimple(price.Currency))
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package offer
+
+import (
+	"time"
+
+	"property-service/pkg/money"
+)
+
+// Applicant : the prospective buyer or tenant who made an offer.
+type Applicant struct {
+	Name      string `bson:"Name" json:"name" validate:"required,lt=100"`
+	Email     string `bson:"Email" json:"email" validate:"required,email"`
+	Telephone string `bson:"Telephone,omitempty" json:"telephone,omitempty" validate:"omitempty,gte=7,lte=15"`
+}
+
+// Counter : the owner's answer to an offer with the amount they would accept.
+type Counter struct {
+	Amount  money.Money `bson:"Amount" json:"amount" validate:"required"`
+	Message string      `bson:"Message,omitempty" json:"message,omitempty" validate:"omitempty,max=2000"`
+}
+
+type Model[ID any] struct {
+	ID         ID            `bson:"_id" validate:"required"`
+	PropertyID string        `bson:"PropertyID" validate:"required"`
+	Kind       Kind          `bson:"Kind" validate:"oneof=1 2"`
+	Applicant  Applicant     `bson:"Applicant" validate:"required"`
+	Amount     money.Money   `bson:"Amount" validate:"required"`
+	Conditions []string      `bson:"Conditions,omitempty" validate:"omitempty,max=20,dive,max=500"`
+	Message    string        `bson:"Message,omitempty" validate:"omitempty,max=2000"`
+	Status     Status        `bson:"Status" validate:"required,lte=5"`
+	Counter    *Counter      `bson:"Counter,omitempty" validate:"omitempty"`
+	Metadata   MetadataModel `bson:"Metadata" validate:"required"`
+}
+
+type MetadataModel struct {
+	CreatedAt time.Time `bson:"CreatedAt"`
+	UpdatedAt time.Time `bson:"UpdatedAt"`
+}
+
+func MapModelToOffer[Old any](
+	mappingFunc func(Old) (string, error),
+	oldOffer Model[Old],
+) (*Offer, error) {
+	// Map IDs
+	offerID, err := mappingFunc(oldOffer.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Offer{
+		ID:         offerID,
+		PropertyID: oldOffer.PropertyID,
+		Kind:       oldOffer.Kind,
+		Applicant:  oldOffer.Applicant,
+		Amount:     oldOffer.Amount,
+		Conditions: oldOffer.Conditions,
+		Message:    oldOffer.Message,
+		Status:     oldOffer.Status,
+		Counter:    oldOffer.Counter,
+		Metadata: Metadata{
+			createdAt: oldOffer.Metadata.CreatedAt,
+			updatedAt: oldOffer.Metadata.UpdatedAt,
+		},
+	}, nil
+}
+
+// Offer : This domain model contains an offer to buy or an application to rent a property,
+// with the amount offered, the conditions attached and the owner's answer.
+type Offer struct {
+	ID         string      `json:"id" validate:"required"`
+	PropertyID string      `json:"propertyId" validate:"required"`
+	Kind       Kind        `json:"kind" validate:"oneof=1 2"`
+	Applicant  Applicant   `json:"applicant" validate:"required"`
+	Amount     money.Money `json:"amount" validate:"required"` // The price offered, the rent per period of the listing for an application.
+	Conditions []string    `json:"conditions,omitempty" validate:"omitempty,max=20,dive,max=500"`
+	Message    string      `json:"message,omitempty" validate:"omitempty,max=2000"`
+	Status     Status      `json:"status" validate:"required,lte=5"`
+	Counter    *Counter    `json:"counter,omitempty" validate:"omitempty"` // Set when the owner countered.
+	Metadata   Metadata    `json:"metadata" validate:"required"`
+}
+
+type Metadata struct {
+	createdAt time.Time `bson:"CreatedAt"`
+	updatedAt time.Time `bson:"UpdatedAt"`
+}
+
+// CreatedAt : returns when the offer was submitted.
+func (m Metadata) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// UpdatedAt : returns when the offer was last answered.
+func (m Metadata) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+func MapOfferToModel[New any](
+	mappingFunc func(string) (New, error),
+	oldOffer Offer,
+) (*Model[New], error) {
+	// Map IDs
+	offerID, err := mappingFunc(oldOffer.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Model[New]{
+		ID:         offerID,
+		PropertyID: oldOffer.PropertyID,
+		Kind:       oldOffer.Kind,
+		Applicant:  oldOffer.Applicant,
+		Amount:     oldOffer.Amount,
+		Conditions: oldOffer.Conditions,
+		Message:    oldOffer.Message,
+		Status:     oldOffer.Status,
+		Counter:    oldOffer.Counter,
+		Metadata: MetadataModel{
+			CreatedAt: oldOffer.Metadata.createdAt,
+			UpdatedAt: oldOffer.Metadata.updatedAt,
+		},
+	}, nil
+}
@@ -0,0 +1,29 @@
+package offer
+
+import (
+	"context"
+
+	"property-service/pkg/errors"
+)
+
+// ErrNotFound : no offer has the ID.
+var ErrNotFound = errors.NewSimple("offer not found")
+
+// Repository :  handles all the database actions for the offers and applications.
+type Repository interface {
+	// New : submits a pending offer.
+	New(c context.Context, params NewOfferParams) (*Offer, error)
+	// Get : returns a single offer by its id.
+	Get(c context.Context, ID string) (*Offer, error)
+	// ListByProperty : returns the offers on the property in any of the statuses, the newest
+	// first. No statuses are every status.
+	ListByProperty(c context.Context, propertyID string, statuses []Status) ([]Offer, error)
+
+	// Respond : moves an offer from one status to another with the owner's counter offer,
+	// ErrOfferChanged is returned when it is no longer in the from status.
+	Respond(c context.Context, id string, from Status, to Status, counter *Counter) error
+	// Accept : accepts a pending offer, declines the other open offers on the property and
+	// moves the listing from published to under offer in a single transaction, nothing is
+	// changed when any of them fails.
+	Accept(c context.Context, offer Offer) error
+}
@@ -0,0 +1,60 @@
+package offer
+
+import (
+	"property-service/pkg/errors"
+)
+
+var (
+	// ErrIllegalResponse : the offer cannot move from its status to the requested one.
+	ErrIllegalResponse = errors.NewSimple("offer cannot be answered in its status")
+	// ErrOfferChanged : the status of the offer changed while it was being answered.
+	ErrOfferChanged = errors.NewSimple("offer status changed concurrently")
+)
+
+// Status : the stage of an offer or application.
+type Status uint8
+
+const (
+	UnknownStatus Status = iota // 0: unknown
+	Pending                     // 1: waiting for the owner
+	Accepted                    // 2: accepted by the owner, the listing is under offer
+	Rejected                    // 3: rejected by the owner
+	Countered                   // 4: answered with a counter offer, a new offer can be submitted
+	Declined                    // 5: declined because another offer was accepted
+)
+
+// statusNames are the names of the statuses as they are logged and returned in errors.
+var statusNames = map[Status]string{
+	UnknownStatus: "unknown",
+	Pending:       "pending",
+	Accepted:      "accepted",
+	Rejected:      "rejected",
+	Countered:     "countered",
+	Declined:      "declined",
+}
+
+// String returns the name of the status.
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return statusNames[UnknownStatus]
+}
+
+// Open reports whether the offer still waits for an answer, a countered offer stays open until
+// another offer is accepted.
+func (s Status) Open() bool {
+	return s == Pending || s == Countered
+}
+
+// OpenStatuses are the statuses declined when another offer for the property is accepted.
+var OpenStatuses = []Status{Pending, Countered}
+
+// ValidateResponse checks that the owner may answer an offer in the from status with the to
+// status, only a pending offer can be accepted, rejected or countered.
+func ValidateResponse(from Status, to Status) error {
+	if from != Pending || (to != Accepted && to != Rejected && to != Countered) {
+		return errors.Join(ErrIllegalResponse, errors.NewSimple(from.String()+" to "+to.String()))
+	}
+	return nil
+}
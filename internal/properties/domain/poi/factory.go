@@ -0,0 +1,47 @@
+package poi
+
+import (
+	"strings"
+	"unicode"
+
+	"property-service/pkg/address"
+	"property-service/pkg/helper/factory"
+)
+
+const (
+	// Factory Config Constants.
+	MaxSchemaVersion = 9999
+)
+
+type Factory[DatabaseID any] interface {
+	New(
+		poi NewPOIParams,
+	) (*POI, error)
+	validate(p *POI) error
+	factory.Factory[POI, Model[DatabaseID]]
+}
+
+// FactoryConfig is a struct for configuring the factory.
+type FactoryConfig struct {
+	SchemaVersion int
+}
+
+// Validate validates the factory configuration and returns an error if it is invalid.
+func (p FactoryConfig) Validate() error {
+	return nil
+}
+
+type NewPOIParams struct {
+	Type     string                     `validate:"required"`
+	Name     string                     `validate:"omitempty"`
+	Location address.GeoJSONCoordinates `validate:"required"`
+}
+
+// TypeKey returns the key the points of interest of a type are stored and filtered by, the
+// type in lower case with the words joined by hyphens, e.g. "Bus Stop" is "bus-stop".
+func TypeKey(kind string) string {
+	words := strings.FieldsFunc(strings.ToLower(kind), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
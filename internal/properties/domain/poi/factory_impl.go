@@ -0,0 +1,103 @@
+package poi
+
+import (
+	"time"
+
+	"property-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var _ Factory[any] = (*FactoryImpl[any])(nil)
+
+// Factory is a struct that creates and validates the model.
+type FactoryImpl[databaseID comparable] struct {
+	NewID             func() string
+	mapToDomainFunc   func(databaseID) (string, error)
+	mapToDatabaseFunc func(string) (databaseID, error)
+	mapToDomain       func(mapper func(databaseID) (string, error), his Model[databaseID]) (*POI, error)
+	mapToDatabase     func(mapper func(string) (databaseID, error), his POI) (*Model[databaseID], error)
+	v                 *validator.Validate // validator used for validating the factory configuration
+	fc                FactoryConfig       // configuration for the factory
+}
+
+// NewFactory creates a new Factory with the given configuration and returns an error if the configuration is invalid.
+func NewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*POI, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel POI) (*Model[databaseID], error),
+) (FactoryImpl[databaseID], error) {
+	if err := fc.Validate(); err != nil {
+		return FactoryImpl[databaseID]{}, errors.Join(err, errors.ErrInvalidConfigFactory)
+	}
+	return FactoryImpl[databaseID]{
+		fc:                fc,
+		v:                 v,
+		NewID:             newID,
+		mapToDomainFunc:   mappingFunc,
+		mapToDomain:       mapHistory,
+		mapToDatabase:     mapToDatabase,
+		mapToDatabaseFunc: mapToDatabaseFunc,
+	}, nil
+}
+
+// MustNewFactory creates a new Factory with the given configuration and panics if the configuration is invalid.
+func MustNewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*POI, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel POI) (*Model[databaseID], error),
+) FactoryImpl[databaseID] {
+	f, err := NewFactory[databaseID](fc, v, newID, mappingFunc, mapHistory, mapToDatabaseFunc, mapToDatabase)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Config returns the configuration for the factory.
+func (fi FactoryImpl[databaseID]) Config() FactoryConfig {
+	return fi.fc
+}
+
+func (fi FactoryImpl[databaseID]) validate(p *POI) error {
+	return fi.v.Struct(p)
+}
+
+func (fi FactoryImpl[databaseID]) New(
+	poi NewPOIParams,
+) (*POI, error) {
+	poiModel := &POI{
+		ID:       fi.NewID(),
+		Type:     TypeKey(poi.Type),
+		Name:     poi.Name,
+		Location: poi.Location,
+		Metadata: Metadata{
+			createdAt: time.Now(),
+		},
+	}
+	return poiModel, fi.validate(poiModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDomain(poiDatabaseModel Model[databaseID]) (*POI, error) {
+	poiDomainModel, err := fi.mapToDomain(fi.mapToDomainFunc, poiDatabaseModel)
+	if err != nil {
+		return nil, err
+	}
+	return poiDomainModel, fi.validate(poiDomainModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDatabase(poiDomainModel POI) (*Model[databaseID], error) {
+	validationErr := fi.validate(&poiDomainModel)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	poiDatabaseModel, err := fi.mapToDatabase(fi.mapToDatabaseFunc, poiDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	return poiDatabaseModel, nil
+}
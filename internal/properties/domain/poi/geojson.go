@@ -0,0 +1,83 @@
+package poi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"property-service/pkg/address"
+	"property-service/pkg/errors"
+)
+
+// ErrInvalidFeatureCollection : The points of interest are not a GeoJSON FeatureCollection.
+var ErrInvalidFeatureCollection = errors.NewSimple("invalid GeoJSON FeatureCollection")
+
+const (
+	// DefaultTypeProperty is the feature property a point of interest is typed by.
+	DefaultTypeProperty = "type"
+	// DefaultNameProperty is the feature property a point of interest is named by.
+	DefaultNameProperty = "name"
+)
+
+// featureCollection is the part of a GeoJSON FeatureCollection read as points of interest.
+type featureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Geometry struct {
+			Type        string     `json:"type"`
+			Coordinates [2]float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"features"`
+}
+
+// ParseFeatureCollection reads the Point features of a GeoJSON FeatureCollection as points
+// of interest, typed and named by the DefaultTypeProperty and DefaultNameProperty.
+func ParseFeatureCollection(data []byte) ([]NewPOIParams, error) {
+	var collection featureCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFeatureCollection, err)
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("%w: type %q", ErrInvalidFeatureCollection, collection.Type)
+	}
+
+	pois := make([]NewPOIParams, 0, len(collection.Features))
+	for i, feature := range collection.Features {
+		if feature.Geometry.Type != "Point" {
+			return nil, fmt.Errorf("%w: feature %d is not a point", ErrInvalidFeatureCollection, i)
+		}
+		kind, _ := feature.Properties[DefaultTypeProperty].(string)
+		if TypeKey(kind) == "" {
+			return nil, fmt.Errorf("%w: feature %d has no %q property", ErrInvalidFeatureCollection, i, DefaultTypeProperty)
+		}
+		name, _ := feature.Properties[DefaultNameProperty].(string)
+		lng, lat := feature.Geometry.Coordinates[0], feature.Geometry.Coordinates[1]
+		if lng < -180 || lng > 180 || lat < -90 || lat > 90 {
+			return nil, fmt.Errorf("%w: feature %d is outside [-180, 180] x [-90, 90]", ErrInvalidFeatureCollection, i)
+		}
+		pois = append(pois, NewPOIParams{
+			Type:     kind,
+			Name:     name,
+			Location: *address.NewPoint(lat, lng),
+		})
+	}
+	return pois, nil
+}
+
+// LoadFile reads the points of interest of a GeoJSON FeatureCollection file and returns them
+// with the dataset hash of the file, the hex SHA-256 of its content.
+func LoadFile(path string) ([]NewPOIParams, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	pois, err := ParseFeatureCollection(data)
+	if err != nil {
+		return nil, "", err
+	}
+	hash := sha256.Sum256(data)
+	return pois, hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,86 @@
+package poi
+
+import (
+	"time"
+
+	"property-service/pkg/address"
+)
+
+type Model[ID any] struct {
+	ID       ID                         `bson:"_id" validate:"required"`
+	Type     string                     `bson:"Type" validate:"required"`
+	Name     string                     `bson:"Name" validate:"omitempty"`
+	Location address.GeoJSONCoordinates `bson:"Location" validate:"required"`
+	Distance float64                    `bson:"Distance,omitempty" validate:"omitempty"`
+	Dataset  string                     `bson:"Dataset" validate:"omitempty"`
+	Metadata MetadataModel              `bson:"Metadata" validate:"required"`
+}
+
+type MetadataModel struct {
+	CreatedAt time.Time `bson:"CreatedAt"`
+}
+
+func MapModelToPOI[Old any](
+	mappingFunc func(Old) (string, error),
+	oldPOI Model[Old],
+) (*POI, error) {
+	// Map IDs
+	poiID, err := mappingFunc(oldPOI.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &POI{
+		ID:       poiID,
+		Type:     oldPOI.Type,
+		Name:     oldPOI.Name,
+		Location: oldPOI.Location,
+		Distance: oldPOI.Distance,
+		Dataset:  oldPOI.Dataset,
+		Metadata: Metadata{
+			createdAt: oldPOI.Metadata.CreatedAt,
+		},
+	}, nil
+}
+
+// POI : This domain model contains a point of interest, e.g. a station, school or park, that
+// the properties are enriched with the distance to.
+type POI struct {
+	ID       string                     `json:"id" validate:"required"`
+	Type     string                     `json:"type" validate:"required"` // Type key, see TypeKey.
+	Name     string                     `json:"name,omitempty" validate:"omitempty"`
+	Location address.GeoJSONCoordinates `json:"location" validate:"required"`
+	Distance float64                    `json:"distance,omitempty" validate:"omitempty"` // Metres from the search point, if applicable.
+	Dataset  string                     `json:"dataset,omitempty" validate:"omitempty"`  // Hash of the dataset it was loaded from.
+	Metadata Metadata                   `json:"metadata" validate:"required"`
+}
+
+type Metadata struct {
+	createdAt time.Time `bson:"CreatedAt"`
+}
+
+// CreatedAt : returns when the point of interest was loaded.
+func (m Metadata) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func MapPOIToModel[New any](
+	mappingFunc func(string) (New, error),
+	oldPOI POI,
+) (*Model[New], error) {
+	// Map IDs
+	poiID, err := mappingFunc(oldPOI.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Model[New]{
+		ID:       poiID,
+		Type:     oldPOI.Type,
+		Name:     oldPOI.Name,
+		Location: oldPOI.Location,
+		Distance: oldPOI.Distance,
+		Dataset:  oldPOI.Dataset,
+		Metadata: MetadataModel{
+			CreatedAt: oldPOI.Metadata.createdAt,
+		},
+	}, nil
+}
@@ -0,0 +1,20 @@
+package poi
+
+import "property-service/internal/properties/domain/property"
+
+// Nearby returns the points of interest found by NearestPerType by type, as the nearest points
+// of interest of a property, none when nothing was found.
+func Nearby(found []POI) map[string]property.NearbyPOI {
+	if len(found) == 0 {
+		return nil
+	}
+	nearby := make(map[string]property.NearbyPOI, len(found))
+	for _, p := range found {
+		nearby[p.Type] = property.NearbyPOI{
+			Name:     p.Name,
+			Distance: p.Distance,
+			Location: p.Location,
+		}
+	}
+	return nearby
+}
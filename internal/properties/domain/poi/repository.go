@@ -0,0 +1,22 @@
+package poi
+
+import (
+	"context"
+
+	"property-service/pkg/address"
+)
+
+// DefaultMaxDistance is the distance in metres within which the nearest points of interest
+// of a property are looked for.
+const DefaultMaxDistance = 2000.0
+
+// Repository :  handles all the database actions for the points of interest.
+type Repository interface {
+	// Replace : replaces every point of interest with the ones of the dataset with the hash
+	// at once and returns how many are stored and whether they changed. The stored points are
+	// kept when they are all of the dataset already.
+	Replace(c context.Context, dataset string, params []NewPOIParams) (int, bool, error)
+	// NearestPerType : returns the nearest point of interest of every type within maxDistance
+	// metres of the point, by type, with the distance set on each.
+	NearestPerType(c context.Context, point address.GeoJSONCoordinates, maxDistance float64) ([]POI, error)
+}
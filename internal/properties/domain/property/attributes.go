@@ -0,0 +1,132 @@
+package property
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Furnishing : how a property is furnished.
+type Furnishing uint8
+
+const (
+	UnknownFurnishing Furnishing = iota // 0: unknown
+	Unfurnished                         // 1: unfurnished
+	PartFurnished                       // 2: part furnished
+	Furnished                           // 3: furnished
+)
+
+// Parking : the parking of a property, the larger the value the more private.
+type Parking uint8
+
+const (
+	UnknownParking   Parking = iota // 0: unknown
+	NoParking                       // 1: none
+	StreetParking                   // 2: on street
+	OffStreetParking                // 3: off street, e.g. a driveway
+	GarageParking                   // 4: garage
+)
+
+// AreaUnit : the unit a floor area is given in.
+type AreaUnit uint8
+
+const (
+	UnknownAreaUnit AreaUnit = iota // 0: unknown
+	SquareMetres                    // 1: square metres
+	SquareFeet                      // 2: square feet
+)
+
+// squareMetresPerSquareFoot converts a floor area in square feet into square metres.
+const squareMetresPerSquareFoot = 0.09290304
+
+// Attributes : the structured facts of a property.
+type Attributes struct {
+	Bedrooms       uint8      `bson:"Bedrooms" json:"bedrooms" validate:"lte=50"`
+	Bathrooms      uint8      `bson:"Bathrooms" json:"bathrooms" validate:"lte=50"`
+	ReceptionRooms uint8      `bson:"ReceptionRooms" json:"receptionRooms" validate:"lte=50"`
+	FloorArea      *FloorArea `bson:"FloorArea,omitempty" json:"floorArea,omitempty" validate:"omitempty"`
+	Furnishing     Furnishing `bson:"Furnishing" json:"furnishing" validate:"lte=3"`
+	Parking        Parking    `bson:"Parking" json:"parking" validate:"lte=4"`
+	Garden         bool       `bson:"Garden" json:"garden"`
+	PetsAllowed    bool       `bson:"PetsAllowed" json:"petsAllowed"`
+	// Accessibility holds the keys of the known accessibility features and Amenities any
+	// feature key, e.g. "air-conditioning", both sorted without duplicates.
+	Accessibility []string `bson:"Accessibility,omitempty" json:"accessibility,omitempty" validate:"omitempty,unique,dive,oneof=step-free-access lift wheelchair-accessible wide-doorways level-access-shower ground-floor-bedroom"`
+	Amenities     []string `bson:"Amenities,omitempty" json:"amenities,omitempty" validate:"omitempty,max=50,unique,dive,required,max=50"`
+}
+
+// FloorArea : the floor area of a property in its unit.
+type FloorArea struct {
+	Size float64  `bson:"Size" json:"size" validate:"gt=0"`
+	Unit AreaUnit `bson:"Unit" json:"unit" validate:"oneof=1 2"`
+	// SquareMetres is the size in square metres, floor areas of either unit are filtered by it.
+	SquareMetres float64 `bson:"SquareMetres" json:"squareMetres"`
+}
+
+// NewFloorArea returns the floor area of the size in the unit with its size in square metres.
+func NewFloorArea(size float64, unit AreaUnit) FloorArea {
+	floorArea := FloorArea{Size: size, Unit: unit, SquareMetres: size}
+	if unit == SquareFeet {
+		// Rounded to the nearest hundredth.
+		floorArea.SquareMetres = math.Round(size*squareMetresPerSquareFoot*100) / 100
+	}
+	return floorArea
+}
+
+// NewAttributes returns the attributes with the floor area in square metres and the feature
+// keys normalised, sorted and without duplicates.
+func NewAttributes(attributes Attributes) Attributes {
+	if attributes.FloorArea != nil {
+		floorArea := NewFloorArea(attributes.FloorArea.Size, attributes.FloorArea.Unit)
+		attributes.FloorArea = &floorArea
+	}
+	attributes.Accessibility = featureKeys(attributes.Accessibility)
+	attributes.Amenities = featureKeys(attributes.Amenities)
+	return attributes
+}
+
+// FeatureKey returns the key of an accessibility feature or amenity, its name in lower case
+// with the words joined by hyphens, e.g. "Air Conditioning" is "air-conditioning".
+func FeatureKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+// featureKeys returns the sorted keys of the names without duplicates.
+func featureKeys(names []string) []string {
+	if len(names) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(names))
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key := FeatureKey(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// AttributeFilter : the attribute criteria of a property list, every criterion that is set
+// must match. A nil maximum is no upper bound, so a zero MaxBedrooms lists the studios. The
+// floor areas are compared in square metres.
+type AttributeFilter struct {
+	MinBedrooms   uint8      `validate:"omitempty"`
+	MaxBedrooms   *uint8     `validate:"omitempty,gtefield=MinBedrooms"`
+	MinBathrooms  uint8      `validate:"omitempty"`
+	MinFloorArea  float64    `validate:"gte=0"`
+	MaxFloorArea  *float64   `validate:"omitempty,gtefield=MinFloorArea"`
+	Furnishing    Furnishing `validate:"omitempty,lte=3"`
+	Parking       bool       // Only properties with street, off street or garage parking.
+	Garden        *bool      `validate:"omitempty"`
+	PetsAllowed   *bool      `validate:"omitempty"`
+	Accessibility []string   `validate:"omitempty,dive,required"` // Every feature is required.
+	Amenities     []string   `validate:"omitempty,dive,required"` // Every amenity is required.
+}
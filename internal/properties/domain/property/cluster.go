@@ -0,0 +1,38 @@
+package property
+
+import (
+	"property-service/pkg/address"
+)
+
+const (
+	// MaxClusterZoom is the deepest map zoom level properties are clustered for.
+	MaxClusterZoom = 22
+	// DefaultClusterThreshold is the number of properties from which a grid cell is returned
+	// as a cluster instead of its properties.
+	DefaultClusterThreshold = 10
+	// MaxClusterCells is the largest number of grid cells a viewport is clustered into, the
+	// cells with the most properties are kept.
+	MaxClusterCells = 1000
+	// clusterCellsPerTile is the number of grid cells across a 256 pixel map tile.
+	clusterCellsPerTile = 4
+)
+
+// ClusterCellSize returns the width and height in degrees of the grid cells at the zoom
+// level, every zoom level halves the cells.
+func ClusterCellSize(zoom uint8) float64 {
+	return 360 / float64(uint64(1)<<zoom) / clusterCellsPerTile
+}
+
+// Cluster : a grid cell of the map with the number of properties inside it.
+type Cluster struct {
+	Count    int64                      `json:"count"`
+	Centroid address.GeoJSONCoordinates `json:"centroid"` // Mean position of the properties in the cell.
+	SampleID string                     `json:"sampleID"` // One of the properties in the cell.
+}
+
+// Clusters : the properties inside a map viewport, the cells with fewer properties than the
+// threshold are returned as their properties and the others as clusters.
+type Clusters struct {
+	Clusters   []Cluster  `json:"clusters"`
+	Properties []Property `json:"properties"`
+}
@@ -0,0 +1,16 @@
+package property
+
+// FacetBucket : the number of properties sharing a value of a field.
+type FacetBucket struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// Facets : the counts per value of the fields a property search can be narrowed by, the
+// largest buckets first.
+type Facets struct {
+	Categories []FacetBucket `json:"categories"`
+	SaleTypes  []FacetBucket `json:"saleTypes"`
+	Cities     []FacetBucket `json:"cities"`
+	Statuses   []FacetBucket `json:"statuses"`
+}
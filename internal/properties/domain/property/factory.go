@@ -3,6 +3,7 @@ package property
 import (
 	"property-service/pkg/address"
 	"property-service/pkg/helper/factory"
+	"property-service/pkg/money"
 	"time"
 )
 
@@ -37,4 +38,14 @@ type UpdatePropertyParams struct {
 	Category      string
 	Address       address.Address
 	SaleType      uint8
+	// Areas are the keys of the named areas containing the Address and Nearby the nearest
+	// points of interest to it, they are only saved with the Address.
+	Areas  []string
+	Nearby map[string]NearbyPOI
+	// Rent and AskingPrice are only saved with the SaleType, the price of a sale type the
+	// property is no longer for is removed.
+	Rent        *Rent
+	AskingPrice *money.Money
+	// Attributes replace the stored attributes when they are set.
+	Attributes *Attributes
 }
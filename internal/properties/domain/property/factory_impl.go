@@ -5,6 +5,7 @@ import (
 
 	"property-service/pkg/address"
 	"property-service/pkg/errors"
+	"property-service/pkg/money"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -74,15 +75,35 @@ type NewPropertyParams struct {
 	Category      string `validate:"required"`
 	Description   string `validate:"required"`
 	Title         string `validate:"required"`
-	Available     bool
-	AvailableDate time.Time       `validate:"required"`
-	Address       address.Address `validate:"required"`
-	SaleType      uint8           `validate:"required"`
+	Status        Status
+	AvailableDate time.Time            `validate:"required"`
+	Address       address.Address      `validate:"required"`
+	SaleType      uint8                `validate:"required"`
+	Areas         []string             `validate:"omitempty"`
+	Nearby        map[string]NearbyPOI `validate:"omitempty"`
+	Rent          *Rent                `validate:"omitempty"`
+	AskingPrice   *money.Money         `validate:"omitempty"`
+	Attributes    *Attributes          `validate:"omitempty"`
 }
 
 func (fi FactoryImpl[databaseID]) New(
 	property NewPropertyParams,
 ) (*Property, error) {
+	var rent *Rent
+	if property.Rent != nil {
+		newRent := NewRent(property.Rent.Price, property.Rent.Period)
+		rent = &newRent
+	}
+	var askingPrice *money.Money
+	if property.AskingPrice != nil {
+		newAskingPrice := money.New(property.AskingPrice.Amount, property.AskingPrice.Currency)
+		askingPrice = &newAskingPrice
+	}
+	var attributes *Attributes
+	if property.Attributes != nil {
+		newAttributes := NewAttributes(*property.Attributes)
+		attributes = &newAttributes
+	}
 	propertyModel := &Property{
 		ID:          property.PropertyID,
 		OwnerID:     property.OwnerID,
@@ -93,10 +114,15 @@ func (fi FactoryImpl[databaseID]) New(
 			createdAt: time.Now(),
 			updatedAt: time.Time{},
 		},
-		Available:     property.Available,
+		Status:        property.Status,
 		AvailableDate: property.AvailableDate,
 		Address:       property.Address,
 		SaleType:      property.SaleType,
+		Areas:         property.Areas,
+		Nearby:        property.Nearby,
+		Rent:          rent,
+		AskingPrice:   askingPrice,
+		Attributes:    attributes,
 	}
 	return propertyModel, fi.validate(propertyModel)
 }
@@ -0,0 +1,59 @@
+package property
+
+// Highlight : the passages of a field that matched a text search.
+type Highlight struct {
+	Path  string          `json:"path"`
+	Texts []HighlightText `json:"texts"`
+}
+
+// HighlightText : a piece of a highlighted passage, hits are the matched terms.
+type HighlightText struct {
+	Value string `json:"value"`
+	Hit   bool   `json:"hit"`
+}
+
+// HighlightModel : the database representation of a highlight, as returned by Atlas Search.
+type HighlightModel struct {
+	Path  string               `bson:"path"`
+	Texts []HighlightTextModel `bson:"texts"`
+}
+
+// HighlightTextModel : a piece of a highlighted passage, the type is either hit or text.
+type HighlightTextModel struct {
+	Value string `bson:"value"`
+	Type  string `bson:"type"`
+}
+
+func mapHighlightsToDomain(highlights []HighlightModel) []Highlight {
+	if len(highlights) == 0 {
+		return nil
+	}
+	mapped := make([]Highlight, 0, len(highlights))
+	for _, highlight := range highlights {
+		texts := make([]HighlightText, 0, len(highlight.Texts))
+		for _, text := range highlight.Texts {
+			texts = append(texts, HighlightText{Value: text.Value, Hit: text.Type == "hit"})
+		}
+		mapped = append(mapped, Highlight{Path: highlight.Path, Texts: texts})
+	}
+	return mapped
+}
+
+func mapHighlightsToModel(highlights []Highlight) []HighlightModel {
+	if len(highlights) == 0 {
+		return nil
+	}
+	mapped := make([]HighlightModel, 0, len(highlights))
+	for _, highlight := range highlights {
+		texts := make([]HighlightTextModel, 0, len(highlight.Texts))
+		for _, text := range highlight.Texts {
+			textType := "text"
+			if text.Hit {
+				textType = "hit"
+			}
+			texts = append(texts, HighlightTextModel{Value: text.Value, Type: textType})
+		}
+		mapped = append(mapped, HighlightModel{Path: highlight.Path, Texts: texts})
+	}
+	return mapped
+}
@@ -2,6 +2,7 @@ package property
 
 import (
 	"property-service/pkg/address"
+	"property-service/pkg/money"
 	"time"
 )
 
@@ -15,17 +16,25 @@ const (
 )
 
 type Model[ID any] struct {
-	ID              ID              `bson:"_id" validate:"required,len=24,hexadecimal"`
-	OwnerID         ID              `bson:"OwnerID" validate:"required,len=24,hexadecimal"`
-	Category        string          `bson:"Category" validate:"required"`
-	Description     string          `bson:"Description" validate:"required"`
-	Title           string          `bson:"Title" validate:"required"`
-	Metadata        MetadataModel   `bson:"Metadata" validate:"required"`
-	Available       bool            `bson:"Available" validate:"required"`
-	AvailableDate   time.Time       `bson:"AvailableDate" validate:"required"`
-	Address         address.Address `bson:"Address" validate:"omitempty"`
-	SaleType        SaleType        `bson:"SaleType" validate:"gte=0,lte=3"`
-	PaginationToken string          `bson:"PaginationToken,omitempty" validate:"omitempty"`
+	ID              ID                   `bson:"_id" validate:"required,len=24,hexadecimal"`
+	OwnerID         ID                   `bson:"OwnerID" validate:"required,len=24,hexadecimal"`
+	Category        string               `bson:"Category" validate:"required"`
+	Description     string               `bson:"Description" validate:"required"`
+	Title           string               `bson:"Title" validate:"required"`
+	Metadata        MetadataModel        `bson:"Metadata" validate:"required"`
+	Status          Status               `bson:"Status" validate:"required,lte=7"`
+	AvailableDate   time.Time            `bson:"AvailableDate" validate:"required"`
+	Address         address.Address      `bson:"Address" validate:"omitempty"`
+	SaleType        SaleType             `bson:"SaleType" validate:"gte=0,lte=3"`
+	PaginationToken string               `bson:"PaginationToken,omitempty" validate:"omitempty"`
+	Distance        float64              `bson:"Distance,omitempty" validate:"omitempty"`
+	Highlights      []HighlightModel     `bson:"Highlights,omitempty" validate:"omitempty"`
+	SimilarityScore float64              `bson:"SimilarityScore,omitempty" validate:"omitempty"`
+	Areas           []string             `bson:"Areas,omitempty" validate:"omitempty"`
+	Nearby          map[string]NearbyPOI `bson:"Nearby,omitempty" validate:"omitempty"`
+	Rent            *Rent                `bson:"Rent,omitempty" validate:"omitempty"`
+	AskingPrice     *money.Money         `bson:"AskingPrice,omitempty" validate:"omitempty"`
+	Attributes      *Attributes          `bson:"Attributes,omitempty" validate:"omitempty"`
 }
 
 type MetadataModel struct {
@@ -56,11 +65,19 @@ func MapModelToProperty[Old any](
 			createdAt: oldProperty.Metadata.CreatedAt,
 			updatedAt: oldProperty.Metadata.UpdatedAt,
 		},
-		Available:       oldProperty.Available,
+		Status:          oldProperty.Status,
 		AvailableDate:   oldProperty.AvailableDate,
 		Address:         oldProperty.Address,
 		SaleType:        uint8(oldProperty.SaleType),
 		PaginationToken: oldProperty.PaginationToken,
+		Distance:        oldProperty.Distance,
+		SimilarityScore: oldProperty.SimilarityScore,
+		Highlights:      mapHighlightsToDomain(oldProperty.Highlights),
+		Areas:           oldProperty.Areas,
+		Nearby:          oldProperty.Nearby,
+		Rent:            oldProperty.Rent,
+		AskingPrice:     oldProperty.AskingPrice,
+		Attributes:      oldProperty.Attributes,
 	}, err
 }
 
@@ -72,17 +89,40 @@ type Property struct {
 	Description     string          `json:"description" validate:"required"`
 	Title           string          `json:"title" validate:"required"`
 	Metadata        Metadata        `json:"metadata" validate:"required"`
-	Available       bool            `json:"available" validate:"required"`
+	Status          Status          `json:"status" validate:"required,lte=7"` // Stage of the listing lifecycle.
 	AvailableDate   time.Time       `json:"availableDate" validate:"required"`
 	Address         address.Address `json:"address" validate:"required"`
 	SaleType        uint8           `json:"saleType" validate:"required"`
 	PaginationToken string          `json:"paginationToken,omitempty" validate:"omitempty"`
+	Distance        float64         `json:"distance,omitempty" validate:"omitempty"`
+	Highlights      []Highlight     `json:"highlights,omitempty" validate:"omitempty"`
+	SimilarityScore float64         `json:"similarityScore,omitempty" validate:"omitempty"`
+	Areas           []string        `json:"areas,omitempty" validate:"omitempty"` // Keys of the named areas containing the property.
+	// Nearby holds the nearest point of interest of every type, by type key.
+	Nearby map[string]NearbyPOI `json:"nearby,omitempty" validate:"omitempty"`
+	// Rent is set when the property is for rent and AskingPrice when it is for sale.
+	Rent        *Rent        `json:"rent,omitempty" validate:"omitempty"`
+	AskingPrice *money.Money `json:"askingPrice,omitempty" validate:"omitempty"`
+	// Attributes are the structured facts of the property, e.g. its rooms and amenities.
+	Attributes *Attributes `json:"attributes,omitempty" validate:"omitempty"`
+	// TravelTime is the journey time from the destination of a commute search, it is not stored.
+	TravelTime time.Duration `json:"travelTime,omitempty" validate:"omitempty"`
 }
 type Metadata struct {
 	createdAt time.Time `bson:"CreatedAt"`
 	updatedAt time.Time `bson:"UpdatedAt"`
 }
 
+// CreatedAt : returns when the property was created.
+func (m Metadata) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// UpdatedAt : returns when the property was last updated.
+func (m Metadata) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
 func MapPropertyToModel[New any](
 	mappingFunc func(string) (New, error),
 	oldProperty Property,
@@ -108,10 +148,18 @@ func MapPropertyToModel[New any](
 			CreatedAt: oldProperty.Metadata.createdAt,
 			UpdatedAt: oldProperty.Metadata.updatedAt,
 		},
-		Available:       oldProperty.Available,
+		Status:          oldProperty.Status,
 		AvailableDate:   oldProperty.AvailableDate,
 		Address:         oldProperty.Address,
 		SaleType:        SaleType(oldProperty.SaleType),
 		PaginationToken: oldProperty.PaginationToken,
+		Distance:        oldProperty.Distance,
+		SimilarityScore: oldProperty.SimilarityScore,
+		Highlights:      mapHighlightsToModel(oldProperty.Highlights),
+		Areas:           oldProperty.Areas,
+		Nearby:          oldProperty.Nearby,
+		Rent:            oldProperty.Rent,
+		AskingPrice:     oldProperty.AskingPrice,
+		Attributes:      oldProperty.Attributes,
 	}, err
 }
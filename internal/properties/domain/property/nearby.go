@@ -0,0 +1,19 @@
+package property
+
+import (
+	"property-service/pkg/address"
+)
+
+// NearbyPOI : the nearest point of interest of a type to a property.
+type NearbyPOI struct {
+	Name     string                     `bson:"Name,omitempty" json:"name,omitempty"`
+	Distance float64                    `bson:"Distance" json:"distance"` // Metres from the property.
+	Location address.GeoJSONCoordinates `bson:"Location" json:"location"`
+}
+
+// POIDistance : a search criterion matching the properties within a distance of a point of
+// interest of the type, e.g. within 500 metres of a station.
+type POIDistance struct {
+	Type   string  `validate:"required"`      // Type of the points of interest, e.g. "station".
+	Within float64 `validate:"required,gt=0"` // Metres.
+}
@@ -0,0 +1,82 @@
+package property
+
+import (
+	"property-service/pkg/errors"
+	"property-service/pkg/money"
+)
+
+var (
+	// ErrRentRequired : a property for rent has no rent.
+	ErrRentRequired = errors.NewSimple("a property for rent needs a rent")
+	// ErrAskingPriceRequired : a property for sale has no asking price.
+	ErrAskingPriceRequired = errors.NewSimple("a property for sale needs an asking price")
+	// ErrRentNotForRent : a property that is not for rent has a rent.
+	ErrRentNotForRent = errors.NewSimple("only a property for rent can have a rent")
+	// ErrAskingPriceNotForSale : a property that is not for sale has an asking price.
+	ErrAskingPriceNotForSale = errors.NewSimple("only a property for sale can have an asking price")
+)
+
+// RentPeriod : the period a rent is paid for.
+type RentPeriod uint8
+
+const (
+	UnknownRentPeriod RentPeriod = iota // 0: unknown
+	Weekly                              // 1: per week
+	Monthly                             // 2: per month
+)
+
+// weeksPerYear and monthsPerYear convert a weekly rent into a monthly one.
+const (
+	weeksPerYear  = 52
+	monthsPerYear = 12
+)
+
+// Rent : the rent of a property for rent.
+type Rent struct {
+	Price  money.Money `bson:"Price" json:"price"`
+	Period RentPeriod  `bson:"Period" json:"period" validate:"oneof=1 2"`
+	// MonthlyAmount is the price per month in minor units, rents of either period are
+	// filtered and sorted by it.
+	MonthlyAmount int64 `bson:"MonthlyAmount" json:"monthlyAmount"`
+}
+
+// NewRent returns the rent of the price per period with its monthly amount, the currency
+// is put in upper case.
+func NewRent(price money.Money, period RentPeriod) Rent {
+	rent := Rent{
+		Price:         money.New(price.Amount, price.Currency),
+		Period:        period,
+		MonthlyAmount: price.Amount,
+	}
+	if period == Weekly {
+		// Rounded to the nearest minor unit.
+		rent.MonthlyAmount = (price.Amount*weeksPerYear + monthsPerYear/2) / monthsPerYear
+	}
+	return rent
+}
+
+// ValidatePricing checks that a property of the sale type has a rent when it is for rent and
+// an asking price when it is for sale, and no price of a sale type it is not.
+func ValidatePricing(saleType uint8, rent *Rent, askingPrice *money.Money) error {
+	forRent := SaleType(saleType) == ForRent || SaleType(saleType) == ForBoth
+	forSale := SaleType(saleType) == ForSale || SaleType(saleType) == ForBoth
+	switch {
+	case forRent && rent == nil:
+		return ErrRentRequired
+	case !forRent && rent != nil:
+		return ErrRentNotForRent
+	case forSale && askingPrice == nil:
+		return ErrAskingPriceRequired
+	case !forSale && askingPrice != nil:
+		return ErrAskingPriceNotForSale
+	}
+	return nil
+}
+
+// PriceRange : a search criterion matching the prices between Min and Max in minor units, a
+// zero Max has no upper bound. A rent range is compared with the monthly amount of the rent.
+type PriceRange struct {
+	Min      int64  `validate:"gte=0"`
+	Max      int64  `validate:"omitempty,gtefield=Min"`
+	Currency string `validate:"omitempty,iso4217"` // Empty matches any currency.
+}
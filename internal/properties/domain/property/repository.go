@@ -2,6 +2,8 @@ package property
 
 import (
 	"context"
+
+	"property-service/pkg/address"
 )
 
 // Repository :  handles all the database actions for the user profile.
@@ -14,22 +16,141 @@ type Repository interface {
 	Get(c context.Context, ID string) (*Property, error)
 	// Update: updates a property.
 	Update(c context.Context, id string, params UpdatePropertyParams) error
+	// UpdateStatus : moves a property from one listing status to another, ErrStatusChanged
+	// is returned when it is no longer in the from status.
+	UpdateStatus(c context.Context, id string, from Status, to Status) error
+
+	// The lists, counts, facets and suggestions below only include the published properties
+	// unless they take the statuses to include. The ones taking excluded IDs leave out the
+	// properties with those IDs, e.g. the ones unavailable for the dates looked for.
 
+	// ListByCategory : returns the properties in the category, limited to the named area
+	// with the key when it is not empty.
 	ListByCategory(
 		c context.Context,
 		category string,
-		sort uint8,
+		area string,
+		excluded []string,
+		sort Sort,
 		limit uint16,
 		paginationToken string,
 		search uint8,
 	) ([]Property, error)
+	// CountByCategory : returns the number of properties in the category and area.
+	CountByCategory(c context.Context, category string, area string, excluded []string) (int64, error)
 
+	// ListByOwner : returns the properties of the owner in any of the statuses, limited to the
+	// named area with the key when it is not empty. No statuses are the published ones.
 	ListByOwner(
 		c context.Context,
 		ownerID string,
+		area string,
+		statuses []Status,
+		excluded []string,
+		sort Sort,
+		limit uint16,
+		paginationToken string,
+		search uint8,
+	) ([]Property, error)
+	// CountByOwner : returns the number of properties of the owner in the area and statuses.
+	CountByOwner(
+		c context.Context,
+		ownerID string,
+		area string,
+		statuses []Status,
+		excluded []string,
+	) (int64, error)
+
+	// ListNear : returns the properties within radius metres of the point matching the
+	// attribute filter, nearest first, with the distance set on each property.
+	ListNear(
+		c context.Context,
+		latitude float64,
+		longitude float64,
+		radius float64,
+		category string,
+		saleType uint8,
+		area string,
+		attributes AttributeFilter,
+		excluded []string,
+		limit uint16,
+	) ([]Property, error)
+
+	// ListSimilar : returns the published properties most similar to the source by
+	// category, sale type, distance and wording, the most similar first with the score set
+	// on each property.
+	ListSimilar(c context.Context, source Property, limit uint16) ([]Property, error)
+
+	// ListWithinArea : returns the properties inside a bounding box or polygon.
+	ListWithinArea(
+		c context.Context,
+		area SearchArea,
+		excluded []string,
+		sort uint8,
+		limit uint16,
+		paginationToken string,
+		search uint8,
+	) ([]Property, error)
+	// ClusterWithinBox : groups the properties inside the box into the grid cells of the
+	// zoom level, the properties of a cell with fewer than threshold properties are returned
+	// instead of its cluster. Only the MaxClusterCells cells with the most properties are kept.
+	ClusterWithinBox(
+		c context.Context,
+		box address.BoundingBox,
+		zoom uint8,
+		threshold uint16,
+	) (*Clusters, error)
+	// ListWithinBoundary : returns at most limit properties inside the boundary matching the
+	// attribute filter, limited to the category and sale type when they are set.
+	ListWithinBoundary(
+		c context.Context,
+		boundary address.GeoJSONMultiPolygon,
+		category string,
+		saleType uint8,
+		attributes AttributeFilter,
+		excluded []string,
+		limit uint16,
+	) ([]Property, error)
+	// CountWithinArea : returns the number of properties inside the area.
+	CountWithinArea(c context.Context, area SearchArea, excluded []string) (int64, error)
+
+	// Search : returns the properties matching every criterion of the filter.
+	Search(
+		c context.Context,
+		filter SearchFilter,
 		sort uint8,
 		limit uint16,
 		paginationToken string,
 		search uint8,
 	) ([]Property, error)
+	// CountSearch : returns the number of properties matching the filter.
+	CountSearch(c context.Context, filter SearchFilter) (int64, error)
+	// SearchText : returns the properties whose title, description or address match the
+	// text, an empty sort orders them by relevance.
+	SearchText(
+		c context.Context,
+		text string,
+		sort Sort,
+		limit uint16,
+		paginationToken string,
+		search uint8,
+	) ([]Property, error)
+	// CountSearchText : returns the number of properties matching the text.
+	CountSearchText(c context.Context, text string) (int64, error)
+	// Facets : returns the counts per category, sale type, city and status of the properties
+	// matching the filter.
+	Facets(c context.Context, filter SearchFilter) (*Facets, error)
+	// Suggest : returns at most limit distinct cities, counties, postcodes and titles with a
+	// word starting with the prefix.
+	Suggest(c context.Context, prefix string, limit uint16) (*Suggestions, error)
+
+	// TagArea : tags the properties whose coordinates are inside the boundary with the
+	// area key and removes the tag from the properties outside it.
+	TagArea(c context.Context, key string, boundary address.GeoJSONMultiPolygon) error
+	// RefreshNearby : sets the nearest points of interest returned by nearest on every
+	// property with coordinates and returns how many properties changed.
+	RefreshNearby(
+		c context.Context,
+		nearest func(c context.Context, point address.GeoJSONCoordinates) (map[string]NearbyPOI, error),
+	) (int64, error)
 }
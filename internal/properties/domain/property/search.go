@@ -0,0 +1,48 @@
+package property
+
+import (
+	"time"
+
+	"property-service/pkg/address"
+)
+
+// SearchArea : the area a property search is limited to, either a bounding box or a polygon.
+type SearchArea struct {
+	BoundingBox *address.BoundingBox    `validate:"required_without=Polygon"`
+	Polygon     *address.GeoJSONPolygon `validate:"required_without=BoundingBox"`
+}
+
+// Validate checks the geometry of the search area.
+func (a SearchArea) Validate() error {
+	if a.Polygon != nil {
+		return a.Polygon.Validate()
+	}
+	if a.BoundingBox != nil {
+		return a.BoundingBox.Validate()
+	}
+	return nil
+}
+
+// SearchFilter : the criteria of a property search, every criterion that is set must match.
+type SearchFilter struct {
+	Categories     []string      `validate:"omitempty,dive,required"`
+	SaleType       uint8         `validate:"omitempty,lte=3"`
+	Statuses       []Status      `validate:"omitempty,dive,gte=1,lte=7"` // Empty matches the published properties.
+	AvailableFrom  time.Time     `validate:"omitempty"`
+	AvailableTo    time.Time     `validate:"omitempty,gtefield=AvailableFrom"`
+	City           string        `validate:"omitempty"`
+	PostcodePrefix string        `validate:"omitempty"`
+	Country        string        `validate:"omitempty"`
+	Area           string        `validate:"omitempty"` // Key or name of a named area.
+	NearPOIs       []POIDistance `validate:"omitempty,dive"`
+	Rent           *PriceRange   `validate:"omitempty"` // Compared with the monthly amount of the rent.
+	AskingPrice    *PriceRange   `validate:"omitempty"`
+	Attributes     AttributeFilter
+	// FreeFrom and FreeTo limit the properties to the ones without a blocked or booked date
+	// from FreeFrom up to but excluding FreeTo in their availability calendar.
+	FreeFrom time.Time `validate:"omitempty"`
+	FreeTo   time.Time `validate:"omitempty"`
+	// ExcludedIDs are the IDs of the properties left out, the queries set them to the
+	// properties that are not free.
+	ExcludedIDs []string `validate:"omitempty"`
+}
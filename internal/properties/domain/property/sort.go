@@ -0,0 +1,80 @@
+package property
+
+import (
+	"strings"
+
+	"property-service/pkg/errors"
+)
+
+// ErrUnsupportedSortField : the sort names a field properties can not be sorted by.
+var ErrUnsupportedSortField = errors.NewSimple("unsupported sort field")
+
+// SortField : a field properties can be sorted by.
+type SortField string
+
+const (
+	SortByTitle         SortField = "title"
+	SortByAvailableDate SortField = "available_date"
+	SortBySaleType      SortField = "sale_type"
+	SortByCreatedAt     SortField = "created_at"
+	SortByUpdatedAt     SortField = "updated_at"
+	SortByRent          SortField = "rent" // By the monthly amount of the rent.
+	SortByAskingPrice   SortField = "asking_price"
+)
+
+// SortOrder : a single field of a sort and its direction.
+type SortOrder struct {
+	Field      SortField `validate:"required"`
+	Descending bool
+}
+
+// Sort : the order of a property list, later fields break ties of earlier ones. An empty
+// sort orders by title.
+type Sort []SortOrder
+
+// Validate checks that every field of the sort is supported and used once.
+func (s Sort) Validate() error {
+	seen := make(map[SortField]bool, len(s))
+	for _, order := range s {
+		switch order.Field {
+		case SortByTitle, SortByAvailableDate, SortBySaleType, SortByCreatedAt, SortByUpdatedAt,
+			SortByRent, SortByAskingPrice:
+		default:
+			return errors.Join(ErrUnsupportedSortField, errors.NewSimple(string(order.Field)))
+		}
+		if seen[order.Field] {
+			return errors.NewSimple("sort field used more than once: " + string(order.Field))
+		}
+		seen[order.Field] = true
+	}
+	return nil
+}
+
+// String returns the sort as comma separated fields, descending fields are prefixed with a minus.
+func (s Sort) String() string {
+	fields := make([]string, 0, len(s))
+	for _, order := range s {
+		if order.Descending {
+			fields = append(fields, "-"+string(order.Field))
+		} else {
+			fields = append(fields, string(order.Field))
+		}
+	}
+	return strings.Join(fields, ",")
+}
+
+// ParseSort reads a sort in the form of String, e.g. "-available_date,title", the fields
+// are not checked until the sort is validated.
+func ParseSort(sort string) Sort {
+	var parsed Sort
+	for _, field := range strings.Split(sort, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		order := SortOrder{Field: SortField(strings.TrimPrefix(field, "-"))}
+		order.Descending = strings.HasPrefix(field, "-")
+		parsed = append(parsed, order)
+	}
+	return parsed
+}
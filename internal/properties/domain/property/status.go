@@ -0,0 +1,82 @@
+package property
+
+import (
+	"property-service/pkg/errors"
+)
+
+var (
+	// ErrIllegalTransition : the listing cannot move from its status to the requested one.
+	ErrIllegalTransition = errors.NewSimple("illegal listing status transition")
+	// ErrLetNotForRent : a property that is not for rent is marked as let.
+	ErrLetNotForRent = errors.NewSimple("only a property for rent can be let")
+	// ErrSoldNotForSale : a property that is not for sale is marked as sold.
+	ErrSoldNotForSale = errors.NewSimple("only a property for sale can be sold")
+	// ErrStatusChanged : the status of the listing changed while it was being moved.
+	ErrStatusChanged = errors.NewSimple("listing status changed concurrently")
+)
+
+// Status : the stage of the lifecycle of a listing.
+type Status uint8
+
+const (
+	UnknownStatus Status = iota // 0: unknown
+	Draft                       // 1: not yet shown to the public
+	Published                   // 2: shown to the public
+	UnderOffer                  // 3: an offer is accepted, still shown to the public
+	Let                         // 4: let, a property for rent only
+	Sold                        // 5: sold, a property for sale only
+	Withdrawn                   // 6: taken off the market by the owner
+	Archived                    // 7: kept for the record, final
+)
+
+// statusNames are the names of the statuses as they are logged and returned in errors.
+var statusNames = map[Status]string{
+	UnknownStatus: "unknown",
+	Draft:         "draft",
+	Published:     "published",
+	UnderOffer:    "under offer",
+	Let:           "let",
+	Sold:          "sold",
+	Withdrawn:     "withdrawn",
+	Archived:      "archived",
+}
+
+// String returns the name of the status.
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return statusNames[UnknownStatus]
+}
+
+// transitions are the statuses a listing may move to from each status, an archived listing
+// never moves again.
+var transitions = map[Status][]Status{
+	Draft:      {Published, Archived},
+	Published:  {UnderOffer, Let, Sold, Withdrawn},
+	UnderOffer: {Published, Let, Sold, Withdrawn},
+	Let:        {Published, Archived},
+	Sold:       {Archived},
+	Withdrawn:  {Draft, Published, Archived},
+}
+
+// ValidateTransition checks that a listing of the sale type may move from one status to the
+// other, only a property for rent can be let and only a property for sale can be sold.
+func ValidateTransition(from Status, to Status, saleType uint8) error {
+	allowed := false
+	for _, next := range transitions[from] {
+		allowed = allowed || next == to
+	}
+	if !allowed {
+		return errors.Join(ErrIllegalTransition, errors.NewSimple(from.String()+" to "+to.String()))
+	}
+	forRent := SaleType(saleType) == ForRent || SaleType(saleType) == ForBoth
+	forSale := SaleType(saleType) == ForSale || SaleType(saleType) == ForBoth
+	switch {
+	case to == Let && !forRent:
+		return ErrLetNotForRent
+	case to == Sold && !forSale:
+		return ErrSoldNotForSale
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package property
+
+// Suggestions : the distinct values starting with a typed prefix per field, the most common
+// first.
+type Suggestions struct {
+	Cities    []string `json:"cities"`
+	Counties  []string `json:"counties"`
+	Postcodes []string `json:"postcodes"`
+	Titles    []string `json:"titles"`
+}
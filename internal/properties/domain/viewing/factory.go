@@ -0,0 +1,37 @@
+package viewing
+
+import (
+	"time"
+
+	"property-service/pkg/helper/factory"
+)
+
+const (
+	// Factory Config Constants.
+	MaxSchemaVersion = 9999
+)
+
+type Factory[DatabaseID any] interface {
+	New(
+		viewing NewViewingParams,
+	) (*Viewing, error)
+	validate(v *Viewing) error
+	factory.Factory[Viewing, Model[DatabaseID]]
+}
+
+// FactoryConfig is a struct for configuring the factory.
+type FactoryConfig struct {
+	SchemaVersion int
+}
+
+// Validate validates the factory configuration and returns an error if it is invalid.
+func (p FactoryConfig) Validate() error {
+	return nil
+}
+
+type NewViewingParams struct {
+	ViewingID  string    // A new ID is generated when empty.
+	PropertyID string    `validate:"required"`
+	Start      time.Time `validate:"required"`
+	End        time.Time `validate:"required"`
+}
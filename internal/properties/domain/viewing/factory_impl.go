@@ -0,0 +1,110 @@
+package viewing
+
+import (
+	"time"
+
+	"property-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var _ Factory[any] = (*FactoryImpl[any])(nil)
+
+// Factory is a struct that creates and validates the model.
+type FactoryImpl[databaseID comparable] struct {
+	NewID             func() string
+	mapToDomainFunc   func(databaseID) (string, error)
+	mapToDatabaseFunc func(string) (databaseID, error)
+	mapToDomain       func(mapper func(databaseID) (string, error), his Model[databaseID]) (*Viewing, error)
+	mapToDatabase     func(mapper func(string) (databaseID, error), his Viewing) (*Model[databaseID], error)
+	v                 *validator.Validate // validator used for validating the factory configuration
+	fc                FactoryConfig       // configuration for the factory
+}
+
+// NewFactory creates a new Factory with the given configuration and returns an error if the configuration is invalid.
+func NewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Viewing, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Viewing) (*Model[databaseID], error),
+) (FactoryImpl[databaseID], error) {
+	if err := fc.Validate(); err != nil {
+		return FactoryImpl[databaseID]{}, errors.Join(err, errors.ErrInvalidConfigFactory)
+	}
+	return FactoryImpl[databaseID]{
+		fc:                fc,
+		v:                 v,
+		NewID:             newID,
+		mapToDomainFunc:   mappingFunc,
+		mapToDomain:       mapHistory,
+		mapToDatabase:     mapToDatabase,
+		mapToDatabaseFunc: mapToDatabaseFunc,
+	}, nil
+}
+
+// MustNewFactory creates a new Factory with the given configuration and panics if the configuration is invalid.
+func MustNewFactory[databaseID comparable](
+	fc FactoryConfig, v *validator.Validate, newID func() string,
+	mappingFunc func(databaseID) (string, error),
+	mapHistory func(mappingFunc func(databaseID) (string, error), databaseModel Model[databaseID]) (*Viewing, error),
+	mapToDatabaseFunc func(string) (databaseID, error),
+	mapToDatabase func(mappingFunc func(string) (databaseID, error), domainModel Viewing) (*Model[databaseID], error),
+) FactoryImpl[databaseID] {
+	f, err := NewFactory[databaseID](fc, v, newID, mappingFunc, mapHistory, mapToDatabaseFunc, mapToDatabase)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Config returns the configuration for the factory.
+func (fi FactoryImpl[databaseID]) Config() FactoryConfig {
+	return fi.fc
+}
+
+func (fi FactoryImpl[databaseID]) validate(v *Viewing) error {
+	return fi.v.Struct(v)
+}
+
+// New returns a free viewing slot of the property, a slot without an ID is given a new one.
+func (fi FactoryImpl[databaseID]) New(
+	viewing NewViewingParams,
+) (*Viewing, error) {
+	now := time.Now()
+	id := viewing.ViewingID
+	if id == "" {
+		id = fi.NewID()
+	}
+	viewingModel := &Viewing{
+		ID:         id,
+		PropertyID: viewing.PropertyID,
+		Start:      viewing.Start,
+		End:        viewing.End,
+		Metadata: Metadata{
+			createdAt: now,
+			updatedAt: now,
+		},
+	}
+	return viewingModel, fi.validate(viewingModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDomain(viewingDatabaseModel Model[databaseID]) (*Viewing, error) {
+	viewingDomainModel, err := fi.mapToDomain(fi.mapToDomainFunc, viewingDatabaseModel)
+	if err != nil {
+		return nil, err
+	}
+	return viewingDomainModel, fi.validate(viewingDomainModel)
+}
+
+func (fi FactoryImpl[databaseID]) ToDatabase(viewingDomainModel Viewing) (*Model[databaseID], error) {
+	validationErr := fi.validate(&viewingDomainModel)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	viewingDatabaseModel, err := fi.mapToDatabase(fi.mapToDatabaseFunc, viewingDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	return viewingDatabaseModel, nil
+}
@@ -0,0 +1,108 @@
+package viewing
+
+import (
+	"time"
+)
+
+// Booking : the prospective tenant or buyer who booked a viewing slot.
+type Booking struct {
+	ID        string    `bson:"ID" json:"id" validate:"required"`
+	Name      string    `bson:"Name" json:"name" validate:"required,lt=100"`
+	Email     string    `bson:"Email" json:"email" validate:"required,email"`
+	Telephone string    `bson:"Telephone,omitempty" json:"telephone,omitempty" validate:"omitempty,gte=7,lte=15"`
+	BookedAt  time.Time `bson:"BookedAt" json:"bookedAt"`
+}
+
+type Model[ID any] struct {
+	ID         ID            `bson:"_id" validate:"required"`
+	PropertyID string        `bson:"PropertyID" validate:"required"`
+	Start      time.Time     `bson:"Start" validate:"required"`
+	End        time.Time     `bson:"End" validate:"required,gtfield=Start"`
+	Booking    *Booking      `bson:"Booking,omitempty" validate:"omitempty"`
+	Metadata   MetadataModel `bson:"Metadata" validate:"required"`
+}
+
+type MetadataModel struct {
+	CreatedAt time.Time `bson:"CreatedAt"`
+	UpdatedAt time.Time `bson:"UpdatedAt"`
+}
+
+func MapModelToViewing[Old any](
+	mappingFunc func(Old) (string, error),
+	oldViewing Model[Old],
+) (*Viewing, error) {
+	// Map IDs
+	viewingID, err := mappingFunc(oldViewing.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Viewing{
+		ID:         viewingID,
+		PropertyID: oldViewing.PropertyID,
+		Start:      oldViewing.Start,
+		End:        oldViewing.End,
+		Booking:    oldViewing.Booking,
+		Metadata: Metadata{
+			createdAt: oldViewing.Metadata.CreatedAt,
+			updatedAt: oldViewing.Metadata.UpdatedAt,
+		},
+	}, nil
+}
+
+// Viewing : This domain model contains a viewing slot the owner offers for a property, it is
+// free until a single prospective tenant or buyer books it.
+type Viewing struct {
+	ID         string    `json:"id" validate:"required"`
+	PropertyID string    `json:"propertyId" validate:"required"`
+	Start      time.Time `json:"start" validate:"required"`
+	End        time.Time `json:"end" validate:"required,gtfield=Start"`
+	Booking    *Booking  `json:"booking,omitempty" validate:"omitempty"` // Nil while the slot is free.
+	Metadata   Metadata  `json:"metadata" validate:"required"`
+}
+
+type Metadata struct {
+	createdAt time.Time `bson:"CreatedAt"`
+	updatedAt time.Time `bson:"UpdatedAt"`
+}
+
+// CreatedAt : returns when the slot was created.
+func (m Metadata) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// UpdatedAt : returns when the slot was last booked, cancelled or moved.
+func (m Metadata) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+// IsBooked reports whether the slot is booked.
+func (v Viewing) IsBooked() bool {
+	return v.Booking != nil
+}
+
+// Overlaps reports whether the slot shares any time with the one from start up to end.
+func (v Viewing) Overlaps(start time.Time, end time.Time) bool {
+	return v.Start.Before(end) && start.Before(v.End)
+}
+
+func MapViewingToModel[New any](
+	mappingFunc func(string) (New, error),
+	oldViewing Viewing,
+) (*Model[New], error) {
+	// Map IDs
+	viewingID, err := mappingFunc(oldViewing.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Model[New]{
+		ID:         viewingID,
+		PropertyID: oldViewing.PropertyID,
+		Start:      oldViewing.Start,
+		End:        oldViewing.End,
+		Booking:    oldViewing.Booking,
+		Metadata: MetadataModel{
+			CreatedAt: oldViewing.Metadata.createdAt,
+			UpdatedAt: oldViewing.Metadata.updatedAt,
+		},
+	}, nil
+}
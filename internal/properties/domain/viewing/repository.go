@@ -0,0 +1,43 @@
+package viewing
+
+import (
+	"context"
+	"time"
+
+	"property-service/pkg/errors"
+)
+
+var (
+	// ErrNotFound : no viewing slot has the ID.
+	ErrNotFound = errors.NewSimple("viewing slot not found")
+	// ErrSlotBooked : the slot is already booked.
+	ErrSlotBooked = errors.NewSimple("viewing slot is already booked")
+	// ErrBookingNotFound : the slot is not booked by the booking.
+	ErrBookingNotFound = errors.NewSimple("viewing booking not found")
+	// ErrPropertyNotFound : no property has the ID of the slot.
+	ErrPropertyNotFound = errors.NewSimple("property of the viewing slot not found")
+)
+
+// Repository :  handles all the database actions for the viewing slots.
+type Repository interface {
+	// New : creates a free viewing slot, it fails with ErrSlotOverlap when the slot overlaps
+	// another slot of the property, also one created at the same time.
+	New(c context.Context, params NewViewingParams) (*Viewing, error)
+	// Get : returns a single viewing slot by its id.
+	Get(c context.Context, ID string) (*Viewing, error)
+	// Delete : deletes a viewing slot by its id, it fails with ErrSlotBooked when it is booked.
+	Delete(c context.Context, ID string) error
+	// ListByProperty : returns the viewing slots of the property that overlap the time from
+	// start up to end, the earliest first.
+	ListByProperty(c context.Context, propertyID string, start time.Time, end time.Time) ([]Viewing, error)
+
+	// Book : books a free slot in a single write, it fails with ErrSlotBooked when the slot
+	// is already booked so a slot can never be booked twice.
+	Book(c context.Context, ID string, booking Booking) error
+	// Cancel : frees a slot booked by the booking, it fails with ErrBookingNotFound when the
+	// slot is not booked by it.
+	Cancel(c context.Context, ID string, bookingID string) error
+	// Reschedule : moves the booking from the ID slot to the free newID slot in one
+	// transaction, it fails like Book and Cancel and then leaves both slots unchanged.
+	Reschedule(c context.Context, ID string, newID string, booking Booking) error
+}
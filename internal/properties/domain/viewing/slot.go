@@ -0,0 +1,49 @@
+package viewing
+
+import (
+	"time"
+
+	"property-service/internal/properties/domain/property"
+	"property-service/pkg/errors"
+)
+
+// MaxSlotDuration : the longest viewing slot an owner can offer.
+const MaxSlotDuration = 4 * time.Hour
+
+var (
+	// ErrEmptySlot : the slot does not end after it starts.
+	ErrEmptySlot = errors.NewSimple("viewing slot must end after it starts")
+	// ErrSlotTooLong : the slot is longer than MaxSlotDuration.
+	ErrSlotTooLong = errors.NewSimple("viewing slot is longer than " + MaxSlotDuration.String())
+	// ErrSlotInPast : the slot starts before now.
+	ErrSlotInPast = errors.NewSimple("viewing slot starts in the past")
+	// ErrSlotOverlap : the slot overlaps another slot of the property.
+	ErrSlotOverlap = errors.NewSimple("viewing slot overlaps another slot of the property")
+	// ErrOtherProperty : a booking is moved to a slot of another property.
+	ErrOtherProperty = errors.NewSimple("viewing slot is for another property")
+	// ErrListingNotAvailable : the listing is not published so it cannot be viewed.
+	ErrListingNotAvailable = errors.NewSimple("listing is not available for viewings")
+)
+
+// ValidateSlot checks that a slot from start up to end is not empty, not longer than
+// MaxSlotDuration and does not start before now.
+func ValidateSlot(start time.Time, end time.Time, now time.Time) error {
+	switch {
+	case !end.After(start):
+		return ErrEmptySlot
+	case end.Sub(start) > MaxSlotDuration:
+		return ErrSlotTooLong
+	case start.Before(now):
+		return ErrSlotInPast
+	}
+	return nil
+}
+
+// ValidateListing checks that a listing in the status can be booked for a viewing, only a
+// published listing is available.
+func ValidateListing(status property.Status) error {
+	if status != property.Published {
+		return errors.Join(ErrListingNotAvailable, errors.NewSimple(status.String()))
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"property-service/internal/properties/app"
 	"property-service/internal/properties/app/command"
 	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/offer"
 	"property-service/internal/properties/domain/owner"
 	"property-service/internal/properties/domain/property"
 	"property-service/internal/properties/service"
@@ -52,6 +53,154 @@ func (s *ServiceImpl) DeleteProperty(
 	return s.App.Commands.DeleteProperty.Handle(ctx, params)
 }
 
+// Listing lifecycle operations
+func (s *ServiceImpl) PublishProperty(
+	ctx context.Context,
+	params command.MovePropertyCommand,
+) error {
+	return s.App.Commands.PublishProperty.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) MarkPropertyUnderOffer(
+	ctx context.Context,
+	params command.MovePropertyCommand,
+) error {
+	return s.App.Commands.MarkPropertyUnderOffer.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) MarkPropertyLet(
+	ctx context.Context,
+	params command.MovePropertyCommand,
+) error {
+	return s.App.Commands.MarkPropertyLet.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) MarkPropertySold(
+	ctx context.Context,
+	params command.MovePropertyCommand,
+) error {
+	return s.App.Commands.MarkPropertySold.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) WithdrawProperty(
+	ctx context.Context,
+	params command.MovePropertyCommand,
+) error {
+	return s.App.Commands.WithdrawProperty.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ArchiveProperty(
+	ctx context.Context,
+	params command.MovePropertyCommand,
+) error {
+	return s.App.Commands.ArchiveProperty.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) BlockDates(
+	ctx context.Context,
+	params command.BlockDatesCommand,
+) error {
+	return s.App.Commands.BlockDates.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) UnblockDates(
+	ctx context.Context,
+	params command.UnblockDatesCommand,
+) error {
+	return s.App.Commands.UnblockDates.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) GetAvailability(
+	ctx context.Context,
+	params query.GetAvailabilityQuery,
+) (*query.GetAvailabilityResult, error) {
+	return s.App.Queries.GetAvailability.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) CreateViewingSlot(
+	ctx context.Context,
+	params command.CreateViewingSlotCommand,
+) error {
+	return s.App.Commands.CreateViewingSlot.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) DeleteViewingSlot(
+	ctx context.Context,
+	params command.DeleteViewingSlotCommand,
+) error {
+	return s.App.Commands.DeleteViewingSlot.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) BookViewing(
+	ctx context.Context,
+	params command.BookViewingCommand,
+) error {
+	return s.App.Commands.BookViewing.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) CancelViewing(
+	ctx context.Context,
+	params command.CancelViewingCommand,
+) error {
+	return s.App.Commands.CancelViewing.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) RescheduleViewing(
+	ctx context.Context,
+	params command.RescheduleViewingCommand,
+) error {
+	return s.App.Commands.RescheduleViewing.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ListViewingSlots(
+	ctx context.Context,
+	params query.ListViewingSlotsQuery,
+) (*query.ListViewingSlotsResult, error) {
+	return s.App.Queries.ListViewingSlots.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) SubmitOffer(
+	ctx context.Context,
+	params command.SubmitOfferCommand,
+) error {
+	return s.App.Commands.SubmitOffer.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) AcceptOffer(
+	ctx context.Context,
+	params command.AcceptOfferCommand,
+) error {
+	return s.App.Commands.AcceptOffer.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) RejectOffer(
+	ctx context.Context,
+	params command.RejectOfferCommand,
+) error {
+	return s.App.Commands.RejectOffer.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) CounterOffer(
+	ctx context.Context,
+	params command.CounterOfferCommand,
+) error {
+	return s.App.Commands.CounterOffer.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) GetOffer(
+	ctx context.Context,
+	params query.GetOfferQuery,
+) (*offer.Offer, error) {
+	return s.App.Queries.GetOffer.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ListOffers(
+	ctx context.Context,
+	params query.ListOffersQuery,
+) ([]offer.Offer, error) {
+	return s.App.Queries.ListOffers.Handle(ctx, params)
+}
+
 func (s *ServiceImpl) GetProperty(
 	ctx context.Context,
 	params query.GetPropertyQuery,
@@ -73,6 +222,83 @@ func (s *ServiceImpl) ListPropertiesByOwner(
 	return s.App.Queries.ListPropertiesByOwner.Handle(ctx, params)
 }
 
+func (s *ServiceImpl) ListPropertiesNear(
+	ctx context.Context,
+	params query.ListPropertiesNearQuery,
+) (*query.ListPropertiesNearResult, error) {
+	return s.App.Queries.ListPropertiesNear.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ListSimilarProperties(
+	ctx context.Context,
+	params query.ListSimilarPropertiesQuery,
+) (*query.ListSimilarPropertiesResult, error) {
+	return s.App.Queries.ListSimilarProperties.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ListPropertiesWithinArea(
+	ctx context.Context,
+	params query.ListPropertiesWithinAreaQuery,
+) (*query.ListPropertiesWithinAreaResult, error) {
+	return s.App.Queries.ListPropertiesWithinArea.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ListPropertiesWithinCommute(
+	ctx context.Context,
+	params query.ListPropertiesWithinCommuteQuery,
+) (*query.ListPropertiesWithinCommuteResult, error) {
+	return s.App.Queries.ListPropertiesWithinCommute.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) SearchProperties(
+	ctx context.Context,
+	params query.SearchPropertiesQuery,
+) (*query.SearchPropertiesResult, error) {
+	return s.App.Queries.SearchProperties.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) SearchPropertiesByText(
+	ctx context.Context,
+	params query.SearchPropertiesByTextQuery,
+) (*query.SearchPropertiesByTextResult, error) {
+	return s.App.Queries.SearchPropertiesByText.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) GetPropertyFacets(
+	ctx context.Context,
+	params query.GetPropertyFacetsQuery,
+) (*query.GetPropertyFacetsResult, error) {
+	return s.App.Queries.GetPropertyFacets.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) SuggestProperties(
+	ctx context.Context,
+	params query.SuggestPropertiesQuery,
+) (*query.SuggestPropertiesResult, error) {
+	return s.App.Queries.SuggestProperties.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ClusterProperties(
+	ctx context.Context,
+	params query.ClusterPropertiesQuery,
+) (*query.ClusterPropertiesResult, error) {
+	return s.App.Queries.ClusterProperties.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ReverseGeocode(
+	ctx context.Context,
+	params query.ReverseGeocodeQuery,
+) (*query.ReverseGeocodeResult, error) {
+	return s.App.Queries.ReverseGeocode.Handle(ctx, params)
+}
+
+func (s *ServiceImpl) ImportAreas(
+	ctx context.Context,
+	params command.ImportAreasCommand,
+) error {
+	return s.App.Commands.ImportAreas.Handle(ctx, params)
+}
+
 // Owner CRUD operations
 func (s *ServiceImpl) CreateOwner(
 	ctx context.Context,
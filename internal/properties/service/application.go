@@ -2,9 +2,11 @@ package service
 
 import (
 	"property-service/internal/properties/app"
+	"property-service/pkg/address"
 	"property-service/pkg/configs"
 	redis "property-service/pkg/infrastructure/cache"
 	"property-service/pkg/infrastructure/log"
+	"property-service/pkg/pagination"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -16,6 +18,7 @@ type Dependencies struct {
 	L       log.Logger
 	Cacher  redis.Cacher
 	Jwt     jwtManagers
+	Pages   pagination.Manager
 	V       *validator.Validate
 	Config  configs.Config
 }
@@ -35,6 +38,9 @@ func BuildDependencies(config configs.Config) Dependencies {
 	cacher := redis.NewRedisCacher(&config.Caching, logger)
 	// Creates a validator, this is used for validating structs.
 	validator := validator.New()
+	if err := address.RegisterValidation(validator); err != nil {
+		logger.Panic("can not register the address validation %v", err)
+	}
 	// return the dependency object.
 	factories := createFactories(logger, validator, &config)
 	return Dependencies{
@@ -43,7 +49,8 @@ func BuildDependencies(config configs.Config) Dependencies {
 		Cacher:  cacher,
 		V:       validator,
 		Jwt:     createJWTManagers(logger, cacher, validator),
-		Clients: createClients(logger, &config),
+		Pages:   pagination.NewED25519Manager(loadSigningKeys()),
+		Clients: createClients(logger, &config, cacher),
 		Repo:    createRepositories(logger, &config, factories, validator),
 		Factory: factories,
 	}
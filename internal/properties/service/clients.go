@@ -1,16 +1,57 @@
 package service
 
 import (
+	"property-service/pkg/address"
+	"property-service/pkg/commute"
 	"property-service/pkg/configs"
+	redis "property-service/pkg/infrastructure/cache"
 	"property-service/pkg/infrastructure/log"
 )
 
 type client struct {
+	Geocoder address.Geocoder
+	Router   commute.Router
 }
 
 func createClients(
 	l log.Logger,
 	config *configs.Config,
+	cacher redis.Cacher,
 ) client {
-	return client{}
+	return client{
+		Geocoder: createGeocoder(l, config.Geocoding, cacher),
+		Router:   createRouter(l, config.Commute, cacher),
+	}
+}
+
+// createRouter loads the timetable and walking network of the commute search, the isochrones
+// are cached per destination cell. Without a timetable the search is unavailable.
+func createRouter(l log.Logger, config configs.CommuteStruct, cacher redis.Cacher) commute.Router {
+	if config.TimetablePath == "" {
+		return commute.NewUnavailableRouter()
+	}
+	router, err := commute.NewLocalRouter(config.TimetablePath, config.WalkingNetworkPath)
+	if err != nil {
+		l.Panic("failed to load the commute timetable %s: %+v", config.TimetablePath, err)
+	}
+	return commute.NewRouterDecorator(router, cacher, l, commute.RouterPolicy{})
+}
+
+// createGeocoder selects the geocoder of the addresses, Google unless the offline provider
+// is configured, which reads a local postcode dataset and needs no network access. Google
+// is called through a cache, a rate limit and a circuit breaker.
+func createGeocoder(l log.Logger, config configs.GeocodingStruct, cacher redis.Cacher) address.Geocoder {
+	if config.Provider != "offline" {
+		return address.NewGeocoderDecorator(
+			address.NewGoogleGeocoderClient(config.GoogleAPIKey),
+			cacher,
+			l,
+			address.GeocoderPolicy{},
+		)
+	}
+	geocoder, err := address.NewOfflineGeocoderClient(config.DatasetPath)
+	if err != nil {
+		l.Panic("failed to load the geocoder dataset %s: %+v", config.DatasetPath, err)
+	}
+	return geocoder
 }
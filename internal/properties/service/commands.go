@@ -3,6 +3,7 @@ package service
 import (
 	"property-service/internal/properties/app"
 	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/domain/property"
 )
 
 func (d Dependencies) createCommands() app.Commands {
@@ -10,11 +11,17 @@ func (d Dependencies) createCommands() app.Commands {
 		// Property commands
 		CreateProperty: command.NewCreatePropertyHandler(
 			d.Repo.PropertyRepository,
+			d.Clients.Geocoder,
+			d.Repo.AreaRepository,
+			d.Repo.POIRepository,
 			d.L,
 			d.V,
 		),
 		UpdateProperty: command.NewUpdatePropertyHandler(
 			d.Repo.PropertyRepository,
+			d.Clients.Geocoder,
+			d.Repo.AreaRepository,
+			d.Repo.POIRepository,
 			d.L,
 			d.V,
 		),
@@ -23,6 +30,114 @@ func (d Dependencies) createCommands() app.Commands {
 			d.L,
 			d.V,
 		),
+		// Listing lifecycle commands
+		PublishProperty: command.NewMovePropertyHandler(
+			d.Repo.PropertyRepository,
+			property.Published,
+			d.L,
+			d.V,
+		),
+		MarkPropertyUnderOffer: command.NewMovePropertyHandler(
+			d.Repo.PropertyRepository,
+			property.UnderOffer,
+			d.L,
+			d.V,
+		),
+		MarkPropertyLet: command.NewMovePropertyHandler(
+			d.Repo.PropertyRepository,
+			property.Let,
+			d.L,
+			d.V,
+		),
+		MarkPropertySold: command.NewMovePropertyHandler(
+			d.Repo.PropertyRepository,
+			property.Sold,
+			d.L,
+			d.V,
+		),
+		WithdrawProperty: command.NewMovePropertyHandler(
+			d.Repo.PropertyRepository,
+			property.Withdrawn,
+			d.L,
+			d.V,
+		),
+		ArchiveProperty: command.NewMovePropertyHandler(
+			d.Repo.PropertyRepository,
+			property.Archived,
+			d.L,
+			d.V,
+		),
+		// Availability calendar commands
+		BlockDates: command.NewBlockDatesHandler(
+			d.Repo.CalendarRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		UnblockDates: command.NewUnblockDatesHandler(
+			d.Repo.CalendarRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		// Viewing commands
+		CreateViewingSlot: command.NewCreateViewingSlotHandler(
+			d.Repo.ViewingRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		DeleteViewingSlot: command.NewDeleteViewingSlotHandler(
+			d.Repo.ViewingRepository,
+			d.L,
+			d.V,
+		),
+		BookViewing: command.NewBookViewingHandler(
+			d.Repo.ViewingRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		CancelViewing: command.NewCancelViewingHandler(
+			d.Repo.ViewingRepository,
+			d.L,
+			d.V,
+		),
+		RescheduleViewing: command.NewRescheduleViewingHandler(
+			d.Repo.ViewingRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		// Offer and application commands
+		SubmitOffer: command.NewSubmitOfferHandler(
+			d.Repo.OfferRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		AcceptOffer: command.NewAcceptOfferHandler(
+			d.Repo.OfferRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		RejectOffer: command.NewRejectOfferHandler(
+			d.Repo.OfferRepository,
+			d.L,
+			d.V,
+		),
+		CounterOffer: command.NewCounterOfferHandler(
+			d.Repo.OfferRepository,
+			d.L,
+			d.V,
+		),
+		ImportAreas: command.NewImportAreasHandler(
+			d.Repo.AreaRepository,
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
 		// Owner commands
 		CreateOwner: command.NewCreateOwnerHandler(
 			d.Repo.OwnerRepository,
@@ -1,9 +1,15 @@
 package service
 
 import (
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/offer"
 	"property-service/internal/properties/domain/owner"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
 	"property-service/pkg/configs"
+	factoryHelper "property-service/pkg/helper/factory"
 	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
 
@@ -19,6 +25,11 @@ import (
 const (
 	_PROPERTY = "Property"
 	_OWNER    = "Owner"
+	_AREA     = "Area"
+	_POI      = "POI"
+	_CALENDAR = "Calendar"
+	_VIEWING  = "Viewing"
+	_OFFER    = "Offer"
 )
 
 type Property struct {
@@ -38,6 +49,10 @@ type Property struct {
 	Aggregator database.Grouper[
 		mongo.Pipeline, property.Property,
 	]
+	// RawAggregator decodes aggregation results that are not properties, e.g. counts.
+	RawAggregator database.Grouper[
+		mongo.Pipeline, bson.M,
+	]
 }
 
 func createProperty(
@@ -73,6 +88,9 @@ func createProperty(
 	propAggregator := database.NewMongoGrouper(
 		l, factory.Property, connector, _PROPERTY,
 	)
+	propRawAggregator := database.NewMongoGrouper(
+		l, factoryHelper.Identity[bson.M]{}, connector, _PROPERTY,
+	)
 
 	return Property{
 		finder:                        propFinder,
@@ -81,6 +99,7 @@ func createProperty(
 		Inserter:                      propInserter,
 		FinderInsterterUpdaterRemover: propFinderInserterUpdaterRemover,
 		Aggregator:                    propAggregator,
+		RawAggregator:                 propRawAggregator,
 	}
 }
 
@@ -137,3 +156,233 @@ func createOwner(
 		FinderInsterterUpdaterRemover: ownerFinderInserterUpdaterRemover,
 	}
 }
+
+type Area struct {
+	FinderInsterterUpdaterRemover database.FinderInserterUpdaterRemover[
+		bson.M, bson.M, area.Area,
+	]
+	Aggregator database.Grouper[
+		mongo.Pipeline, area.Area,
+	]
+}
+
+func createArea(
+	l log.Logger,
+	factory factories,
+	v *validator.Validate,
+	connector database.Connector[mongo.Client, mongo.ClientEncryption, mongo.Collection],
+	config configs.DatabaseStruct,
+) Area {
+	// Finder
+	areaFinder := database.NewMongoFinder(
+		l, _AREA, factory.Area, connector,
+		options.FindOne(), options.Find())
+	// Updater
+	areaUpdater := database.NewMongoUpdater(
+		l, factory.Area, connector, _AREA,
+	)
+	// Inserter
+	areaInserter := database.NewMongoInserter(
+		l, _AREA, factory.Area, connector,
+	)
+	// Remover
+	areaRemover := database.NewMongoRemover(l, connector, _AREA)
+	// FinderInserterUpdaterRemover
+	areaFinderInserterUpdaterRemover := database.NewMongoFinderInserterUpdaterRemover(
+		areaFinder, areaInserter, areaUpdater, areaRemover,
+	)
+
+	// Aggregator
+	areaAggregator := database.NewMongoGrouper(
+		l, factory.Area, connector, _AREA,
+	)
+
+	return Area{
+		FinderInsterterUpdaterRemover: areaFinderInserterUpdaterRemover,
+		Aggregator:                    areaAggregator,
+	}
+}
+
+type POI struct {
+	FinderInsterterUpdaterRemover database.FinderInserterUpdaterRemover[
+		bson.M, bson.M, poi.POI,
+	]
+	Aggregator database.Grouper[
+		mongo.Pipeline, poi.POI,
+	]
+}
+
+func createPOI(
+	l log.Logger,
+	factory factories,
+	v *validator.Validate,
+	connector database.Connector[mongo.Client, mongo.ClientEncryption, mongo.Collection],
+	config configs.DatabaseStruct,
+) POI {
+	// Finder
+	poiFinder := database.NewMongoFinder(
+		l, _POI, factory.POI, connector,
+		options.FindOne(), options.Find())
+	// Updater
+	poiUpdater := database.NewMongoUpdater(
+		l, factory.POI, connector, _POI,
+	)
+	// Inserter
+	poiInserter := database.NewMongoInserter(
+		l, _POI, factory.POI, connector,
+	)
+	// Remover
+	poiRemover := database.NewMongoRemover(l, connector, _POI)
+	// FinderInserterUpdaterRemover
+	poiFinderInserterUpdaterRemover := database.NewMongoFinderInserterUpdaterRemover(
+		poiFinder, poiInserter, poiUpdater, poiRemover,
+	)
+
+	// Aggregator
+	poiAggregator := database.NewMongoGrouper(
+		l, factory.POI, connector, _POI,
+	)
+
+	return POI{
+		FinderInsterterUpdaterRemover: poiFinderInserterUpdaterRemover,
+		Aggregator:                    poiAggregator,
+	}
+}
+
+type Calendar struct {
+	FinderInsterterUpdaterRemover database.FinderInserterUpdaterRemover[
+		bson.M, bson.M, calendar.Calendar,
+	]
+	Aggregator database.Grouper[
+		mongo.Pipeline, calendar.Calendar,
+	]
+}
+
+func createCalendar(
+	l log.Logger,
+	factory factories,
+	v *validator.Validate,
+	connector database.Connector[mongo.Client, mongo.ClientEncryption, mongo.Collection],
+	config configs.DatabaseStruct,
+) Calendar {
+	// Finder
+	calendarFinder := database.NewMongoFinder(
+		l, _CALENDAR, factory.Calendar, connector,
+		options.FindOne(), options.Find())
+	// Updater
+	calendarUpdater := database.NewMongoUpdater(
+		l, factory.Calendar, connector, _CALENDAR,
+	)
+	// Inserter
+	calendarInserter := database.NewMongoInserter(
+		l, _CALENDAR, factory.Calendar, connector,
+	)
+	// Remover
+	calendarRemover := database.NewMongoRemover(l, connector, _CALENDAR)
+	// FinderInserterUpdaterRemover
+	calendarFinderInserterUpdaterRemover := database.NewMongoFinderInserterUpdaterRemover(
+		calendarFinder, calendarInserter, calendarUpdater, calendarRemover,
+	)
+
+	// Aggregator
+	calendarAggregator := database.NewMongoGrouper(
+		l, factory.Calendar, connector, _CALENDAR,
+	)
+
+	return Calendar{
+		FinderInsterterUpdaterRemover: calendarFinderInserterUpdaterRemover,
+		Aggregator:                    calendarAggregator,
+	}
+}
+
+type Viewing struct {
+	FinderInsterterUpdaterRemover database.FinderInserterUpdaterRemover[
+		bson.M, bson.M, viewing.Viewing,
+	]
+	Aggregator database.Grouper[
+		mongo.Pipeline, viewing.Viewing,
+	]
+}
+
+func createViewing(
+	l log.Logger,
+	factory factories,
+	v *validator.Validate,
+	connector database.Connector[mongo.Client, mongo.ClientEncryption, mongo.Collection],
+	config configs.DatabaseStruct,
+) Viewing {
+	// Finder
+	viewingFinder := database.NewMongoFinder(
+		l, _VIEWING, factory.Viewing, connector,
+		options.FindOne(), options.Find())
+	// Updater
+	viewingUpdater := database.NewMongoUpdater(
+		l, factory.Viewing, connector, _VIEWING,
+	)
+	// Inserter
+	viewingInserter := database.NewMongoInserter(
+		l, _VIEWING, factory.Viewing, connector,
+	)
+	// Remover
+	viewingRemover := database.NewMongoRemover(l, connector, _VIEWING)
+	// FinderInserterUpdaterRemover
+	viewingFinderInserterUpdaterRemover := database.NewMongoFinderInserterUpdaterRemover(
+		viewingFinder, viewingInserter, viewingUpdater, viewingRemover,
+	)
+
+	// Aggregator
+	viewingAggregator := database.NewMongoGrouper(
+		l, factory.Viewing, connector, _VIEWING,
+	)
+
+	return Viewing{
+		FinderInsterterUpdaterRemover: viewingFinderInserterUpdaterRemover,
+		Aggregator:                    viewingAggregator,
+	}
+}
+
+type Offer struct {
+	FinderInsterterUpdaterRemover database.FinderInserterUpdaterRemover[
+		bson.M, bson.M, offer.Offer,
+	]
+	Aggregator database.Grouper[
+		mongo.Pipeline, offer.Offer,
+	]
+}
+
+func createOffer(
+	l log.Logger,
+	factory factories,
+	v *validator.Validate,
+	connector database.Connector[mongo.Client, mongo.ClientEncryption, mongo.Collection],
+	config configs.DatabaseStruct,
+) Offer {
+	// Finder
+	offerFinder := database.NewMongoFinder(
+		l, _OFFER, factory.Offer, connector,
+		options.FindOne(), options.Find())
+	// Updater
+	offerUpdater := database.NewMongoUpdater(
+		l, factory.Offer, connector, _OFFER,
+	)
+	// Inserter
+	offerInserter := database.NewMongoInserter(
+		l, _OFFER, factory.Offer, connector,
+	)
+	// Remover
+	offerRemover := database.NewMongoRemover(l, connector, _OFFER)
+	// FinderInserterUpdaterRemover
+	offerFinderInserterUpdaterRemover := database.NewMongoFinderInserterUpdaterRemover(
+		offerFinder, offerInserter, offerUpdater, offerRemover,
+	)
+
+	// Aggregator
+	offerAggregator := database.NewMongoGrouper(
+		l, factory.Offer, connector, _OFFER,
+	)
+
+	return Offer{
+		FinderInsterterUpdaterRemover: offerFinderInserterUpdaterRemover,
+		Aggregator:                    offerAggregator,
+	}
+}
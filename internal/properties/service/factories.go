@@ -1,8 +1,13 @@
 package service
 
 import (
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/offer"
 	"property-service/internal/properties/domain/owner"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
 	"property-service/pkg/configs"
 	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
@@ -14,6 +19,11 @@ import (
 type factories struct {
 	Property property.Factory[uuid.UUID]
 	Owner    owner.Factory[uuid.UUID]
+	Area     area.Factory[uuid.UUID]
+	POI      poi.Factory[uuid.UUID]
+	Calendar calendar.Factory[uuid.UUID]
+	Viewing  viewing.Factory[uuid.UUID]
+	Offer    offer.Factory[uuid.UUID]
 }
 
 func createFactories(
@@ -44,5 +54,60 @@ func createFactories(
 			database.StringToID,
 			owner.MapOwnerToModel,
 		),
+		Area: area.MustNewFactory(
+			area.FactoryConfig{
+				SchemaVersion: 1,
+			},
+			v,
+			database.NewStringID,
+			database.IDToString,
+			area.MapModelToArea,
+			database.StringToID,
+			area.MapAreaToModel,
+		),
+		POI: poi.MustNewFactory(
+			poi.FactoryConfig{
+				SchemaVersion: 1,
+			},
+			v,
+			database.NewStringID,
+			database.IDToString,
+			poi.MapModelToPOI,
+			database.StringToID,
+			poi.MapPOIToModel,
+		),
+		Calendar: calendar.MustNewFactory(
+			calendar.FactoryConfig{
+				SchemaVersion: 1,
+			},
+			v,
+			database.NewStringID,
+			database.IDToString,
+			calendar.MapModelToCalendar,
+			database.StringToID,
+			calendar.MapCalendarToModel,
+		),
+		Viewing: viewing.MustNewFactory(
+			viewing.FactoryConfig{
+				SchemaVersion: 1,
+			},
+			v,
+			database.NewStringID,
+			database.IDToString,
+			viewing.MapModelToViewing,
+			database.StringToID,
+			viewing.MapViewingToModel,
+		),
+		Offer: offer.MustNewFactory(
+			offer.FactoryConfig{
+				SchemaVersion: 1,
+			},
+			v,
+			database.NewStringID,
+			database.IDToString,
+			offer.MapModelToOffer,
+			database.StringToID,
+			offer.MapOfferToModel,
+		),
 	}
 }
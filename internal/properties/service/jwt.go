@@ -19,10 +19,7 @@ type jwtManagers struct {
 // createJWTManagers : will create and return a the necessary jwt creation objects for the application.
 func createJWTManagers(logger log.Logger, cacher redis.Cacher, v *validator.Validate) jwtManagers {
 	// Load in the public and private keys.
-	keys := signing.MustLoad(
-		os.Getenv("ed25519PublicKey"),
-		os.Getenv("ed25519PrivateKey"),
-	)
+	keys := loadSigningKeys()
 	// Create the authentication jwt manager.
 	authentication := jwt.NewED25519Manager(jwt.InitStruct{
 		Issuer:    "PropertyService",
@@ -37,3 +34,11 @@ func createJWTManagers(logger log.Logger, cacher redis.Cacher, v *validator.Vali
 		authentication: authentication,
 	}
 }
+
+// loadSigningKeys : loads the Ed25519 key pair the service signs its tokens with.
+func loadSigningKeys() signing.Ed25519KeyPair {
+	return signing.MustLoad(
+		os.Getenv("ed25519PublicKey"),
+		os.Getenv("ed25519PrivateKey"),
+	)
+}
@@ -19,11 +19,94 @@ func (d Dependencies) createQueries() app.Queries {
 		),
 		ListPropertiesByCategory: query.NewListPropertiesByCategoryHandler(
 			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.Pages,
 			d.L,
 			d.V,
 		),
 		ListPropertiesByOwner: query.NewListPropertiesByOwnerHandler(
 			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.Pages,
+			d.L,
+			d.V,
+		),
+		ListPropertiesNear: query.NewListPropertiesNearHandler(
+			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.L,
+			d.V,
+		),
+		ListSimilarProperties: query.NewListSimilarPropertiesHandler(
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		ListPropertiesWithinArea: query.NewListPropertiesWithinAreaHandler(
+			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.Pages,
+			d.L,
+			d.V,
+		),
+		ListPropertiesWithinCommute: query.NewListPropertiesWithinCommuteHandler(
+			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.Clients.Router,
+			d.L,
+			d.V,
+		),
+		ClusterProperties: query.NewClusterPropertiesHandler(
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		SearchProperties: query.NewSearchPropertiesHandler(
+			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.Pages,
+			d.L,
+			d.V,
+		),
+		SearchPropertiesByText: query.NewSearchPropertiesByTextHandler(
+			d.Repo.PropertyRepository,
+			d.Pages,
+			d.L,
+			d.V,
+		),
+		GetPropertyFacets: query.NewGetPropertyFacetsHandler(
+			d.Repo.PropertyRepository,
+			d.Repo.CalendarRepository,
+			d.L,
+			d.V,
+		),
+		SuggestProperties: query.NewSuggestPropertiesHandler(
+			d.Repo.PropertyRepository,
+			d.L,
+			d.V,
+		),
+		GetAvailability: query.NewGetAvailabilityHandler(
+			d.Repo.CalendarRepository,
+			d.L,
+			d.V,
+		),
+		ListViewingSlots: query.NewListViewingSlotsHandler(
+			d.Repo.ViewingRepository,
+			d.L,
+			d.V,
+		),
+		GetOffer: query.NewGetOfferHandler(
+			d.Repo.OfferRepository,
+			d.L,
+			d.V,
+		),
+		ListOffers: query.NewListOffersHandler(
+			d.Repo.OfferRepository,
+			d.L,
+			d.V,
+		),
+		ReverseGeocode: query.NewReverseGeocodeHandler(
+			d.Clients.Geocoder,
 			d.L,
 			d.V,
 		),
@@ -1,9 +1,17 @@
 package service
 
 import (
+	"context"
+
 	"property-service/internal/properties/adapters"
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/calendar"
+	"property-service/internal/properties/domain/offer"
 	"property-service/internal/properties/domain/owner"
+	"property-service/internal/properties/domain/poi"
 	"property-service/internal/properties/domain/property"
+	"property-service/internal/properties/domain/viewing"
+	"property-service/pkg/address"
 	"property-service/pkg/configs"
 	"property-service/pkg/infrastructure/database"
 	"property-service/pkg/infrastructure/log"
@@ -16,6 +24,11 @@ const _DatabaseName = "properties"
 type repositories struct {
 	PropertyRepository property.Repository
 	OwnerRepository    owner.Repository
+	AreaRepository     area.Repository
+	POIRepository      poi.Repository
+	CalendarRepository calendar.Repository
+	ViewingRepository  viewing.Repository
+	OfferRepository    offer.Repository
 }
 
 func createRepositories(
@@ -38,12 +51,51 @@ func createRepositories(
 		config.Database,
 	)
 
+	creator := database.NewMongoCreator(l, connector)
+	// The attribute filters of the near, commute and keyset searches match on these paths,
+	// the amenities and accessibility features are multikey indexes.
+	for _, key := range []string{
+		"Attributes.Bedrooms",
+		"Attributes.Bathrooms",
+		"Attributes.FloorArea.SquareMetres",
+		"Attributes.Parking",
+		"Attributes.Amenities",
+		"Attributes.Accessibility",
+	} {
+		if _, err := creator.CreateCompoundIndex(context.Background(), _PROPERTY, key); err != nil {
+			l.Error("failed to create the property attribute index %s: %+v", key, err)
+		}
+	}
+
 	propRepo := adapters.NewMongoPropertyRepository(
 		l,
 		prop.FinderInsterterUpdaterRemover,
 		factory.Property,
 		prop.Aggregator,
+		prop.RawAggregator,
+		createPaginationHelper(l, config.Database, creator),
 	)
+	// The properties stored before the listing statuses had an Available flag instead.
+	migrated, err := propRepo.MigrateAvailability(context.Background())
+	if err != nil {
+		l.Error("failed to migrate the property availability: %+v", err)
+	} else if migrated > 0 {
+		l.Info("gave %d properties a listing status", migrated)
+	}
+	// The coordinates stored before the GeoJSON fields were renamed are rewritten first, the
+	// geo index rejects them.
+	migrated, err = propRepo.MigrateGeoJSON(context.Background())
+	if err != nil {
+		l.Error("failed to migrate the property coordinates: %+v", err)
+	} else if migrated > 0 {
+		l.Info("rewrote the coordinates of %d properties", migrated)
+	}
+	// The geo queries need a 2dsphere index on the property coordinates.
+	if _, err := creator.CreateIndex(
+		context.Background(), _PROPERTY, "Address.GeoJSON", "2dsphere",
+	); err != nil {
+		l.Error("failed to create the property geo index: %+v", err)
+	}
 
 	owner := createOwner(
 		l,
@@ -58,8 +110,201 @@ func createRepositories(
 		owner.FinderInsterterUpdaterRemover,
 		factory.Owner,
 	)
+
+	area := createArea(
+		l,
+		factory,
+		v,
+		connector,
+		config.Database,
+	)
+	// The properties are tagged with the areas whose boundary intersects their coordinates.
+	if _, err := creator.CreateIndex(
+		context.Background(), _AREA, "Boundary", "2dsphere",
+	); err != nil {
+		l.Error("failed to create the area geo index: %+v", err)
+	}
+
+	areaRepo := adapters.NewMongoAreaRepository(
+		l,
+		area.FinderInsterterUpdaterRemover,
+		factory.Area,
+		area.Aggregator,
+	)
+	// An area is saved by its key, the unique index keeps the upserts from storing it twice.
+	// The areas imported twice before it existed are removed when it cannot be built.
+	if _, err := creator.CreateUniqueIndex(context.Background(), _AREA, "Key"); err != nil {
+		removed, migrateErr := areaRepo.MigrateDuplicateKeys(context.Background())
+		if migrateErr != nil {
+			l.Error("failed to remove the duplicate areas: %+v", migrateErr)
+		} else if removed > 0 {
+			l.Info("removed %d duplicate areas", removed)
+		}
+		if _, err := creator.CreateUniqueIndex(context.Background(), _AREA, "Key"); err != nil {
+			l.Error("failed to create the area key index: %+v", err)
+		}
+	}
+
+	poi := createPOI(
+		l,
+		factory,
+		v,
+		connector,
+		config.Database,
+	)
+	// The nearest points of interest are found with $geoNear on their location.
+	if _, err := creator.CreateIndex(
+		context.Background(), _POI, "Location", "2dsphere",
+	); err != nil {
+		l.Error("failed to create the point of interest geo index: %+v", err)
+	}
+
+	poiRepo := adapters.NewMongoPOIRepository(
+		l,
+		poi.FinderInsterterUpdaterRemover,
+		factory.POI,
+		poi.Aggregator,
+		database.NewMongoSession(connector),
+	)
+	loadPOIs(l, config.POIs, poiRepo, propRepo)
+
+	calendar := createCalendar(
+		l,
+		factory,
+		v,
+		connector,
+		config.Database,
+	)
+	// A property has a single calendar, its ranges are matched by the available between filter.
+	if _, err := creator.CreateUniqueIndex(context.Background(), _CALENDAR, "PropertyID"); err != nil {
+		l.Error("failed to create the calendar property index: %+v", err)
+	}
+	if _, err := creator.CreateCompoundIndex(
+		context.Background(), _CALENDAR, "Ranges.Start", "Ranges.End",
+	); err != nil {
+		l.Error("failed to create the calendar range index: %+v", err)
+	}
+
+	calendarRepo := adapters.NewMongoCalendarRepository(
+		l,
+		calendar.FinderInsterterUpdaterRemover,
+		factory.Calendar,
+		calendar.Aggregator,
+	)
+
+	viewing := createViewing(
+		l,
+		factory,
+		v,
+		connector,
+		config.Database,
+	)
+	// The slots of a property are listed and checked for overlaps by their times.
+	if _, err := creator.CreateCompoundIndex(
+		context.Background(), _VIEWING, "PropertyID", "Start", "End",
+	); err != nil {
+		l.Error("failed to create the viewing property index: %+v", err)
+	}
+
+	// A slot is checked for overlaps and inserted in one transaction that locks its property.
+	viewingRepo := adapters.NewMongoViewingRepository(
+		l,
+		viewing.FinderInsterterUpdaterRemover,
+		factory.Viewing,
+		viewing.Aggregator,
+		prop.FinderInsterterUpdaterRemover,
+		database.NewMongoSession(connector),
+	)
+
+	offer := createOffer(
+		l,
+		factory,
+		v,
+		connector,
+		config.Database,
+	)
+	// The open offers of a property are declined together when one is accepted.
+	if _, err := creator.CreateCompoundIndex(
+		context.Background(), _OFFER, "PropertyID", "Status",
+	); err != nil {
+		l.Error("failed to create the offer property index: %+v", err)
+	}
+
+	// Accepting an offer also moves the listing, both are written in one transaction.
+	offerRepo := adapters.NewMongoOfferRepository(
+		l,
+		offer.FinderInsterterUpdaterRemover,
+		factory.Offer,
+		offer.Aggregator,
+		database.NewMongoSession(connector),
+		propRepo,
+	)
 	return repositories{
 		PropertyRepository: propRepo,
 		OwnerRepository:    ownerRepo,
+		AreaRepository:     areaRepo,
+		POIRepository:      poiRepo,
+		CalendarRepository: calendarRepo,
+		ViewingRepository:  viewingRepo,
+		OfferRepository:    offerRepo,
+	}
+}
+
+// loadPOIs replaces the stored points of interest with the configured dataset, the stored
+// ones are kept when no dataset is configured or they were loaded from the same file. The
+// nearest points of interest of the stored properties are refreshed when the dataset changed.
+func loadPOIs(l log.Logger, config configs.POIStruct, repo poi.Repository, properties property.Repository) {
+	if config.DatasetPath == "" {
+		return
+	}
+	pois, dataset, err := poi.LoadFile(config.DatasetPath)
+	if err != nil {
+		l.Panic("failed to load the points of interest %s: %+v", config.DatasetPath, err)
+	}
+	count, changed, err := repo.Replace(context.Background(), dataset, pois)
+	if err != nil {
+		l.Panic("failed to store the points of interest %s: %+v", config.DatasetPath, err)
+	}
+	if !changed {
+		l.Info("kept the %d points of interest of %s", count, config.DatasetPath)
+		return
+	}
+	l.Info("loaded %d points of interest from %s", count, config.DatasetPath)
+	refreshed, err := properties.RefreshNearby(
+		context.Background(),
+		func(c context.Context, point address.GeoJSONCoordinates) (map[string]property.NearbyPOI, error) {
+			found, err := repo.NearestPerType(c, point, poi.DefaultMaxDistance)
+			return poi.Nearby(found), err
+		},
+	)
+	if err != nil {
+		l.Error("failed to refresh the nearest points of interest of the properties: %+v", err)
+		return
+	}
+	l.Info("refreshed the nearest points of interest of %d properties", refreshed)
+}
+
+// createPaginationHelper selects the pagination backend of the property lists, Atlas Search
+// unless the keyset backend is configured, which pages on normal indexes.
+func createPaginationHelper(
+	l log.Logger,
+	config configs.DatabaseStruct,
+	creator database.Creator,
+) database.PaginationHelper {
+	if config.Pagination != "keyset" {
+		return &database.PaginationHelperMongoImpl{}
+	}
+
+	// The keyset cursors sort on Title and _id after the equality and status filters of each
+	// list.
+	for _, keys := range [][]string{
+		{"Category", "Status", "Title", "_id"},
+		{"OwnerID", "Status", "Title", "_id"},
+		{"Status", "Title", "_id"},
+	} {
+		if _, err := creator.CreateCompoundIndex(context.Background(), _PROPERTY, keys...); err != nil {
+			l.Error("failed to create the property keyset index %v: %+v", keys, err)
+		}
 	}
+	return &database.KeysetPaginationHelperMongoImpl{}
 }
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"property-service/api/proto"
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/offer"
+	port "property-service/internal/properties/ports"
+	"property-service/pkg/infrastructure/database"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MyOfferService implements proto.OfferServiceServer.
+type MyOfferService struct {
+	proto.UnimplementedOfferServiceServer
+	AppService *port.ServiceImpl
+}
+
+func (s *MyOfferService) SubmitOffer(ctx context.Context, req *proto.SubmitOfferRequest) (*proto.SubmitOfferResponse, error) {
+	s.AppService.Log.Debug("Submitting offer on property with ID:", req.PropertyId)
+	id := req.Id
+	if id == "" {
+		id = database.NewStringID()
+	}
+	var values uint8Values
+	kind := offer.Kind(values.convert("kind", req.Kind))
+	if values.err != nil {
+		s.AppService.Log.Error("Invalid offer kind", values.err)
+		return nil, values.err
+	}
+	err := s.AppService.SubmitOffer(ctx, command.SubmitOfferCommand{
+		OfferID:    id,
+		PropertyID: req.PropertyId,
+		Kind:       kind,
+		Name:       req.Name,
+		Email:      req.Email,
+		Telephone:  req.Telephone,
+		Amount:     req.GetAmount().GetAmount(),
+		Currency:   req.GetAmount().GetCurrency(),
+		Conditions: req.Conditions,
+		Message:    req.Message,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to submit offer", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Offer submitted successfully")
+	// Return the response
+	return &proto.SubmitOfferResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *MyOfferService) GetOffer(ctx context.Context, req *proto.GetOfferRequest) (*proto.GetOfferResponse, error) {
+	s.AppService.Log.Debug("Reading offer with ID:", req.Id)
+	found, err := s.AppService.GetOffer(ctx, query.GetOfferQuery{
+		ID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to read offer", err)
+		return nil, err
+	}
+	// Return the response
+	return &proto.GetOfferResponse{
+		Offer: toProtoOffer(*found),
+	}, nil
+}
+
+func (s *MyOfferService) ListOffers(ctx context.Context, req *proto.ListOffersRequest) (*proto.ListOffersResponse, error) {
+	s.AppService.Log.Debug("Listing offers on property with ID:", req.PropertyId)
+	var values uint8Values
+	statuses := make([]offer.Status, 0, len(req.Statuses))
+	for _, status := range req.Statuses {
+		statuses = append(statuses, offer.Status(values.convert("status", status)))
+	}
+	if values.err != nil {
+		s.AppService.Log.Error("Invalid offer status", values.err)
+		return nil, values.err
+	}
+	offers, err := s.AppService.ListOffers(ctx, query.ListOffersQuery{
+		PropertyID: req.PropertyId,
+		Statuses:   statuses,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to list offers", err)
+		return nil, err
+	}
+	protoOffers := make([]*proto.Offer, 0, len(offers))
+	for _, found := range offers {
+		protoOffers = append(protoOffers, toProtoOffer(found))
+	}
+	return &proto.ListOffersResponse{
+		Offers: protoOffers,
+	}, nil
+}
+
+func (s *MyOfferService) AcceptOffer(ctx context.Context, req *proto.AcceptOfferRequest) (*proto.AcceptOfferResponse, error) {
+	s.AppService.Log.Debug("Accepting offer with ID:", req.Id)
+	err := s.AppService.AcceptOffer(ctx, command.AcceptOfferCommand{
+		OfferID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to accept offer", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Offer accepted successfully")
+	// Return the response
+	return &proto.AcceptOfferResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyOfferService) RejectOffer(ctx context.Context, req *proto.RejectOfferRequest) (*proto.RejectOfferResponse, error) {
+	s.AppService.Log.Debug("Rejecting offer with ID:", req.Id)
+	err := s.AppService.RejectOffer(ctx, command.RejectOfferCommand{
+		OfferID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to reject offer", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Offer rejected successfully")
+	// Return the response
+	return &proto.RejectOfferResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyOfferService) CounterOffer(ctx context.Context, req *proto.CounterOfferRequest) (*proto.CounterOfferResponse, error) {
+	s.AppService.Log.Debug("Countering offer with ID:", req.Id)
+	err := s.AppService.CounterOffer(ctx, command.CounterOfferCommand{
+		OfferID: req.Id,
+		Amount:  req.Amount,
+		Message: req.Message,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to counter offer", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Offer countered successfully")
+	// Return the response
+	return &proto.CounterOfferResponse{
+		Id: req.Id,
+	}, nil
+}
+
+// toProtoOffer converts a domain offer into its proto representation.
+func toProtoOffer(domainOffer offer.Offer) *proto.Offer {
+	protoOffer := &proto.Offer{
+		Id:         domainOffer.ID,
+		PropertyId: domainOffer.PropertyID,
+		Kind:       uint32(domainOffer.Kind),
+		Name:       domainOffer.Applicant.Name,
+		Email:      domainOffer.Applicant.Email,
+		Telephone:  domainOffer.Applicant.Telephone,
+		Amount:     toProtoMoney(&domainOffer.Amount),
+		Conditions: domainOffer.Conditions,
+		Message:    domainOffer.Message,
+		Status:     uint32(domainOffer.Status),
+		CreatedAt:  timestamppb.New(domainOffer.Metadata.CreatedAt()),
+		UpdatedAt:  timestamppb.New(domainOffer.Metadata.UpdatedAt()),
+	}
+	if domainOffer.Counter != nil {
+		protoOffer.CounterAmount = toProtoMoney(&domainOffer.Counter.Amount)
+		protoOffer.CounterMessage = domainOffer.Counter.Message
+	}
+	return protoOffer
+}
@@ -2,12 +2,20 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"time"
 
 	"property-service/api/proto"
 	"property-service/internal/properties/app/command"
 	"property-service/internal/properties/app/query"
+	"property-service/internal/properties/domain/area"
+	"property-service/internal/properties/domain/calendar"
+	domain "property-service/internal/properties/domain/property"
 	port "property-service/internal/properties/ports"
 	"property-service/pkg/address"
+	"property-service/pkg/errors"
+	"property-service/pkg/money"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -21,27 +29,23 @@ type MyPropertyService struct {
 
 func (s *MyPropertyService) CreateProperty(ctx context.Context, req *proto.CreatePropertyRequest) (*proto.CreatePropertyResponse, error) {
 	s.AppService.Log.Debug("Creating new property")
-	err := s.AppService.CreateProperty(ctx, command.CreatePropertyCommand{
-		PropertyID: req.Id,
-		OwnerID:    req.OwnerID,
-		Address: address.Address{
-			FirstLine:  req.Address.FirstLine,
-			Street:     req.Address.Street,
-			City:       req.Address.City,
-			County:     req.Address.County,
-			Country:    req.Address.Country,
-			PostalCode: req.Address.Postcode,
-			GeoJSON: &address.GeoJSONCoordinates{
-				Type:        "Point",
-				Coordinates: [2]float64{float64(*req.Address.Latitude), float64(*req.Address.Longitude)},
-			},
-		},
+	attributes, err := toAttributes(req.GetAttributes())
+	if err != nil {
+		s.AppService.Log.Error("Invalid property attributes", err)
+		return nil, err
+	}
+	err = s.AppService.CreateProperty(ctx, command.CreatePropertyCommand{
+		PropertyID:    req.Id,
+		OwnerID:       req.OwnerID,
+		Address:       toAddress(req.GetAddress()),
 		Description:   req.Description,
 		Title:         req.Title,
 		Category:      req.Category,
-		Available:     req.Available,
 		AvailableDate: req.AvailableDate.AsTime(),
 		SaleType:      uint8(req.SaleType),
+		Rent:          toRent(req.GetRent()),
+		AskingPrice:   toMoney(req.GetAskingPrice()),
+		Attributes:    attributes,
 	})
 	if err != nil {
 		s.AppService.Log.Error("Failed to create property", err)
@@ -93,34 +97,44 @@ func (s *MyPropertyService) ReadProperty(ctx context.Context, req *proto.ReadPro
 		Description:   property.Description,
 		Title:         property.Title,
 		AvailableDate: timestamppb.New(property.AvailableDate),
-		Available:     wrapperspb.Bool(property.Available),
+		Status:        uint32(property.Status),
 		SaleType:      uint32(property.SaleType),
 		Category:      property.Category,
+		Areas:         property.Areas,
+		Nearby:        toProtoNearby(property.Nearby),
+		Rent:          toProtoRent(property.Rent),
+		AskingPrice:   toProtoMoney(property.AskingPrice),
+		Attributes:    toProtoAttributes(property.Attributes),
 	}, nil
 }
 
 func (s *MyPropertyService) UpdateProperty(ctx context.Context, req *proto.UpdatePropertyRequest) (*proto.UpdatePropertyResponse, error) {
 	s.AppService.Log.Debug("Updating property with ID:", req.Id)
 
-	err := s.AppService.UpdateProperty(ctx, command.UpdatePropertyCommand{
-		PropertyID: req.Id,
-		Address: address.Address{
-			FirstLine:  req.Address.FirstLine,
-			Street:     req.Address.Street,
-			City:       req.Address.City,
-			County:     req.Address.County,
-			Country:    req.Address.Country,
-			PostalCode: req.Address.Postcode,
-			GeoJSON: &address.GeoJSONCoordinates{
-				Type:        "Point",
-				Coordinates: [2]float64{float64(*req.Address.Latitude), float64(*req.Address.Longitude)},
-			},
-		},
+	var category string
+	if len(req.Category) > 0 {
+		category = req.Category[0]
+	}
+	var availableDate time.Time
+	if req.AvailableDate != nil {
+		availableDate = req.AvailableDate.AsTime()
+	}
+	attributes, err := toAttributes(req.GetAttributes())
+	if err != nil {
+		s.AppService.Log.Error("Invalid property attributes", err)
+		return nil, err
+	}
+	err = s.AppService.UpdateProperty(ctx, command.UpdatePropertyCommand{
+		PropertyID:    req.Id,
+		Address:       toAddress(req.GetAddress()),
 		Description:   req.Description,
 		Title:         req.Title,
-		Category:      req.Category[0],
-		AvailableDate: req.AvailableDate.AsTime(),
+		Category:      category,
+		AvailableDate: availableDate,
 		SaleType:      uint8(req.SaleType),
+		Rent:          toRent(req.GetRent()),
+		AskingPrice:   toMoney(req.GetAskingPrice()),
+		Attributes:    attributes,
 		Server:        "Test",
 	})
 	if err != nil {
@@ -150,111 +164,976 @@ func (s *MyPropertyService) DeleteProperty(ctx context.Context, req *proto.Delet
 	}, nil
 }
 
+func (s *MyPropertyService) PublishProperty(ctx context.Context, req *proto.ChangePropertyStatusRequest) (*proto.ChangePropertyStatusResponse, error) {
+	s.AppService.Log.Debug("Publishing property with ID:", req.Id)
+	err := s.AppService.PublishProperty(ctx, command.MovePropertyCommand{
+		PropertyID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to publish property", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property published successfully")
+	return &proto.ChangePropertyStatusResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) MarkPropertyUnderOffer(ctx context.Context, req *proto.ChangePropertyStatusRequest) (*proto.ChangePropertyStatusResponse, error) {
+	s.AppService.Log.Debug("Marking property under offer with ID:", req.Id)
+	err := s.AppService.MarkPropertyUnderOffer(ctx, command.MovePropertyCommand{
+		PropertyID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to mark property under offer", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property marked under offer successfully")
+	return &proto.ChangePropertyStatusResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) MarkPropertyLet(ctx context.Context, req *proto.ChangePropertyStatusRequest) (*proto.ChangePropertyStatusResponse, error) {
+	s.AppService.Log.Debug("Marking property let with ID:", req.Id)
+	err := s.AppService.MarkPropertyLet(ctx, command.MovePropertyCommand{
+		PropertyID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to mark property let", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property marked let successfully")
+	return &proto.ChangePropertyStatusResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) MarkPropertySold(ctx context.Context, req *proto.ChangePropertyStatusRequest) (*proto.ChangePropertyStatusResponse, error) {
+	s.AppService.Log.Debug("Marking property sold with ID:", req.Id)
+	err := s.AppService.MarkPropertySold(ctx, command.MovePropertyCommand{
+		PropertyID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to mark property sold", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property marked sold successfully")
+	return &proto.ChangePropertyStatusResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) WithdrawProperty(ctx context.Context, req *proto.ChangePropertyStatusRequest) (*proto.ChangePropertyStatusResponse, error) {
+	s.AppService.Log.Debug("Withdrawing property with ID:", req.Id)
+	err := s.AppService.WithdrawProperty(ctx, command.MovePropertyCommand{
+		PropertyID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to withdraw property", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property withdrawn successfully")
+	return &proto.ChangePropertyStatusResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) ArchiveProperty(ctx context.Context, req *proto.ChangePropertyStatusRequest) (*proto.ChangePropertyStatusResponse, error) {
+	s.AppService.Log.Debug("Archiving property with ID:", req.Id)
+	err := s.AppService.ArchiveProperty(ctx, command.MovePropertyCommand{
+		PropertyID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to archive property", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property archived successfully")
+	return &proto.ChangePropertyStatusResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) BlockDates(ctx context.Context, req *proto.BlockDatesRequest) (*proto.BlockDatesResponse, error) {
+	s.AppService.Log.Debug("Blocking dates of property with ID:", req.Id)
+	err := s.AppService.BlockDates(ctx, command.BlockDatesCommand{
+		PropertyID: req.Id,
+		Start:      toTime(req.GetStart()),
+		End:        toTime(req.GetEnd()),
+		Kind:       calendar.Kind(req.GetKind()),
+		Note:       req.GetNote(),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to block dates", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Dates blocked successfully")
+	return &proto.BlockDatesResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) UnblockDates(ctx context.Context, req *proto.UnblockDatesRequest) (*proto.UnblockDatesResponse, error) {
+	s.AppService.Log.Debug("Unblocking dates of property with ID:", req.Id)
+	err := s.AppService.UnblockDates(ctx, command.UnblockDatesCommand{
+		PropertyID: req.Id,
+		Start:      toTime(req.GetStart()),
+		End:        toTime(req.GetEnd()),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to unblock dates", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Dates unblocked successfully")
+	return &proto.UnblockDatesResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyPropertyService) GetAvailability(ctx context.Context, req *proto.GetAvailabilityRequest) (*proto.GetAvailabilityResponse, error) {
+	s.AppService.Log.Debug("Getting availability of property with ID:", req.Id)
+	availability, err := s.AppService.GetAvailability(ctx, query.GetAvailabilityQuery{
+		PropertyID: req.Id,
+		Start:      toTime(req.GetStart()),
+		End:        toTime(req.GetEnd()),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to get availability", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Availability retrieved successfully")
+	ranges := make([]*proto.DateRange, 0, len(availability.Ranges))
+	for _, dateRange := range availability.Ranges {
+		ranges = append(ranges, &proto.DateRange{
+			Start: timestamppb.New(dateRange.Start),
+			End:   timestamppb.New(dateRange.End),
+			Kind:  uint32(dateRange.Kind),
+			Note:  dateRange.Note,
+		})
+	}
+	return &proto.GetAvailabilityResponse{
+		Id:        availability.PropertyID,
+		Available: availability.Available,
+		Ranges:    ranges,
+	}, nil
+}
+
+// toTime converts a proto timestamp into a time, the zero time when it is unset.
+func toTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
+
 func (s *MyPropertyService) ListPropertyByCategory(ctx context.Context, req *proto.PropertyListByCategoryRequest) (*proto.ListPropertyResponse, error) {
 	s.AppService.Log.Debug("Listing properties")
 	properties, err := s.AppService.ListPropertiesByCategory(ctx, query.ListPropertiesByCategoryQuery{
 		Category:        req.Category,
-		Sort:            uint8(req.Sort),
+		Area:            req.Area,
+		FreeFrom:        toTime(req.GetFreeFrom()),
+		FreeTo:          toTime(req.GetFreeTo()),
+		Sort:            domain.ParseSort(req.SortBy),
 		Limit:           uint16(req.Limit),
 		PaginationToken: req.PaginationToken,
-		Search:          uint8(req.Search),
+		TotalCount:      req.IncludeTotalCount,
 	})
 	if err != nil {
 		s.AppService.Log.Error("Failed to list properties", err)
 		return nil, err
 	}
 	s.AppService.Log.Debug("Properties listed successfully")
-	// Convert properties to proto format
-
-	var propertyList []*proto.Property
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
 	for _, property := range properties.Properties {
-		var latitude *float32
-		var longitude *float32
-
-		if property.Address.GeoJSON != nil {
-			lat := float32(property.Address.GeoJSON.Coordinates[0])
-			lng := float32(property.Address.GeoJSON.Coordinates[1])
-			latitude = &lat
-			longitude = &lng
-		}
-		s.AppService.Log.Debug("Converting property to proto format: %s", property.ID)
-		propertyList = append(propertyList, &proto.Property{
-			Id:      property.ID,
-			OwnerID: property.OwnerID,
-			Address: &proto.Address{
-				FirstLine: property.Address.FirstLine,
-				Street:    property.Address.Street,
-				City:      property.Address.City,
-				County:    property.Address.County,
-				Country:   property.Address.Country,
-				Postcode:  property.Address.PostalCode,
-				Latitude:  latitude,
-				Longitude: longitude,
-			},
-			Description:     property.Description,
-			Title:           property.Title,
-			AvailableDate:   timestamppb.New(property.AvailableDate),
-			Available:       wrapperspb.Bool(property.Available),
-			SaleType:        uint32(property.SaleType),
-			Category:        property.Category,
-			PaginationToken: property.PaginationToken,
-		})
+		propertyList = append(propertyList, toProtoProperty(property))
 	}
-	return &proto.ListPropertyResponse{
-		Properties: propertyList,
-	}, nil
+	return toListPropertyResponse(propertyList, properties.Page), nil
 }
 
 func (s *MyPropertyService) ListPropertyByOwner(ctx context.Context, req *proto.PropertyListByOwnerRequest) (*proto.ListPropertyResponse, error) {
 	s.AppService.Log.Debug("Listing properties by owner")
+	statuses, err := toStatuses(req.GetStatuses())
+	if err != nil {
+		s.AppService.Log.Error("Invalid listing statuses", err)
+		return nil, err
+	}
 	properties, err := s.AppService.ListPropertiesByOwner(ctx, query.ListPropertiesByOwnerQuery{
 		Server:          "Test",
 		Owner:           req.OwnerID,
-		Sort:            uint8(req.Sort),
+		Area:            req.Area,
+		Statuses:        statuses,
+		FreeFrom:        toTime(req.GetFreeFrom()),
+		FreeTo:          toTime(req.GetFreeTo()),
+		Sort:            domain.ParseSort(req.SortBy),
 		Limit:           uint16(req.Limit),
 		PaginationToken: req.PaginationToken,
-		Search:          uint8(req.Search),
+		TotalCount:      req.IncludeTotalCount,
 	})
 	if err != nil {
 		s.AppService.Log.Error("Failed to list properties by owner", err)
 		return nil, err
 	}
 	s.AppService.Log.Debug("Properties by owner listed successfully")
-	var propertyList []*proto.Property
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
 	for _, property := range properties.Properties {
-		var latitude *float32
-		var longitude *float32
+		propertyList = append(propertyList, toProtoProperty(property))
+	}
+	return toListPropertyResponse(propertyList, properties.Page), nil
+}
 
-		if property.Address.GeoJSON != nil {
-			lat := float32(property.Address.GeoJSON.Coordinates[0])
-			lng := float32(property.Address.GeoJSON.Coordinates[1])
-			latitude = &lat
-			longitude = &lng
+func (s *MyPropertyService) ListPropertiesNear(ctx context.Context, req *proto.PropertyListNearRequest) (*proto.ListPropertyResponse, error) {
+	s.AppService.Log.Debug("Listing properties near %f,%f", req.Latitude, req.Longitude)
+	attributes, err := toAttributeFilter(req.GetAttributes())
+	if err != nil {
+		s.AppService.Log.Error("Invalid attribute filter", err)
+		return nil, err
+	}
+	properties, err := s.AppService.ListPropertiesNear(ctx, query.ListPropertiesNearQuery{
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+		Radius:     req.Radius,
+		Category:   req.Category,
+		SaleType:   uint8(req.SaleType),
+		Area:       req.Area,
+		Limit:      uint16(req.Limit),
+		Attributes: attributes,
+		FreeFrom:   toTime(req.GetFreeFrom()),
+		FreeTo:     toTime(req.GetFreeTo()),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to list properties near point", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties near point listed successfully")
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
+	for _, property := range properties.Properties {
+		protoProperty := toProtoProperty(property)
+		distance := property.Distance
+		protoProperty.Distance = &distance
+		propertyList = append(propertyList, protoProperty)
+	}
+	return &proto.ListPropertyResponse{
+		Properties: propertyList,
+	}, nil
+}
+
+func (s *MyPropertyService) ListSimilarProperties(ctx context.Context, req *proto.PropertyListSimilarRequest) (*proto.ListPropertyResponse, error) {
+	s.AppService.Log.Debug("Listing properties similar to %s", req.Id)
+	properties, err := s.AppService.ListSimilarProperties(ctx, query.ListSimilarPropertiesQuery{
+		ID:    req.Id,
+		Limit: uint16(req.Limit),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to list similar properties", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Similar properties listed successfully")
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
+	for _, property := range properties.Properties {
+		protoProperty := toProtoProperty(property)
+		score := property.SimilarityScore
+		protoProperty.SimilarityScore = &score
+		if property.Distance > 0 {
+			distance := property.Distance
+			protoProperty.Distance = &distance
 		}
-		s.AppService.Log.Debug("Converting property to proto format: %s", property.ID)
-		propertyList = append(propertyList, &proto.Property{
-			Id:      property.ID,
-			OwnerID: property.OwnerID,
-			Address: &proto.Address{
-				FirstLine: property.Address.FirstLine,
-				Street:    property.Address.Street,
-				City:      property.Address.City,
-				County:    property.Address.County,
-				Country:   property.Address.Country,
-				Postcode:  property.Address.PostalCode,
-				Latitude:  latitude,
-				Longitude: longitude,
+		propertyList = append(propertyList, protoProperty)
+	}
+	return &proto.ListPropertyResponse{
+		Properties: propertyList,
+	}, nil
+}
+
+// toListPropertyResponse converts a page of properties into a list response.
+func toListPropertyResponse(propertyList []*proto.Property, page query.Page) *proto.ListPropertyResponse {
+	return &proto.ListPropertyResponse{
+		Properties:    propertyList,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		HasMore:       page.HasMore,
+		TotalCount:    page.TotalCount,
+	}
+}
+
+// toAddress converts a proto address into a domain address, the GeoJSON point is only set
+// when both coordinates are given, otherwise the address is geocoded.
+func toAddress(protoAddress *proto.Address) address.Address {
+	domainAddress := address.Address{
+		FirstLine:  protoAddress.GetFirstLine(),
+		Street:     protoAddress.GetStreet(),
+		City:       protoAddress.GetCity(),
+		County:     protoAddress.GetCounty(),
+		Country:    protoAddress.GetCountry(),
+		PostalCode: protoAddress.GetPostcode(),
+	}
+	if protoAddress != nil && protoAddress.Latitude != nil && protoAddress.Longitude != nil {
+		domainAddress.GeoJSON = address.NewPoint(
+			float64(protoAddress.GetLatitude()),
+			float64(protoAddress.GetLongitude()),
+		)
+	}
+	return domainAddress
+}
+
+// toProtoProperty converts a domain property into its proto representation.
+func toProtoProperty(property domain.Property) *proto.Property {
+	return &proto.Property{
+		Id:            property.ID,
+		OwnerID:       property.OwnerID,
+		Address:       toProtoAddress(property.Address),
+		Description:   property.Description,
+		Title:         property.Title,
+		AvailableDate: timestamppb.New(property.AvailableDate),
+		Status:        uint32(property.Status),
+		SaleType:      uint32(property.SaleType),
+		Category:      property.Category,
+		Highlights:    toProtoHighlights(property.Highlights),
+		Areas:         property.Areas,
+		Nearby:        toProtoNearby(property.Nearby),
+		Rent:          toProtoRent(property.Rent),
+		AskingPrice:   toProtoMoney(property.AskingPrice),
+		Attributes:    toProtoAttributes(property.Attributes),
+	}
+}
+
+// toMoney converts proto money into domain money, nil when it is not set.
+func toMoney(protoMoney *proto.Money) *money.Money {
+	if protoMoney == nil {
+		return nil
+	}
+	domainMoney := money.New(protoMoney.GetAmount(), protoMoney.GetCurrency())
+	return &domainMoney
+}
+
+// toRent converts a proto rent into a domain rent, nil when it is not set.
+func toRent(protoRent *proto.Rent) *domain.Rent {
+	if protoRent == nil {
+		return nil
+	}
+	var price money.Money
+	if protoRent.GetPrice() != nil {
+		price = *toMoney(protoRent.GetPrice())
+	}
+	rent := domain.NewRent(price, domain.RentPeriod(protoRent.GetPeriod()))
+	return &rent
+}
+
+// toPriceRange converts a proto price range into a domain price range, nil when it is not set.
+func toPriceRange(protoRange *proto.PriceRange) *domain.PriceRange {
+	if protoRange == nil {
+		return nil
+	}
+	return &domain.PriceRange{
+		Min:      protoRange.GetMin(),
+		Max:      protoRange.GetMax(),
+		Currency: money.NormaliseCurrency(protoRange.GetCurrency()),
+	}
+}
+
+// toProtoMoney converts domain money into its proto representation.
+func toProtoMoney(domainMoney *money.Money) *proto.Money {
+	if domainMoney == nil {
+		return nil
+	}
+	return &proto.Money{
+		Amount:   domainMoney.Amount,
+		Currency: domainMoney.Currency,
+	}
+}
+
+// toProtoRent converts a domain rent into its proto representation.
+func toProtoRent(rent *domain.Rent) *proto.Rent {
+	if rent == nil {
+		return nil
+	}
+	return &proto.Rent{
+		Price:         toProtoMoney(&rent.Price),
+		Period:        uint32(rent.Period),
+		MonthlyAmount: rent.MonthlyAmount,
+	}
+}
+
+// toProtoPriceRange converts a domain price range into its proto representation.
+func toProtoPriceRange(priceRange *domain.PriceRange) *proto.PriceRange {
+	if priceRange == nil {
+		return nil
+	}
+	return &proto.PriceRange{
+		Min:      priceRange.Min,
+		Max:      priceRange.Max,
+		Currency: priceRange.Currency,
+	}
+}
+
+// toAttributes converts proto attributes into domain attributes with their feature names as
+// keys, nil when they are not set.
+func toAttributes(protoAttributes *proto.Attributes) (*domain.Attributes, error) {
+	if protoAttributes == nil {
+		return nil, nil
+	}
+	var values uint8Values
+	attributes := domain.Attributes{
+		Bedrooms:       values.convert("bedrooms", protoAttributes.GetBedrooms()),
+		Bathrooms:      values.convert("bathrooms", protoAttributes.GetBathrooms()),
+		ReceptionRooms: values.convert("reception rooms", protoAttributes.GetReceptionRooms()),
+		Furnishing:     domain.Furnishing(values.convert("furnishing", protoAttributes.GetFurnishing())),
+		Parking:        domain.Parking(values.convert("parking", protoAttributes.GetParking())),
+		Garden:         protoAttributes.GetGarden(),
+		PetsAllowed:    protoAttributes.GetPetsAllowed(),
+		Accessibility:  protoAttributes.GetAccessibility(),
+		Amenities:      protoAttributes.GetAmenities(),
+	}
+	if floorArea := protoAttributes.GetFloorArea(); floorArea != nil {
+		attributes.FloorArea = &domain.FloorArea{
+			Size: floorArea.GetSize(),
+			Unit: domain.AreaUnit(values.convert("floor area unit", floorArea.GetUnit())),
+		}
+	}
+	if values.err != nil {
+		return nil, values.err
+	}
+	attributes = domain.NewAttributes(attributes)
+	return &attributes, nil
+}
+
+// uint8Values converts the proto counts and codes into the uint8 values of the domain, the
+// first value over 255 is kept as an invalid argument instead of wrapping around.
+type uint8Values struct {
+	err error
+}
+
+// convert returns the value as a uint8, zero when it is out of range.
+func (v *uint8Values) convert(field string, value uint32) uint8 {
+	if value > math.MaxUint8 {
+		if v.err == nil {
+			v.err = errors.NewInvalidArgumentError(
+				fmt.Errorf("%w: %s %d is over %d", errors.ErrValueOutOfRange, field, value, math.MaxUint8),
+			)
+		}
+		return 0
+	}
+	return uint8(value)
+}
+
+// toProtoAttributes converts domain attributes into their proto representation.
+func toProtoAttributes(attributes *domain.Attributes) *proto.Attributes {
+	if attributes == nil {
+		return nil
+	}
+	protoAttributes := &proto.Attributes{
+		Bedrooms:       uint32(attributes.Bedrooms),
+		Bathrooms:      uint32(attributes.Bathrooms),
+		ReceptionRooms: uint32(attributes.ReceptionRooms),
+		Furnishing:     uint32(attributes.Furnishing),
+		Parking:        uint32(attributes.Parking),
+		Garden:         attributes.Garden,
+		PetsAllowed:    attributes.PetsAllowed,
+		Accessibility:  attributes.Accessibility,
+		Amenities:      attributes.Amenities,
+	}
+	if attributes.FloorArea != nil {
+		protoAttributes.FloorArea = &proto.FloorArea{
+			Size:         attributes.FloorArea.Size,
+			Unit:         uint32(attributes.FloorArea.Unit),
+			SquareMetres: attributes.FloorArea.SquareMetres,
+		}
+	}
+	return protoAttributes
+}
+
+// toAttributeFilter converts a proto attribute filter into a domain attribute filter, unset
+// criteria are left empty.
+func toAttributeFilter(filter *proto.AttributeFilter) (domain.AttributeFilter, error) {
+	if filter == nil {
+		return domain.AttributeFilter{}, nil
+	}
+	var values uint8Values
+	attributeFilter := domain.AttributeFilter{
+		MinBedrooms:   values.convert("minimum bedrooms", filter.GetMinBedrooms()),
+		MinBathrooms:  values.convert("minimum bathrooms", filter.GetMinBathrooms()),
+		MinFloorArea:  filter.GetMinFloorArea(),
+		MaxFloorArea:  filter.MaxFloorArea,
+		Furnishing:    domain.Furnishing(values.convert("furnishing", filter.GetFurnishing())),
+		Parking:       filter.GetParking(),
+		Accessibility: filter.GetAccessibility(),
+		Amenities:     filter.GetAmenities(),
+	}
+	if filter.MaxBedrooms != nil {
+		maxBedrooms := values.convert("maximum bedrooms", filter.GetMaxBedrooms())
+		attributeFilter.MaxBedrooms = &maxBedrooms
+	}
+	if values.err != nil {
+		return domain.AttributeFilter{}, values.err
+	}
+	if filter.GetGarden() != nil {
+		garden := filter.GetGarden().GetValue()
+		attributeFilter.Garden = &garden
+	}
+	if filter.GetPetsAllowed() != nil {
+		petsAllowed := filter.GetPetsAllowed().GetValue()
+		attributeFilter.PetsAllowed = &petsAllowed
+	}
+	return attributeFilter, nil
+}
+
+// toProtoAttributeFilter converts a domain attribute filter into its proto representation.
+func toProtoAttributeFilter(filter domain.AttributeFilter) *proto.AttributeFilter {
+	protoFilter := &proto.AttributeFilter{
+		MinBedrooms:   uint32(filter.MinBedrooms),
+		MinBathrooms:  uint32(filter.MinBathrooms),
+		MinFloorArea:  filter.MinFloorArea,
+		MaxFloorArea:  filter.MaxFloorArea,
+		Furnishing:    uint32(filter.Furnishing),
+		Parking:       filter.Parking,
+		Accessibility: filter.Accessibility,
+		Amenities:     filter.Amenities,
+	}
+	if filter.MaxBedrooms != nil {
+		maxBedrooms := uint32(*filter.MaxBedrooms)
+		protoFilter.MaxBedrooms = &maxBedrooms
+	}
+	if filter.Garden != nil {
+		protoFilter.Garden = wrapperspb.Bool(*filter.Garden)
+	}
+	if filter.PetsAllowed != nil {
+		protoFilter.PetsAllowed = wrapperspb.Bool(*filter.PetsAllowed)
+	}
+	return protoFilter
+}
+
+// toProtoNearby converts the nearest points of interest of a property into their proto
+// representation, by type.
+func toProtoNearby(nearby map[string]domain.NearbyPOI) map[string]*proto.NearbyPOI {
+	if len(nearby) == 0 {
+		return nil
+	}
+	protoNearby := make(map[string]*proto.NearbyPOI, len(nearby))
+	for kind, poi := range nearby {
+		protoNearby[kind] = &proto.NearbyPOI{
+			Name:     poi.Name,
+			Distance: poi.Distance,
+			Location: &proto.Coordinate{
+				Latitude:  poi.Location.Coordinates[1],
+				Longitude: poi.Location.Coordinates[0],
 			},
-			Description:     property.Description,
-			Title:           property.Title,
-			AvailableDate:   timestamppb.New(property.AvailableDate),
-			Available:       wrapperspb.Bool(property.Available),
-			SaleType:        uint32(property.SaleType),
-			Category:        property.Category,
-			PaginationToken: property.PaginationToken,
+		}
+	}
+	return protoNearby
+}
+
+// toProtoAddress converts a domain address into its proto representation, the latitude and
+// longitude are only set when the address has a GeoJSON point.
+func toProtoAddress(domainAddress address.Address) *proto.Address {
+	var latitude *float32
+	var longitude *float32
+	if domainAddress.GeoJSON != nil {
+		// note: GeoJSON.Coordinates is [lng, lat]
+		lng := float32(domainAddress.GeoJSON.Coordinates[0])
+		lat := float32(domainAddress.GeoJSON.Coordinates[1])
+		latitude = &lat
+		longitude = &lng
+	}
+	return &proto.Address{
+		FirstLine: domainAddress.FirstLine,
+		Street:    domainAddress.Street,
+		City:      domainAddress.City,
+		County:    domainAddress.County,
+		Country:   domainAddress.Country,
+		Postcode:  domainAddress.PostalCode,
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+// toProtoHighlights converts the matched passages of a text search into their proto representation.
+func toProtoHighlights(highlights []domain.Highlight) []*proto.Highlight {
+	if len(highlights) == 0 {
+		return nil
+	}
+	protoHighlights := make([]*proto.Highlight, 0, len(highlights))
+	for _, highlight := range highlights {
+		texts := make([]*proto.HighlightText, 0, len(highlight.Texts))
+		for _, text := range highlight.Texts {
+			texts = append(texts, &proto.HighlightText{
+				Value: text.Value,
+				Hit:   text.Hit,
+			})
+		}
+		protoHighlights = append(protoHighlights, &proto.Highlight{
+			Path:  highlight.Path,
+			Texts: texts,
 		})
 	}
+	return protoHighlights
+}
+
+func (s *MyPropertyService) ListPropertiesWithinArea(ctx context.Context, req *proto.PropertyListWithinAreaRequest) (*proto.ListPropertyResponse, error) {
+	s.AppService.Log.Debug("Listing properties within area")
+	properties, err := s.AppService.ListPropertiesWithinArea(ctx, query.ListPropertiesWithinAreaQuery{
+		Area:            toSearchArea(req),
+		FreeFrom:        toTime(req.GetFreeFrom()),
+		FreeTo:          toTime(req.GetFreeTo()),
+		Sort:            uint8(req.Sort),
+		Limit:           uint16(req.Limit),
+		PaginationToken: req.PaginationToken,
+		TotalCount:      req.IncludeTotalCount,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to list properties within area", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties within area listed successfully")
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
+	for _, property := range properties.Properties {
+		propertyList = append(propertyList, toProtoProperty(property))
+	}
+	return toListPropertyResponse(propertyList, properties.Page), nil
+}
+
+func (s *MyPropertyService) ListPropertiesWithinCommute(ctx context.Context, req *proto.PropertyListWithinCommuteRequest) (*proto.ListPropertyResponse, error) {
+	s.AppService.Log.Debug("Listing properties within commute of %f,%f", req.Latitude, req.Longitude)
+	attributes, err := toAttributeFilter(req.GetAttributes())
+	if err != nil {
+		s.AppService.Log.Error("Invalid attribute filter", err)
+		return nil, err
+	}
+	properties, err := s.AppService.ListPropertiesWithinCommute(ctx, query.ListPropertiesWithinCommuteQuery{
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+		Departure:  req.Departure.AsTime(),
+		MaxMinutes: uint16(req.MaxMinutes),
+		Category:   req.Category,
+		SaleType:   uint8(req.SaleType),
+		Limit:      uint16(req.Limit),
+		Attributes: attributes,
+		FreeFrom:   toTime(req.GetFreeFrom()),
+		FreeTo:     toTime(req.GetFreeTo()),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to list properties within commute", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties within commute listed successfully")
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
+	for _, property := range properties.Properties {
+		protoProperty := toProtoProperty(property)
+		travelTime := uint32(property.TravelTime.Seconds())
+		protoProperty.TravelTime = &travelTime
+		propertyList = append(propertyList, protoProperty)
+	}
 	return &proto.ListPropertyResponse{
 		Properties: propertyList,
 	}, nil
 }
+
+// toSearchArea converts the bounding box or polygon of the request into a search area.
+func toSearchArea(req *proto.PropertyListWithinAreaRequest) domain.SearchArea {
+	switch {
+	case req.GetPolygon() != nil:
+		rings := make([][][2]float64, 0, len(req.GetPolygon().Rings))
+		for _, ring := range req.GetPolygon().Rings {
+			points := make([][2]float64, 0, len(ring.Points))
+			for _, point := range ring.Points {
+				points = append(points, toGeoJSONPoint(point).Coordinates)
+			}
+			rings = append(rings, points)
+		}
+		return domain.SearchArea{
+			Polygon: &address.GeoJSONPolygon{
+				Type:        "Polygon",
+				Coordinates: rings,
+			},
+		}
+	case req.GetBoundingBox() != nil:
+		return domain.SearchArea{
+			BoundingBox: &address.BoundingBox{
+				BottomLeft: toGeoJSONPoint(req.GetBoundingBox().BottomLeft),
+				TopRight:   toGeoJSONPoint(req.GetBoundingBox().TopRight),
+			},
+		}
+	}
+	return domain.SearchArea{}
+}
+
+// toGeoJSONPoint converts a coordinate into a GeoJSON point, GeoJSON orders it [lng, lat].
+func toGeoJSONPoint(coordinate *proto.Coordinate) address.GeoJSONCoordinates {
+	return address.GeoJSONCoordinates{
+		Type:        "Point",
+		Coordinates: [2]float64{coordinate.GetLongitude(), coordinate.GetLatitude()},
+	}
+}
+
+func (s *MyPropertyService) ClusterProperties(ctx context.Context, req *proto.ClusterPropertiesRequest) (*proto.ClusterPropertiesResponse, error) {
+	s.AppService.Log.Debug("Clustering properties at zoom %d", req.Zoom)
+	clusters, err := s.AppService.ClusterProperties(ctx, query.ClusterPropertiesQuery{
+		BoundingBox: address.BoundingBox{
+			BottomLeft: toGeoJSONPoint(req.GetBoundingBox().GetBottomLeft()),
+			TopRight:   toGeoJSONPoint(req.GetBoundingBox().GetTopRight()),
+		},
+		Zoom:      uint8(min(req.Zoom, domain.MaxClusterZoom+1)),
+		Threshold: uint16(min(req.Threshold, 1<<16-1)),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to cluster properties", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties clustered successfully")
+	protoClusters := make([]*proto.PropertyCluster, 0, len(clusters.Clusters.Clusters))
+	for _, cluster := range clusters.Clusters.Clusters {
+		protoClusters = append(protoClusters, &proto.PropertyCluster{
+			Count: cluster.Count,
+			Centroid: &proto.Coordinate{
+				Latitude:  cluster.Centroid.Coordinates[1],
+				Longitude: cluster.Centroid.Coordinates[0],
+			},
+			SamplePropertyId: cluster.SampleID,
+		})
+	}
+	propertyList := make([]*proto.Property, 0, len(clusters.Clusters.Properties))
+	for _, property := range clusters.Clusters.Properties {
+		propertyList = append(propertyList, toProtoProperty(property))
+	}
+	return &proto.ClusterPropertiesResponse{
+		Clusters:   protoClusters,
+		Properties: propertyList,
+	}, nil
+}
+
+func (s *MyPropertyService) SearchProperties(ctx context.Context, req *proto.SearchPropertiesRequest) (*proto.ListPropertyResponse, error) {
+	s.AppService.Log.Debug("Searching properties")
+	filter, err := toSearchFilter(req.GetFilter())
+	if err != nil {
+		s.AppService.Log.Error("Invalid property filter", err)
+		return nil, err
+	}
+	properties, err := s.AppService.SearchProperties(ctx, query.SearchPropertiesQuery{
+		Filter:          filter,
+		Sort:            uint8(req.Sort),
+		Limit:           uint16(req.Limit),
+		PaginationToken: req.PaginationToken,
+		TotalCount:      req.IncludeTotalCount,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to search properties", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties searched successfully")
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
+	for _, property := range properties.Properties {
+		propertyList = append(propertyList, toProtoProperty(property))
+	}
+	return toListPropertyResponse(propertyList, properties.Page), nil
+}
+
+func (s *MyPropertyService) SearchPropertiesByText(ctx context.Context, req *proto.SearchPropertiesByTextRequest) (*proto.ListPropertyResponse, error) {
+	s.AppService.Log.Debug("Searching properties by text")
+	properties, err := s.AppService.SearchPropertiesByText(ctx, query.SearchPropertiesByTextQuery{
+		Text:            req.Query,
+		Sort:            domain.ParseSort(req.SortBy),
+		Limit:           uint16(req.Limit),
+		PaginationToken: req.PaginationToken,
+		TotalCount:      req.IncludeTotalCount,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to search properties by text", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties searched by text successfully")
+	propertyList := make([]*proto.Property, 0, len(properties.Properties))
+	for _, property := range properties.Properties {
+		propertyList = append(propertyList, toProtoProperty(property))
+	}
+	return toListPropertyResponse(propertyList, properties.Page), nil
+}
+
+func (s *MyPropertyService) Suggest(ctx context.Context, req *proto.SuggestRequest) (*proto.SuggestResponse, error) {
+	s.AppService.Log.Debug("Suggesting properties for %s", req.Prefix)
+	suggestions, err := s.AppService.SuggestProperties(ctx, query.SuggestPropertiesQuery{
+		Prefix: req.Prefix,
+		Limit:  uint16(req.Limit),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to suggest properties", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Properties suggested successfully")
+	return &proto.SuggestResponse{
+		Cities:    suggestions.Suggestions.Cities,
+		Counties:  suggestions.Suggestions.Counties,
+		Postcodes: suggestions.Suggestions.Postcodes,
+		Titles:    suggestions.Suggestions.Titles,
+	}, nil
+}
+
+func (s *MyPropertyService) ReverseGeocode(ctx context.Context, req *proto.ReverseGeocodeRequest) (*proto.Address, error) {
+	s.AppService.Log.Debug("Reverse geocoding %f, %f", req.Latitude, req.Longitude)
+	found, err := s.AppService.ReverseGeocode(ctx, query.ReverseGeocodeQuery{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to reverse geocode", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Location reverse geocoded successfully")
+	return toProtoAddress(found.Address), nil
+}
+
+func (s *MyPropertyService) ImportAreas(ctx context.Context, req *proto.ImportAreasRequest) (*proto.ImportAreasResponse, error) {
+	s.AppService.Log.Debug("Importing areas")
+	areas, err := area.ParseFeatureCollection([]byte(req.Geojson), req.NameProperty, req.Kind)
+	if err != nil {
+		s.AppService.Log.Error("Failed to parse areas", err)
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	if err := s.AppService.ImportAreas(ctx, command.ImportAreasCommand{
+		Areas: areas,
+	}); err != nil {
+		s.AppService.Log.Error("Failed to import areas", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Areas imported successfully")
+	keys := make([]string, 0, len(areas))
+	for _, imported := range areas {
+		keys = append(keys, area.Key(imported.Name))
+	}
+	return &proto.ImportAreasResponse{Keys: keys}, nil
+}
+
+// toSearchFilter converts the proto filter into a domain search filter, unset criteria are left empty.
+func toSearchFilter(filter *proto.PropertyFilter) (domain.SearchFilter, error) {
+	attributes, err := toAttributeFilter(filter.GetAttributes())
+	if err != nil {
+		return domain.SearchFilter{}, err
+	}
+	statuses, err := toStatuses(filter.GetStatuses())
+	if err != nil {
+		return domain.SearchFilter{}, err
+	}
+	var values uint8Values
+	searchFilter := domain.SearchFilter{
+		Categories:     filter.GetCategories(),
+		SaleType:       values.convert("sale type", filter.GetSaleType()),
+		Statuses:       statuses,
+		City:           filter.GetCity(),
+		PostcodePrefix: filter.GetPostcodePrefix(),
+		Country:        filter.GetCountry(),
+		Area:           filter.GetArea(),
+		Rent:           toPriceRange(filter.GetRent()),
+		AskingPrice:    toPriceRange(filter.GetAskingPrice()),
+		Attributes:     attributes,
+	}
+	if values.err != nil {
+		return domain.SearchFilter{}, values.err
+	}
+	for _, near := range filter.GetNearPois() {
+		searchFilter.NearPOIs = append(searchFilter.NearPOIs, domain.POIDistance{
+			Type:   near.GetType(),
+			Within: near.GetWithin(),
+		})
+	}
+	if filter.GetAvailableFrom() != nil {
+		searchFilter.AvailableFrom = filter.GetAvailableFrom().AsTime()
+	}
+	if filter.GetAvailableTo() != nil {
+		searchFilter.AvailableTo = filter.GetAvailableTo().AsTime()
+	}
+	searchFilter.FreeFrom = toTime(filter.GetFreeFrom())
+	searchFilter.FreeTo = toTime(filter.GetFreeTo())
+	return searchFilter, nil
+}
+
+func (s *MyPropertyService) GetPropertyFacets(ctx context.Context, req *proto.GetPropertyFacetsRequest) (*proto.GetPropertyFacetsResponse, error) {
+	s.AppService.Log.Debug("Getting property facets")
+	filter, err := toSearchFilter(req.GetFilter())
+	if err != nil {
+		s.AppService.Log.Error("Invalid property filter", err)
+		return nil, err
+	}
+	facets, err := s.AppService.GetPropertyFacets(ctx, query.GetPropertyFacetsQuery{
+		Filter: filter,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to get property facets", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Property facets retrieved successfully")
+	return &proto.GetPropertyFacetsResponse{
+		Filter: toProtoFilter(facets.Filter),
+		Facets: &proto.PropertyFacets{
+			Categories: toProtoFacetBuckets(facets.Facets.Categories),
+			SaleTypes:  toProtoFacetBuckets(facets.Facets.SaleTypes),
+			Cities:     toProtoFacetBuckets(facets.Facets.Cities),
+			Statuses:   toProtoFacetBuckets(facets.Facets.Statuses),
+		},
+	}, nil
+}
+
+// toProtoFilter converts a domain search filter into its proto representation.
+func toProtoFilter(filter domain.SearchFilter) *proto.PropertyFilter {
+	protoFilter := &proto.PropertyFilter{
+		Categories:     filter.Categories,
+		SaleType:       uint32(filter.SaleType),
+		Statuses:       toProtoStatuses(filter.Statuses),
+		City:           filter.City,
+		PostcodePrefix: filter.PostcodePrefix,
+		Country:        filter.Country,
+		Area:           filter.Area,
+		Rent:           toProtoPriceRange(filter.Rent),
+		AskingPrice:    toProtoPriceRange(filter.AskingPrice),
+		Attributes:     toProtoAttributeFilter(filter.Attributes),
+	}
+	for _, near := range filter.NearPOIs {
+		protoFilter.NearPois = append(protoFilter.NearPois, &proto.POIDistance{
+			Type:   near.Type,
+			Within: near.Within,
+		})
+	}
+	if !filter.AvailableFrom.IsZero() {
+		protoFilter.AvailableFrom = timestamppb.New(filter.AvailableFrom)
+	}
+	if !filter.AvailableTo.IsZero() {
+		protoFilter.AvailableTo = timestamppb.New(filter.AvailableTo)
+	}
+	if !filter.FreeFrom.IsZero() {
+		protoFilter.FreeFrom = timestamppb.New(filter.FreeFrom)
+	}
+	if !filter.FreeTo.IsZero() {
+		protoFilter.FreeTo = timestamppb.New(filter.FreeTo)
+	}
+	return protoFilter
+}
+
+// toStatuses converts proto listing statuses into domain statuses, nil when there are none.
+func toStatuses(protoStatuses []uint32) ([]domain.Status, error) {
+	if len(protoStatuses) == 0 {
+		return nil, nil
+	}
+	var values uint8Values
+	statuses := make([]domain.Status, 0, len(protoStatuses))
+	for _, status := range protoStatuses {
+		statuses = append(statuses, domain.Status(values.convert("status", status)))
+	}
+	return statuses, values.err
+}
+
+// toProtoStatuses converts domain listing statuses into their proto representation.
+func toProtoStatuses(statuses []domain.Status) []uint32 {
+	protoStatuses := make([]uint32, 0, len(statuses))
+	for _, status := range statuses {
+		protoStatuses = append(protoStatuses, uint32(status))
+	}
+	return protoStatuses
+}
+
+// toProtoFacetBuckets converts facet buckets into their proto representation.
+func toProtoFacetBuckets(buckets []domain.FacetBucket) []*proto.FacetBucket {
+	protoBuckets := make([]*proto.FacetBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		protoBuckets = append(protoBuckets, &proto.FacetBucket{
+			Value: bucket.Value,
+			Count: bucket.Count,
+		})
+	}
+	return protoBuckets
+}
@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"property-service/api/proto"
+	"property-service/internal/properties/app/command"
+	"property-service/internal/properties/app/query"
+	port "property-service/internal/properties/ports"
+	"property-service/pkg/infrastructure/database"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MyViewingService implements proto.ViewingServiceServer.
+type MyViewingService struct {
+	proto.UnimplementedViewingServiceServer
+	AppService *port.ServiceImpl
+}
+
+func (s *MyViewingService) CreateViewingSlot(ctx context.Context, req *proto.CreateViewingSlotRequest) (*proto.CreateViewingSlotResponse, error) {
+	s.AppService.Log.Debug("Creating viewing slot of property with ID:", req.PropertyId)
+	id := req.Id
+	if id == "" {
+		id = database.NewStringID()
+	}
+	err := s.AppService.CreateViewingSlot(ctx, command.CreateViewingSlotCommand{
+		ViewingID:  id,
+		PropertyID: req.PropertyId,
+		Start:      toTime(req.GetStart()),
+		End:        toTime(req.GetEnd()),
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to create viewing slot", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Viewing slot created successfully")
+	// Return the response
+	return &proto.CreateViewingSlotResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *MyViewingService) DeleteViewingSlot(ctx context.Context, req *proto.DeleteViewingSlotRequest) (*proto.DeleteViewingSlotResponse, error) {
+	s.AppService.Log.Debug("Deleting viewing slot with ID:", req.Id)
+	err := s.AppService.DeleteViewingSlot(ctx, command.DeleteViewingSlotCommand{
+		ViewingID: req.Id,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to delete viewing slot", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Viewing slot deleted successfully")
+	// Return the response
+	return &proto.DeleteViewingSlotResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyViewingService) ListViewingSlots(ctx context.Context, req *proto.ListViewingSlotsRequest) (*proto.ListViewingSlotsResponse, error) {
+	s.AppService.Log.Debug("Listing viewing slots of property with ID:", req.PropertyId)
+	result, err := s.AppService.ListViewingSlots(ctx, query.ListViewingSlotsQuery{
+		PropertyID: req.PropertyId,
+		Start:      toTime(req.GetStart()),
+		End:        toTime(req.GetEnd()),
+		FreeOnly:   req.FreeOnly,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to list viewing slots", err)
+		return nil, err
+	}
+	// The bookings are not returned, a slot is only shown as booked.
+	slots := make([]*proto.ViewingSlot, 0, len(result.Viewings))
+	for _, slot := range result.Viewings {
+		slots = append(slots, &proto.ViewingSlot{
+			Id:         slot.ID,
+			PropertyId: slot.PropertyID,
+			Start:      timestamppb.New(slot.Start),
+			End:        timestamppb.New(slot.End),
+			Booked:     slot.IsBooked(),
+		})
+	}
+	return &proto.ListViewingSlotsResponse{
+		Slots: slots,
+	}, nil
+}
+
+func (s *MyViewingService) BookViewing(ctx context.Context, req *proto.BookViewingRequest) (*proto.BookViewingResponse, error) {
+	s.AppService.Log.Debug("Booking viewing slot with ID:", req.Id)
+	bookingID := database.NewStringID()
+	err := s.AppService.BookViewing(ctx, command.BookViewingCommand{
+		ViewingID: req.Id,
+		BookingID: bookingID,
+		Name:      req.Name,
+		Email:     req.Email,
+		Telephone: req.Telephone,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to book viewing", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Viewing booked successfully")
+	// Return the response
+	return &proto.BookViewingResponse{
+		Id:        req.Id,
+		BookingId: bookingID,
+	}, nil
+}
+
+func (s *MyViewingService) CancelViewing(ctx context.Context, req *proto.CancelViewingRequest) (*proto.CancelViewingResponse, error) {
+	s.AppService.Log.Debug("Cancelling viewing with ID:", req.Id)
+	err := s.AppService.CancelViewing(ctx, command.CancelViewingCommand{
+		ViewingID: req.Id,
+		BookingID: req.BookingId,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to cancel viewing", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Viewing cancelled successfully")
+	// Return the response
+	return &proto.CancelViewingResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *MyViewingService) RescheduleViewing(ctx context.Context, req *proto.RescheduleViewingRequest) (*proto.RescheduleViewingResponse, error) {
+	s.AppService.Log.Debug("Rescheduling viewing with ID:", req.Id)
+	err := s.AppService.RescheduleViewing(ctx, command.RescheduleViewingCommand{
+		ViewingID:    req.Id,
+		BookingID:    req.BookingId,
+		NewViewingID: req.NewId,
+	})
+	if err != nil {
+		s.AppService.Log.Error("Failed to reschedule viewing", err)
+		return nil, err
+	}
+	s.AppService.Log.Debug("Viewing rescheduled successfully")
+	// Return the response
+	return &proto.RescheduleViewingResponse{
+		Id:        req.NewId,
+		BookingId: req.BookingId,
+	}, nil
+}
@@ -1,5 +1,22 @@
 package address
 
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+var (
+	// ErrInvalidPolygon : The polygon has no rings or a ring is not closed.
+	ErrInvalidPolygon = errors.New("invalid polygon: rings must be closed and have at least four positions")
+	// ErrInvalidBoundingBox : The bottom left corner of the box is north of the top right corner.
+	ErrInvalidBoundingBox = errors.New("invalid bounding box: bottom left must be south of top right")
+	// ErrAddressNotFound : The geocoder has no location for the address or coordinates.
+	ErrAddressNotFound = errors.New("address could not be resolved")
+	// ErrEmptyAddress : There is nothing to geocode.
+	ErrEmptyAddress = errors.New("address cannot be empty")
+)
+
 // Address represents a physical address that can be converted to GeoJSON coordinates.
 type Address struct {
 	FirstLine  string `bson:"FirstLine" json:"firstLine"`   // First line of the address, e.g., "123 Main St"
@@ -15,10 +32,162 @@ type Address struct {
 
 // GeoJSONCoordinates holds the GeoJSON representation of a point.
 type GeoJSONCoordinates struct {
-	Type        string     `bson:"Type" json:"type"`               // Always "Point"
-	Coordinates [2]float64 `bson:"Coordinates" json:"coordinates"` // [longitude, latitude]
+	Type        string     `bson:"type" json:"type"`               // Always "Point"
+	Coordinates [2]float64 `bson:"coordinates" json:"coordinates"` // [longitude, latitude]
 }
 
 func (a Address) IsEmpty() bool {
 	return a.FirstLine == "" && a.Street == "" && a.City == "" && a.County == "" && a.Country == "" && a.PostalCode == "" && a.GeoJSON == nil
 }
+
+// String formats the address on a single line as expected by a geocoder, empty parts are
+// left out.
+func (a Address) String() string {
+	parts := make([]string, 0, 6)
+	for _, part := range []string{a.FirstLine, a.Street, a.City, a.County, a.PostalCode, a.Country} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// NewPoint returns the GeoJSON point of a latitude and longitude.
+func NewPoint(lat, lng float64) *GeoJSONCoordinates {
+	return &GeoJSONCoordinates{
+		Type:        "Point",
+		Coordinates: [2]float64{lng, lat},
+	}
+}
+
+// GeoJSONPolygon holds the GeoJSON representation of a polygon.
+type GeoJSONPolygon struct {
+	Type        string         `bson:"type" json:"type"`               // Always "Polygon"
+	Coordinates [][][2]float64 `bson:"coordinates" json:"coordinates"` // Linear rings of [longitude, latitude], the first one is the outer boundary
+}
+
+// Validate checks that every ring of the polygon is closed and has at least four positions.
+func (p GeoJSONPolygon) Validate() error {
+	if len(p.Coordinates) == 0 {
+		return ErrInvalidPolygon
+	}
+	for _, ring := range p.Coordinates {
+		if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+			return ErrInvalidPolygon
+		}
+	}
+	return nil
+}
+
+// GeoJSONMultiPolygon holds the GeoJSON representation of one or more polygons.
+type GeoJSONMultiPolygon struct {
+	Type        string           `bson:"type" json:"type"`               // Always "MultiPolygon"
+	Coordinates [][][][2]float64 `bson:"coordinates" json:"coordinates"` // Polygons, each of linear rings of [longitude, latitude]
+}
+
+// NewMultiPolygon returns the GeoJSON multi polygon of polygons.
+func NewMultiPolygon(polygons ...GeoJSONPolygon) GeoJSONMultiPolygon {
+	multi := GeoJSONMultiPolygon{Type: "MultiPolygon"}
+	for _, polygon := range polygons {
+		multi.Coordinates = append(multi.Coordinates, polygon.Coordinates)
+	}
+	return multi
+}
+
+// Validate checks that there is at least one polygon and that every polygon is valid.
+func (m GeoJSONMultiPolygon) Validate() error {
+	if len(m.Coordinates) == 0 {
+		return ErrInvalidPolygon
+	}
+	for _, rings := range m.Coordinates {
+		if err := (GeoJSONPolygon{Coordinates: rings}).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BoundingBox is a rectangle described by its south west and north east corners.
+type BoundingBox struct {
+	BottomLeft GeoJSONCoordinates `json:"bottomLeft"` // South west corner
+	TopRight   GeoJSONCoordinates `json:"topRight"`   // North east corner
+}
+
+// Validate checks that the bottom left corner is south of the top right corner.
+// The longitudes are not compared so that a box may cross the antimeridian.
+func (b BoundingBox) Validate() error {
+	if b.BottomLeft.Coordinates[1] > b.TopRight.Coordinates[1] {
+		return ErrInvalidBoundingBox
+	}
+	return nil
+}
+
+const (
+	// coverStep is the longest edge in degrees along the north and south sides of a box
+	// cover, such an edge bows less than coverMargin away from its parallel.
+	coverStep = 1.0
+	// coverMargin is how far in degrees a box cover extends past every side of the box.
+	coverMargin = 0.01
+	// coverWidth is the widest polygon of a box cover in degrees, a polygon must be smaller
+	// than a hemisphere.
+	coverWidth = 90.0
+	// coverLatitude is the furthest latitude from the equator of a box cover.
+	coverLatitude = 89.9
+)
+
+// Cover returns polygons covering the box on the sphere, slightly larger than the box so that
+// none of it is left out by their great circle edges, e.g. for a geo index query followed by
+// an exact check of the coordinates. A box whose bottom left longitude is east of its top right
+// longitude crosses the antimeridian.
+func (b BoundingBox) Cover() GeoJSONMultiPolygon {
+	west, south := b.BottomLeft.Coordinates[0]-coverMargin, b.BottomLeft.Coordinates[1]-coverMargin
+	east, north := b.TopRight.Coordinates[0]+coverMargin, b.TopRight.Coordinates[1]+coverMargin
+	if east < west {
+		east += 360
+	}
+	east = math.Min(east, west+360)
+	south, north = math.Max(south, -coverLatitude), math.Min(north, coverLatitude)
+
+	var polygons []GeoJSONPolygon
+	for from := west; from < east; from += coverWidth {
+		to := math.Min(from+coverWidth, east)
+		steps := int(math.Ceil((to - from) / coverStep))
+		// Anticlockwise, east along the south side and back west along the north side.
+		ring := make([][2]float64, 0, 2*steps+3)
+		for i := 0; i <= steps; i++ {
+			ring = append(ring, [2]float64{wrapLongitude(from + (to-from)*float64(i)/float64(steps)), south})
+		}
+		for i := steps; i >= 0; i-- {
+			ring = append(ring, [2]float64{wrapLongitude(from + (to-from)*float64(i)/float64(steps)), north})
+		}
+		ring = append(ring, ring[0])
+		polygons = append(polygons, GeoJSONPolygon{Type: "Polygon", Coordinates: [][][2]float64{ring}})
+	}
+	return NewMultiPolygon(polygons...)
+}
+
+// wrapLongitude returns the longitude between -180 and 180 of the same meridian.
+func wrapLongitude(lng float64) float64 {
+	lng = math.Mod(lng+180, 360)
+	if lng < 0 {
+		lng += 360
+	}
+	return lng - 180
+}
+
+// Polygon returns the box as a polygon of its four corners, anticlockwise from the bottom
+// left one. The edges are great circles, on a wide box the north and south ones bow towards
+// the pole.
+func (b BoundingBox) Polygon() GeoJSONPolygon {
+	bottomLeft, topRight := b.BottomLeft.Coordinates, b.TopRight.Coordinates
+	return GeoJSONPolygon{
+		Type: "Polygon",
+		Coordinates: [][][2]float64{{
+			bottomLeft,
+			{topRight[0], bottomLeft[1]},
+			topRight,
+			{bottomLeft[0], topRight[1]},
+			bottomLeft,
+		}},
+	}
+}
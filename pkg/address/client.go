@@ -2,7 +2,6 @@ package address
 
 import (
 	"context"
-	"errors"
 
 	"github.com/codingsince1985/geo-golang"
 	"github.com/codingsince1985/geo-golang/google"
@@ -33,18 +32,19 @@ func NewGoogleGeocoderClient(apiKey string) Geocoder {
 // Geocode converts a real address to GeoJSON coordinates using the Google geocoding service.
 func (c *googleGeocoderClient) Geocode(ctx context.Context, address string) (*GeoJSONCoordinates, error) {
 	if address == "" {
-		return nil, errors.New("address cannot be empty")
+		return nil, ErrEmptyAddress
 	}
 
 	location, err := c.geocoder.Geocode(address)
 	if err != nil {
 		return nil, err
 	}
+	// The geocoder returns no location and no error when nothing matched.
+	if location == nil {
+		return nil, ErrAddressNotFound
+	}
 
-	return &GeoJSONCoordinates{
-		Type:        "Point",
-		Coordinates: [2]float64{location.Lng, location.Lat},
-	}, nil
+	return NewPoint(location.Lat, location.Lng), nil
 }
 
 // ReverseGeocode converts latitude and longitude values to a human-readable address using the Google geocoding service.
@@ -55,7 +55,7 @@ func (c *googleGeocoderClient) ReverseGeocode(ctx context.Context, lat, lng floa
 	}
 
 	if location == nil || location.FormattedAddress == "" {
-		return Address{}, errors.New("no address found for the given location")
+		return Address{}, ErrAddressNotFound
 	}
 	new_address := Address{
 		FirstLine:  location.HouseNumber,
@@ -64,10 +64,7 @@ func (c *googleGeocoderClient) ReverseGeocode(ctx context.Context, lat, lng floa
 		County:     location.County,
 		Country:    location.Country,
 		PostalCode: location.Postcode,
-		GeoJSON: &GeoJSONCoordinates{
-			Type:        "Point",
-			Coordinates: [2]float64{lng, lat},
-		},
+		GeoJSON:    NewPoint(lat, lng),
 	}
 	return new_address, nil
 }
@@ -0,0 +1,313 @@
+package address
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"property-service/pkg/errors"
+	redis "property-service/pkg/infrastructure/cache"
+	"property-service/pkg/infrastructure/log"
+)
+
+// GeocoderPolicy configures the GeocoderDecorator, a zero field takes its default.
+type GeocoderPolicy struct {
+	CacheExpire         time.Duration // How long a resolved address is cached, default 30 days.
+	NotFoundExpire      time.Duration // How long an unresolved address is cached, default 1 day.
+	CoordinatePrecision int           // Decimals the coordinates are rounded to in a cache key, default 4 (about 11 metres).
+	RatePerSecond       float64       // Calls per second to the geocoder, default 10.
+	Burst               int           // Calls allowed at once, default 10.
+	FailureThreshold    int           // Consecutive failures opening the circuit, default 5.
+	OpenTimeout         time.Duration // How long the circuit stays open before a trial call, default 30 seconds.
+}
+
+func (p GeocoderPolicy) withDefaults() GeocoderPolicy {
+	if p.CacheExpire <= 0 {
+		p.CacheExpire = 30 * 24 * time.Hour
+	}
+	if p.NotFoundExpire <= 0 {
+		p.NotFoundExpire = 24 * time.Hour
+	}
+	if p.CoordinatePrecision <= 0 {
+		p.CoordinatePrecision = 4
+	}
+	if p.RatePerSecond <= 0 {
+		p.RatePerSecond = 10
+	}
+	if p.Burst <= 0 {
+		p.Burst = 10
+	}
+	if p.FailureThreshold <= 0 {
+		p.FailureThreshold = 5
+	}
+	if p.OpenTimeout <= 0 {
+		p.OpenTimeout = 30 * time.Second
+	}
+	return p
+}
+
+// GeocoderDecorator is a decorator for Geocoder that caches the results in Redis, keyed on
+// the normalised address or the rounded coordinates, and protects the wrapped geocoder with
+// a token bucket rate limit and a circuit breaker. Cache errors do not fail a call.
+type GeocoderDecorator struct {
+	base    Geocoder
+	cacher  redis.Cacher
+	log     log.Logger
+	policy  GeocoderPolicy
+	limiter *tokenBucket
+	breaker *circuitBreaker
+}
+
+var _ Geocoder = (*GeocoderDecorator)(nil)
+
+// NewGeocoderDecorator wraps a geocoder with caching, rate limiting and a circuit breaker.
+func NewGeocoderDecorator(
+	base Geocoder,
+	cacher redis.Cacher,
+	logger log.Logger,
+	policy GeocoderPolicy,
+) *GeocoderDecorator {
+	policy = policy.withDefaults()
+	return &GeocoderDecorator{
+		base:    base,
+		cacher:  cacher,
+		log:     logger,
+		policy:  policy,
+		limiter: newTokenBucket(policy.RatePerSecond, policy.Burst),
+		breaker: newCircuitBreaker(policy.FailureThreshold, policy.OpenTimeout),
+	}
+}
+
+// cachedGeocode is the cached result of a call, a nil result was not found.
+type cachedGeocode struct {
+	Point   *GeoJSONCoordinates `json:"point,omitempty"`
+	Address *Address            `json:"address,omitempty"`
+}
+
+// Geocode implements Geocoder.
+func (g *GeocoderDecorator) Geocode(ctx context.Context, address string) (*GeoJSONCoordinates, error) {
+	key := "geocode:" + normaliseAddress(address)
+	if key == "geocode:" {
+		return nil, ErrEmptyAddress
+	}
+	if cached, ok := g.cached(ctx, key); ok {
+		if cached.Point == nil {
+			return nil, ErrAddressNotFound
+		}
+		return cached.Point, nil
+	}
+
+	var point *GeoJSONCoordinates
+	err := g.call(ctx, func() error {
+		var err error
+		point, err = g.base.Geocode(ctx, address)
+		return err
+	})
+	switch {
+	case err == nil:
+		g.cache(ctx, key, cachedGeocode{Point: point}, g.policy.CacheExpire)
+	case errors.Compare(err, ErrAddressNotFound):
+		g.cache(ctx, key, cachedGeocode{}, g.policy.NotFoundExpire)
+	}
+	return point, err
+}
+
+// ReverseGeocode implements Geocoder.
+func (g *GeocoderDecorator) ReverseGeocode(ctx context.Context, lat, lng float64) (Address, error) {
+	key := fmt.Sprintf(
+		"reverse-geocode:%.*f,%.*f",
+		g.policy.CoordinatePrecision, lat, g.policy.CoordinatePrecision, lng,
+	)
+	if cached, ok := g.cached(ctx, key); ok {
+		if cached.Address == nil {
+			return Address{}, ErrAddressNotFound
+		}
+		found := *cached.Address
+		found.GeoJSON = NewPoint(lat, lng)
+		return found, nil
+	}
+
+	var found Address
+	err := g.call(ctx, func() error {
+		var err error
+		found, err = g.base.ReverseGeocode(ctx, lat, lng)
+		return err
+	})
+	switch {
+	case err == nil:
+		g.cache(ctx, key, cachedGeocode{Address: &found}, g.policy.CacheExpire)
+	case errors.Compare(err, ErrAddressNotFound):
+		g.cache(ctx, key, cachedGeocode{}, g.policy.NotFoundExpire)
+	}
+	return found, err
+}
+
+// call runs a call to the wrapped geocoder once the circuit allows it and a token is taken,
+// an address that can not be resolved is not a failure of the geocoder and neither is a call
+// the caller cancelled or let expire.
+func (g *GeocoderDecorator) call(ctx context.Context, geocode func() error) error {
+	if !g.breaker.allow() {
+		return errors.NewUnavailableError(errors.ErrCircuitOpen)
+	}
+	if err := g.limiter.wait(ctx); err != nil {
+		g.breaker.cancel()
+		return errors.NewUnavailableError(err)
+	}
+	err := geocode()
+	if err == nil || errors.Compare(err, ErrAddressNotFound) || errors.Compare(err, ErrEmptyAddress) {
+		g.breaker.success()
+		return err
+	}
+	if ctx.Err() != nil {
+		g.breaker.cancel()
+		return err
+	}
+	g.breaker.failure()
+	return err
+}
+
+// cached reads a cached result, a cache miss or error is reported as not cached.
+func (g *GeocoderDecorator) cached(ctx context.Context, key string) (cachedGeocode, bool) {
+	var cached cachedGeocode
+	data, err := g.cacher.KeyGet(ctx, key)
+	if err != nil {
+		g.log.Debug("Failed to read the geocoder cache: %v", err)
+		return cached, false
+	}
+	if data == nil || json.Unmarshal(data, &cached) != nil {
+		return cached, false
+	}
+	return cached, true
+}
+
+// cache stores a result, a cache error is only logged.
+func (g *GeocoderDecorator) cache(ctx context.Context, key string, result cachedGeocode, expire time.Duration) {
+	data, err := json.Marshal(result)
+	if err == nil {
+		err = g.cacher.KeySet(ctx, key, data, expire)
+	}
+	if err != nil {
+		g.log.Debug("Failed to write the geocoder cache: %v", err)
+	}
+}
+
+// normaliseAddress returns the address in lower case with single spaces and no empty parts,
+// so that the same address written differently shares a cache key.
+func normaliseAddress(address string) string {
+	parts := strings.Split(strings.ToLower(address), ",")
+	normalised := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.Join(strings.Fields(part), " "); part != "" {
+			normalised = append(normalised, part)
+		}
+	}
+	return strings.Join(normalised, ",")
+}
+
+// tokenBucket is a token bucket rate limiter, tokens are added at rate per second up to burst.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token, waiting for one to be added when the bucket is empty.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	// The token is taken now, a negative balance is the wait of the callers in line.
+	b.tokens--
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// The token is given back as the call is not made.
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// circuitBreaker rejects calls after threshold consecutive failures until timeout has passed,
+// then a single trial call decides whether the circuit closes or opens again.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	timeout   time.Duration
+	failures  int
+	openUntil time.Time
+	trial     bool // A trial call is in progress.
+}
+
+func newCircuitBreaker(threshold int, timeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		timeout:   timeout,
+	}
+}
+
+// allow reports whether a call may be made.
+func (c *circuitBreaker) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures < c.threshold {
+		return true
+	}
+	if c.trial || time.Now().Before(c.openUntil) {
+		return false
+	}
+	c.trial = true
+	return true
+}
+
+// success closes the circuit.
+func (c *circuitBreaker) success() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = 0
+	c.trial = false
+}
+
+// failure counts a failed call, the circuit opens at the threshold or when a trial fails.
+func (c *circuitBreaker) failure() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures++
+	c.trial = false
+	if c.failures >= c.threshold {
+		c.openUntil = time.Now().Add(c.timeout)
+	}
+}
+
+// cancel releases an allowed call that was not made or was cancelled by the caller.
+func (c *circuitBreaker) cancel() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trial = false
+}
@@ -0,0 +1,245 @@
+package address_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"property-service/pkg/address"
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeGeocoder counts its calls and fails them with err, a call waits for block when set.
+type fakeGeocoder struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+	block chan struct{}
+}
+
+func (f *fakeGeocoder) Geocode(ctx context.Context, _ string) (*address.GeoJSONCoordinates, error) {
+	f.mu.Lock()
+	f.calls++
+	err, block := f.err, f.block
+	f.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return address.NewPoint(35.8989, 14.5146), nil
+}
+
+func (f *fakeGeocoder) ReverseGeocode(ctx context.Context, lat, lng float64) (address.Address, error) {
+	point, err := f.Geocode(ctx, "")
+	if err != nil {
+		return address.Address{}, err
+	}
+	return address.Address{City: "Valletta", Country: "Malta", GeoJSON: point}, nil
+}
+
+func (f *fakeGeocoder) set(err error, block chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+	f.block = block
+}
+
+func (f *fakeGeocoder) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// fakeCacher keeps the cached values in memory.
+type fakeCacher struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (f *fakeCacher) KeySet(_ context.Context, key string, value interface{}, _ time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.values[key] = value.([]byte)
+	return nil
+}
+
+func (f *fakeCacher) KeyGet(_ context.Context, key string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.values[key], nil
+}
+
+func (f *fakeCacher) KeysGet(context.Context, string) ([]string, error) { return nil, nil }
+func (f *fakeCacher) KeyDelete(context.Context, string) error           { return nil }
+func (f *fakeCacher) KeyExist(context.Context, string) (bool, error)    { return false, nil }
+func (f *fakeCacher) HashGet(context.Context, string, string) ([]byte, error) {
+	return nil, nil
+}
+func (f *fakeCacher) HashSet(context.Context, string, string, interface{}, time.Duration) error {
+	return nil
+}
+func (f *fakeCacher) HealthCheck(context.Context) (string, error) { return "PONG", nil }
+
+// quietLogger drops the debug messages of the decorator.
+type quietLogger struct {
+	log.Logger
+}
+
+func (quietLogger) Debug(string, ...any) {}
+
+// GeocoderDecoratorTestSuite is the test suite of the geocoder cache, rate limit and
+// circuit breaker.
+type GeocoderDecoratorTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	base      *fakeGeocoder
+	decorator *address.GeocoderDecorator
+}
+
+// SetupTest wraps a new fake geocoder, the circuit opens after two failures for 50ms.
+func (s *GeocoderDecoratorTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.base = &fakeGeocoder{}
+	s.decorator = s.newDecorator(address.GeocoderPolicy{
+		FailureThreshold: 2,
+		OpenTimeout:      50 * time.Millisecond,
+		RatePerSecond:    1000,
+		Burst:            100,
+	})
+}
+
+func (s *GeocoderDecoratorTestSuite) newDecorator(policy address.GeocoderPolicy) *address.GeocoderDecorator {
+	return address.NewGeocoderDecorator(
+		s.base,
+		&fakeCacher{values: map[string][]byte{}},
+		quietLogger{},
+		policy,
+	)
+}
+
+// requireCircuitOpen checks that a call is rejected without reaching the geocoder.
+func (s *GeocoderDecoratorTestSuite) requireCircuitOpen(addr string) {
+	calls := s.base.count()
+	_, err := s.decorator.Geocode(s.ctx, addr)
+	s.Require().True(errors.Compare(err, errors.ErrCircuitOpen), "Expected the circuit to be open")
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.Unavailable, appErr.Code(), "Expected an unavailable error")
+	s.Equal(calls, s.base.count(), "Expected the geocoder not to be called")
+}
+
+// openCircuit fails the calls up to the threshold.
+func (s *GeocoderDecoratorTestSuite) openCircuit() {
+	s.base.set(errors.NewSimple("geocoder down"), nil)
+	for i := 0; i < 2; i++ {
+		_, err := s.decorator.Geocode(s.ctx, "Failing Street "+string(rune('A'+i)))
+		s.Require().Error(err, "Expected the geocoder error")
+	}
+	s.requireCircuitOpen("Failing Street C")
+}
+
+// TestCircuitOpensAndCloses tests that the circuit opens at the threshold and closes when the
+// trial call after the timeout succeeds.
+func (s *GeocoderDecoratorTestSuite) TestCircuitOpensAndCloses() {
+	s.openCircuit()
+
+	time.Sleep(60 * time.Millisecond)
+	s.base.set(nil, nil)
+	_, err := s.decorator.Geocode(s.ctx, "Triq il-Merkanti, Valletta")
+	s.Require().NoError(err, "Expected the trial call to succeed")
+	_, err = s.decorator.Geocode(s.ctx, "Triq ir-Repubblika, Valletta")
+	s.Require().NoError(err, "Expected the circuit to be closed")
+	s.Equal(4, s.base.count())
+}
+
+// TestCircuitHalfOpen tests that a single trial call is made after the timeout and that the
+// circuit opens again when it fails.
+func (s *GeocoderDecoratorTestSuite) TestCircuitHalfOpen() {
+	s.openCircuit()
+	time.Sleep(60 * time.Millisecond)
+
+	block := make(chan struct{})
+	s.base.set(errors.NewSimple("geocoder still down"), block)
+	done := make(chan error)
+	go func() {
+		_, err := s.decorator.Geocode(s.ctx, "Trial Street")
+		done <- err
+	}()
+	s.Require().Eventually(func() bool { return s.base.count() == 3 }, time.Second, time.Millisecond)
+	s.requireCircuitOpen("Second Trial Street")
+	close(block)
+	s.Require().Error(<-done, "Expected the trial call to fail")
+
+	s.base.set(nil, nil)
+	s.requireCircuitOpen("After Trial Street")
+}
+
+// TestCancelledCallIsNotAFailure tests that the calls the caller cancelled do not open the
+// circuit.
+func (s *GeocoderDecoratorTestSuite) TestCancelledCallIsNotAFailure() {
+	cancelled, cancel := context.WithCancel(s.ctx)
+	cancel()
+	for i := 0; i < 3; i++ {
+		_, err := s.decorator.Geocode(cancelled, "Cancelled Street "+string(rune('A'+i)))
+		s.Require().ErrorIs(err, context.Canceled)
+	}
+
+	_, err := s.decorator.Geocode(s.ctx, "Triq il-Merkanti, Valletta")
+	s.Require().NoError(err, "Expected the circuit to be closed")
+	s.Equal(4, s.base.count())
+}
+
+// TestRateLimit tests that the calls over the burst wait for a token and that a caller that
+// can not wait is rejected without calling the geocoder.
+func (s *GeocoderDecoratorTestSuite) TestRateLimit() {
+	s.decorator = s.newDecorator(address.GeocoderPolicy{RatePerSecond: 10, Burst: 2})
+	for i := 0; i < 2; i++ {
+		_, err := s.decorator.Geocode(s.ctx, "Burst Street "+string(rune('A'+i)))
+		s.Require().NoError(err, "Expected the burst not to wait")
+	}
+
+	short, cancel := context.WithTimeout(s.ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err := s.decorator.Geocode(short, "Waiting Street")
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(codes.Unavailable, appErr.Code(), "Expected an unavailable error")
+	s.Equal(2, s.base.count(), "Expected the geocoder not to be called")
+
+	start := time.Now()
+	_, err = s.decorator.Geocode(s.ctx, "Waiting Street")
+	s.Require().NoError(err)
+	s.GreaterOrEqual(time.Since(start), 50*time.Millisecond, "Expected the call to wait for a token")
+	s.Equal(3, s.base.count())
+}
+
+// TestCache tests that the same address written differently and an unresolved address are
+// read from the cache.
+func (s *GeocoderDecoratorTestSuite) TestCache() {
+	first, err := s.decorator.Geocode(s.ctx, "Triq il-Merkanti,  Valletta")
+	s.Require().NoError(err)
+	second, err := s.decorator.Geocode(s.ctx, "triq il-merkanti, valletta,")
+	s.Require().NoError(err)
+	s.Equal(first, second)
+
+	s.base.set(address.ErrAddressNotFound, nil)
+	for i := 0; i < 2; i++ {
+		_, err = s.decorator.Geocode(s.ctx, "Nowhere Street")
+		s.Require().ErrorIs(err, address.ErrAddressNotFound)
+	}
+	s.Equal(2, s.base.count(), "Expected the cached results not to call the geocoder")
+}
+
+func TestGeocoderDecoratorTestSuite(t *testing.T) {
+	suite.Run(t, &GeocoderDecoratorTestSuite{})
+}
@@ -0,0 +1,309 @@
+package address
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxReverseDistance is the distance in metres beyond which the nearest centroid is not
+// taken as the address of a location.
+const maxReverseDistance = 50000
+
+// earthRadius is the mean radius of the earth in metres.
+const earthRadius = 6371008.8
+
+// ErrInvalidDataset : The offline geocoder dataset cannot be read.
+var ErrInvalidDataset = errors.New("invalid geocoder dataset")
+
+// offlineGeocoderClient is an implementation of Geocoder reading a postcode to centroid
+// dataset, addresses are resolved by postcode or city and locations by the nearest centroid.
+type offlineGeocoderClient struct {
+	postcodes map[string]Address // Normalised postcode to its entry.
+	cities    map[string]Address // Lower case city to the mean centroid of its postcodes.
+	index     *centroidTree
+}
+
+// NewOfflineGeocoderClient creates a new Geocoder from a local dataset, a .csv file with a
+// header naming the postcode, city, county, country, latitude and longitude columns or a
+// GeoJSON FeatureCollection of points with those names as properties.
+func NewOfflineGeocoderClient(path string) (Geocoder, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []Address
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		entries, err = readCSVDataset(file)
+	case ".json", ".geojson":
+		entries, err = readGeoJSONDataset(file)
+	default:
+		err = fmt.Errorf("%w: unsupported file type %q", ErrInvalidDataset, filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newOfflineGeocoderClient(entries), nil
+}
+
+// newOfflineGeocoderClient indexes the entries of a dataset.
+func newOfflineGeocoderClient(entries []Address) *offlineGeocoderClient {
+	client := &offlineGeocoderClient{
+		postcodes: make(map[string]Address, len(entries)),
+		cities:    map[string]Address{},
+		index:     newCentroidTree(entries),
+	}
+	sums := map[string][3]float64{}
+	for _, entry := range entries {
+		if entry.PostalCode != "" {
+			client.postcodes[normalisePostcode(entry.PostalCode)] = entry
+		}
+		if entry.City == "" {
+			continue
+		}
+		city := strings.ToLower(entry.City)
+		if _, ok := client.cities[city]; !ok {
+			client.cities[city] = Address{City: entry.City, County: entry.County, Country: entry.Country}
+		}
+		sum := sums[city]
+		sums[city] = [3]float64{
+			sum[0] + entry.GeoJSON.Coordinates[0],
+			sum[1] + entry.GeoJSON.Coordinates[1],
+			sum[2] + 1,
+		}
+	}
+	for city, sum := range sums {
+		entry := client.cities[city]
+		entry.GeoJSON = NewPoint(sum[1]/sum[2], sum[0]/sum[2])
+		client.cities[city] = entry
+	}
+	return client
+}
+
+// Geocode converts an address to the centroid of its postcode, or of its city when the
+// postcode is unknown. The address parts are separated by commas as formatted by Address.
+func (c *offlineGeocoderClient) Geocode(ctx context.Context, address string) (*GeoJSONCoordinates, error) {
+	if strings.TrimSpace(address) == "" {
+		return nil, ErrEmptyAddress
+	}
+	parts := strings.Split(address, ",")
+	for _, part := range parts {
+		if entry, ok := c.postcodes[normalisePostcode(part)]; ok {
+			point := *entry.GeoJSON
+			return &point, nil
+		}
+	}
+	for _, part := range parts {
+		if entry, ok := c.cities[strings.ToLower(strings.TrimSpace(part))]; ok {
+			point := *entry.GeoJSON
+			return &point, nil
+		}
+	}
+	return nil, ErrAddressNotFound
+}
+
+// ReverseGeocode converts latitude and longitude values to the address of the nearest
+// centroid within maxReverseDistance.
+func (c *offlineGeocoderClient) ReverseGeocode(ctx context.Context, lat, lng float64) (Address, error) {
+	entry, distance, ok := c.index.nearest(lat, lng)
+	if !ok || distance > maxReverseDistance {
+		return Address{}, ErrAddressNotFound
+	}
+	entry.GeoJSON = NewPoint(lat, lng)
+	return entry, nil
+}
+
+// normalisePostcode returns the postcode in upper case without white space.
+func normalisePostcode(postcode string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(postcode), ""))
+}
+
+// readCSVDataset reads the entries of a CSV dataset, the columns are found by their header.
+func readCSVDataset(r io.Reader) ([]Address, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDataset, err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	_, latOK := columns["latitude"]
+	_, lngOK := columns["longitude"]
+	if !latOK || !lngOK {
+		return nil, fmt.Errorf("%w: latitude and longitude columns are required", ErrInvalidDataset)
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var entries []Address
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidDataset, err)
+		}
+		lat, latErr := strconv.ParseFloat(field(record, "latitude"), 64)
+		lng, lngErr := strconv.ParseFloat(field(record, "longitude"), 64)
+		if latErr != nil || lngErr != nil {
+			return nil, fmt.Errorf("%w: invalid coordinates on line %d", ErrInvalidDataset, line)
+		}
+		entries = append(entries, Address{
+			City:       field(record, "city"),
+			County:     field(record, "county"),
+			Country:    field(record, "country"),
+			PostalCode: field(record, "postcode"),
+			GeoJSON:    NewPoint(lat, lng),
+		})
+	}
+}
+
+// geoJSONDataset is the part of a GeoJSON FeatureCollection read as a dataset.
+type geoJSONDataset struct {
+	Features []struct {
+		Geometry   GeoJSONCoordinates `json:"geometry"`
+		Properties struct {
+			Postcode string `json:"postcode"`
+			City     string `json:"city"`
+			County   string `json:"county"`
+			Country  string `json:"country"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+// readGeoJSONDataset reads the point features of a GeoJSON dataset.
+func readGeoJSONDataset(r io.Reader) ([]Address, error) {
+	var dataset geoJSONDataset
+	if err := json.NewDecoder(r).Decode(&dataset); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDataset, err)
+	}
+	entries := make([]Address, 0, len(dataset.Features))
+	for i, feature := range dataset.Features {
+		if feature.Geometry.Type != "Point" {
+			return nil, fmt.Errorf("%w: feature %d is not a point", ErrInvalidDataset, i)
+		}
+		point := feature.Geometry
+		entries = append(entries, Address{
+			City:       feature.Properties.City,
+			County:     feature.Properties.County,
+			Country:    feature.Properties.Country,
+			PostalCode: feature.Properties.Postcode,
+			GeoJSON:    &point,
+		})
+	}
+	return entries, nil
+}
+
+// centroidTree is a k-d tree of the centroids on the unit sphere, the straight line distance
+// between two points on the sphere orders them as the great circle distance does.
+type centroidTree struct {
+	nodes []centroidNode
+	root  int
+}
+
+type centroidNode struct {
+	point       [3]float64
+	entry       Address
+	left, right int // Child indexes, -1 when absent.
+}
+
+// newCentroidTree builds the tree of the dataset entries.
+func newCentroidTree(entries []Address) *centroidTree {
+	tree := &centroidTree{nodes: make([]centroidNode, 0, len(entries))}
+	indexes := make([]int, len(entries))
+	for i, entry := range entries {
+		tree.nodes = append(tree.nodes, centroidNode{
+			point: unitVector(entry.GeoJSON.Coordinates[1], entry.GeoJSON.Coordinates[0]),
+			entry: entry,
+			left:  -1,
+			right: -1,
+		})
+		indexes[i] = i
+	}
+	tree.root = tree.build(indexes, 0)
+	return tree
+}
+
+// build links the nodes of indexes into a subtree split on the axis of its depth and
+// returns the index of its root.
+func (t *centroidTree) build(indexes []int, depth int) int {
+	if len(indexes) == 0 {
+		return -1
+	}
+	axis := depth % 3
+	sort.Slice(indexes, func(i, j int) bool {
+		return t.nodes[indexes[i]].point[axis] < t.nodes[indexes[j]].point[axis]
+	})
+	median := len(indexes) / 2
+	root := indexes[median]
+	t.nodes[root].left = t.build(indexes[:median], depth+1)
+	t.nodes[root].right = t.build(indexes[median+1:], depth+1)
+	return root
+}
+
+// nearest returns the entry nearest to a location and its great circle distance in metres.
+func (t *centroidTree) nearest(lat, lng float64) (Address, float64, bool) {
+	if t.root < 0 {
+		return Address{}, 0, false
+	}
+	target := unitVector(lat, lng)
+	best, bestDistance := -1, math.Inf(1)
+	var search func(node, depth int)
+	search = func(node, depth int) {
+		if node < 0 {
+			return
+		}
+		n := t.nodes[node]
+		if d := squaredDistance(n.point, target); d < bestDistance {
+			best, bestDistance = node, d
+		}
+		axis := depth % 3
+		diff := target[axis] - n.point[axis]
+		near, far := n.left, n.right
+		if diff > 0 {
+			near, far = n.right, n.left
+		}
+		search(near, depth+1)
+		if diff*diff < bestDistance {
+			search(far, depth+1)
+		}
+	}
+	search(t.root, 0)
+	chord := math.Sqrt(bestDistance)
+	return t.nodes[best].entry, 2 * earthRadius * math.Asin(math.Min(1, chord/2)), true
+}
+
+// unitVector returns the point of a latitude and longitude on the unit sphere.
+func unitVector(lat, lng float64) [3]float64 {
+	latRad, lngRad := lat*math.Pi/180, lng*math.Pi/180
+	return [3]float64{
+		math.Cos(latRad) * math.Cos(lngRad),
+		math.Cos(latRad) * math.Sin(lngRad),
+		math.Sin(latRad),
+	}
+}
+
+func squaredDistance(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
@@ -0,0 +1,257 @@
+package address
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationTag is the validator tag of an Address that must be valid, see RegisterValidation.
+const ValidationTag = "address"
+
+var (
+	// ErrUnknownCountry : The country is not an ISO 3166-1 country name or code.
+	ErrUnknownCountry = errors.New("unknown country: must be an ISO 3166-1 country name or code")
+	// ErrInvalidPostcode : The postcode does not match the postcode pattern of its country.
+	ErrInvalidPostcode = errors.New("invalid postcode for the country")
+)
+
+// country is an ISO 3166-1 country.
+type country struct {
+	Alpha2 string
+	Alpha3 string
+	Name   string
+}
+
+// postcodeRule is the canonical form of the postcodes of a country, the letters and digits
+// of a postcode are split at split, counted from the end when negative, by sep and the
+// result must match pattern.
+type postcodeRule struct {
+	pattern *regexp.Regexp
+	split   int
+	sep     string
+}
+
+// countryAliases are the common names of countries that are not their ISO 3166-1 name or code.
+var countryAliases = map[string]string{
+	"uk":               "GB",
+	"great britain":    "GB",
+	"britain":          "GB",
+	"england":          "GB",
+	"scotland":         "GB",
+	"wales":            "GB",
+	"northern ireland": "GB",
+	"united kingdom of great britain and northern ireland": "GB",
+	"america":                      "US",
+	"united states of america":     "US",
+	"holland":                      "NL",
+	"the netherlands":              "NL",
+	"czech republic":               "CZ",
+	"russia":                       "RU",
+	"turkey":                       "TR",
+	"turkiye":                      "TR",
+	"vietnam":                      "VN",
+	"laos":                         "LA",
+	"syria":                        "SY",
+	"korea":                        "KR",
+	"republic of korea":            "KR",
+	"vatican":                      "VA",
+	"vatican city":                 "VA",
+	"ivory coast":                  "CI",
+	"cote divoire":                 "CI",
+	"swaziland":                    "SZ",
+	"cape verde":                   "CV",
+	"macedonia":                    "MK",
+	"palestine":                    "PS",
+	"brunei":                       "BN",
+	"macau":                        "MO",
+	"aland islands":                "AX",
+	"curacao":                      "CW",
+	"reunion":                      "RE",
+	"saint barthelemy":             "BL",
+	"democratic republic of congo": "CD",
+}
+
+// postcodeRules are the postcode patterns of the countries that have them, the postcode of
+// any other country is only put in upper case with single spaces.
+var postcodeRules = func() map[string]postcodeRule {
+	rule := func(pattern string, split int, sep string) postcodeRule {
+		return postcodeRule{pattern: regexp.MustCompile(pattern), split: split, sep: sep}
+	}
+	rules := map[string]postcodeRule{
+		"GB": rule(`^(GIR 0AA|[A-Z]{1,2}\d[A-Z\d]? \d[A-Z]{2})$`, -3, " "),
+		"IE": rule(`^([AC-FHKNPRTV-Y]\d{2}|D6W) [0-9AC-FHKNPRTV-Y]{4}$`, 3, " "),
+		"MT": rule(`^[A-Z]{3} \d{2,4}$`, 3, " "),
+		"US": rule(`^\d{5}(-\d{4})?$`, 5, "-"),
+		"CA": rule(`^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] \d[ABCEGHJ-NPRSTV-Z]\d$`, 3, " "),
+		"NL": rule(`^[1-9]\d{3} [A-Z]{2}$`, 4, " "),
+		"PL": rule(`^\d{2}-\d{3}$`, 2, "-"),
+		"PT": rule(`^\d{4}-\d{3}$`, 4, "-"),
+		"BR": rule(`^\d{5}-\d{3}$`, 5, "-"),
+		"JP": rule(`^\d{3}-\d{4}$`, 3, "-"),
+		"LV": rule(`^LV-\d{4}$`, 2, "-"),
+	}
+	for _, code := range []string{"SE", "CZ", "SK", "GR"} {
+		rules[code] = rule(`^\d{3} \d{2}$`, 3, " ")
+	}
+	for _, code := range []string{"DE", "FR", "IT", "ES", "FI", "HR", "EE", "MX", "TR"} {
+		rules[code] = rule(`^\d{5}$`, 0, "")
+	}
+	for _, code := range []string{"AT", "BE", "CH", "DK", "NO", "AU", "NZ", "HU", "SI", "BG", "CY", "ZA"} {
+		rules[code] = rule(`^\d{4}$`, 0, "")
+	}
+	for _, code := range []string{"IN", "RU", "CN", "SG", "RO"} {
+		rules[code] = rule(`^\d{6}$`, 0, "")
+	}
+	return rules
+}()
+
+// countryIndex is the alpha-2 code of a country by its folded name, code or alias.
+var countryIndex = func() map[string]string {
+	index := make(map[string]string, 3*len(countries)+len(countryAliases))
+	for _, c := range countries {
+		index[foldCountry(c.Alpha2)] = c.Alpha2
+		index[foldCountry(c.Alpha3)] = c.Alpha2
+		index[foldCountry(c.Name)] = c.Alpha2
+	}
+	for alias, code := range countryAliases {
+		index[alias] = code
+	}
+	return index
+}()
+
+// countryNames is the name of a country by its alpha-2 code.
+var countryNames = func() map[string]string {
+	names := make(map[string]string, len(countries))
+	for _, c := range countries {
+		names[c.Alpha2] = c.Name
+	}
+	return names
+}()
+
+// CountryCode returns the ISO 3166-1 alpha-2 code of a country name, code or common alias.
+func CountryCode(country string) (string, bool) {
+	code, ok := countryIndex[foldCountry(country)]
+	return code, ok
+}
+
+// CountryName returns the canonical name of a country name, code or common alias.
+func CountryName(country string) (string, bool) {
+	code, ok := CountryCode(country)
+	if !ok {
+		return "", false
+	}
+	return countryNames[code], true
+}
+
+// Normalise returns the address in its canonical form, the country by its ISO 3166-1 name,
+// the postcode in the format of its country and the other parts with single spaces and, when
+// written all in one case, capitalised words. A postcode is only checked against its country
+// when the country is set.
+func Normalise(a Address) (Address, error) {
+	a.FirstLine = capitalise(a.FirstLine)
+	a.Street = capitalise(a.Street)
+	a.City = capitalise(a.City)
+	a.County = capitalise(a.County)
+	a.PostalCode = strings.ToUpper(strings.Join(strings.Fields(a.PostalCode), " "))
+	if a.Country = strings.Join(strings.Fields(a.Country), " "); a.Country == "" {
+		return a, nil
+	}
+
+	code, ok := CountryCode(a.Country)
+	if !ok {
+		return a, ErrUnknownCountry
+	}
+	a.Country = countryNames[code]
+	rule, ok := postcodeRules[code]
+	if !ok || a.PostalCode == "" {
+		return a, nil
+	}
+	a.PostalCode = rule.format(a.PostalCode)
+	if !rule.pattern.MatchString(a.PostalCode) {
+		return a, ErrInvalidPostcode
+	}
+	return a, nil
+}
+
+// RegisterValidation registers ValidationTag on a validator, an Address or *Address field with
+// the tag is valid when it can be normalised. An empty address is valid.
+func RegisterValidation(v *validator.Validate) error {
+	return v.RegisterValidation(ValidationTag, func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				return true
+			}
+			field = field.Elem()
+		}
+		a, ok := field.Interface().(Address)
+		if !ok {
+			return false
+		}
+		_, err := Normalise(a)
+		return err == nil
+	})
+}
+
+// format returns the postcode with its letters and digits split as the rule expects.
+func (r postcodeRule) format(postcode string) string {
+	compact := strings.Map(func(c rune) rune {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			return c
+		}
+		return -1
+	}, postcode)
+	split := r.split
+	if split < 0 {
+		split += len(compact)
+	}
+	if r.split == 0 || split <= 0 || split >= len(compact) {
+		return compact
+	}
+	return compact[:split] + r.sep + compact[split:]
+}
+
+// foldCountry returns a country in lower case with single spaces between its words, dots and
+// apostrophes are dropped so that "U.K." is "uk" and other punctuation separates words.
+func foldCountry(country string) string {
+	folded := strings.Map(func(r rune) rune {
+		switch {
+		case r == '.' || r == '\'' || r == '’':
+			return -1
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		default:
+			return ' '
+		}
+	}, country)
+	return strings.Join(strings.Fields(folded), " ")
+}
+
+// capitalise returns the text with single spaces and, when the text is all in lower or upper
+// case, every word capitalised. Words with digits, e.g. "12b", are put in upper case and
+// text in mixed case is kept as written.
+func capitalise(text string) string {
+	words := strings.Fields(text)
+	if text = strings.Join(words, " "); text != strings.ToLower(text) && text != strings.ToUpper(text) {
+		return text
+	}
+	for i, word := range words {
+		if strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+			words[i] = strings.ToUpper(word)
+			continue
+		}
+		runes := []rune(strings.ToLower(word))
+		for j, r := range runes {
+			if j == 0 || !unicode.IsLetter(runes[j-1]) && runes[j-1] != '\'' && runes[j-1] != '’' {
+				runes[j] = unicode.ToUpper(r)
+			}
+		}
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
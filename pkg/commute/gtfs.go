@@ -0,0 +1,265 @@
+package commute
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// The timetable time zone is loaded on hosts without a time zone database.
+	_ "time/tzdata"
+)
+
+// secondsPerDay is the length of a service day, a stop time after midnight is later than it.
+const secondsPerDay = 24 * 60 * 60
+
+// timetable is a GTFS timetable, the times are in seconds from the midnight of a service day.
+type timetable struct {
+	location   *time.Location
+	stops      []stop
+	trips      []trip
+	departures [][]departure // By stop, in departure order.
+	calendar   map[string]serviceCalendar
+	exceptions map[string]map[int]bool // By service and date, true when the service is added.
+}
+
+type stop struct {
+	position [2]float64 // [longitude, latitude]
+}
+
+type trip struct {
+	service   string
+	stopTimes []stopTime // In stop sequence order.
+}
+
+type stopTime struct {
+	stop      int
+	arrival   int32
+	departure int32
+}
+
+// departure is a trip leaving a stop, index is the position of the stop in the trip.
+type departure struct {
+	trip  int32
+	index int32
+	time  int32
+}
+
+// serviceCalendar holds the weekdays of a service from its start to its end date, inclusive.
+type serviceCalendar struct {
+	weekdays [7]bool // Sunday first, as time.Weekday.
+	start    int     // YYYYMMDD.
+	end      int
+}
+
+// loadTimetable reads the GTFS timetable in a directory, agency.txt, stops.txt, trips.txt,
+// stop_times.txt and calendar.txt or calendar_dates.txt are read.
+func loadTimetable(dir string) (*timetable, error) {
+	t := &timetable{
+		location:   time.UTC,
+		calendar:   map[string]serviceCalendar{},
+		exceptions: map[string]map[int]bool{},
+	}
+	if err := readGTFSFile(dir, "agency.txt", true, func(row map[string]string) error {
+		if zone := row["agency_timezone"]; zone != "" {
+			location, err := time.LoadLocation(zone)
+			if err != nil {
+				return err
+			}
+			t.location = location
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	stopIndexes := map[string]int{}
+	if err := readGTFSFile(dir, "stops.txt", true, func(row map[string]string) error {
+		lat, latErr := strconv.ParseFloat(row["stop_lat"], 64)
+		lng, lngErr := strconv.ParseFloat(row["stop_lon"], 64)
+		if latErr != nil || lngErr != nil {
+			return fmt.Errorf("stop %q has no coordinates", row["stop_id"])
+		}
+		stopIndexes[row["stop_id"]] = len(t.stops)
+		t.stops = append(t.stops, stop{position: [2]float64{lng, lat}})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	tripIndexes := map[string]int{}
+	if err := readGTFSFile(dir, "trips.txt", true, func(row map[string]string) error {
+		tripIndexes[row["trip_id"]] = len(t.trips)
+		t.trips = append(t.trips, trip{service: row["service_id"]})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sequences := make([][]int, len(t.trips))
+	if err := readGTFSFile(dir, "stop_times.txt", true, func(row map[string]string) error {
+		tripIndex, ok := tripIndexes[row["trip_id"]]
+		if !ok {
+			return fmt.Errorf("stop time of unknown trip %q", row["trip_id"])
+		}
+		stopIndex, ok := stopIndexes[row["stop_id"]]
+		if !ok {
+			return fmt.Errorf("stop time at unknown stop %q", row["stop_id"])
+		}
+		arrival, arrivalErr := parseGTFSTime(row["arrival_time"])
+		departure, departureErr := parseGTFSTime(row["departure_time"])
+		if arrivalErr != nil || departureErr != nil {
+			// Stops that are not timepoints have no times, the trip is boarded and left
+			// at its timepoints only.
+			return nil
+		}
+		sequence, err := strconv.Atoi(row["stop_sequence"])
+		if err != nil {
+			return fmt.Errorf("stop time of trip %q has no sequence", row["trip_id"])
+		}
+		t.trips[tripIndex].stopTimes = append(t.trips[tripIndex].stopTimes, stopTime{
+			stop: stopIndex, arrival: arrival, departure: departure,
+		})
+		sequences[tripIndex] = append(sequences[tripIndex], sequence)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	t.departures = make([][]departure, len(t.stops))
+	for tripIndex := range t.trips {
+		stopTimes, sequence := t.trips[tripIndex].stopTimes, sequences[tripIndex]
+		sort.Sort(bySequence{stopTimes: stopTimes, sequence: sequence})
+		for index, stopTime := range stopTimes {
+			t.departures[stopTime.stop] = append(t.departures[stopTime.stop], departure{
+				trip: int32(tripIndex), index: int32(index), time: stopTime.departure,
+			})
+		}
+	}
+	for _, departures := range t.departures {
+		sort.Slice(departures, func(a, b int) bool { return departures[a].time < departures[b].time })
+	}
+
+	calendarErr := readGTFSFile(dir, "calendar.txt", false, func(row map[string]string) error {
+		var calendar serviceCalendar
+		for day, name := range []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"} {
+			calendar.weekdays[day] = row[name] == "1"
+		}
+		var startErr, endErr error
+		calendar.start, startErr = strconv.Atoi(row["start_date"])
+		calendar.end, endErr = strconv.Atoi(row["end_date"])
+		if startErr != nil || endErr != nil {
+			return fmt.Errorf("service %q has no start or end date", row["service_id"])
+		}
+		t.calendar[row["service_id"]] = calendar
+		return nil
+	})
+	datesErr := readGTFSFile(dir, "calendar_dates.txt", false, func(row map[string]string) error {
+		date, err := strconv.Atoi(row["date"])
+		if err != nil {
+			return fmt.Errorf("service %q has an exception without a date", row["service_id"])
+		}
+		if t.exceptions[row["service_id"]] == nil {
+			t.exceptions[row["service_id"]] = map[int]bool{}
+		}
+		t.exceptions[row["service_id"]][date] = row["exception_type"] == "1"
+		return nil
+	})
+	for _, err := range []error{calendarErr, datesErr} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(t.calendar) == 0 && len(t.exceptions) == 0 {
+		return nil, fmt.Errorf("%w: calendar.txt or calendar_dates.txt is required", ErrInvalidTimetable)
+	}
+	return t, nil
+}
+
+// active reports whether a service runs on a date, YYYYMMDD.
+func (t *timetable) active(service string, date int, weekday time.Weekday) bool {
+	if added, ok := t.exceptions[service][date]; ok {
+		return added
+	}
+	calendar, ok := t.calendar[service]
+	return ok && calendar.weekdays[weekday] && date >= calendar.start && date <= calendar.end
+}
+
+// bySequence sorts the stop times of a trip by their stop sequence.
+type bySequence struct {
+	stopTimes []stopTime
+	sequence  []int
+}
+
+func (s bySequence) Len() int           { return len(s.stopTimes) }
+func (s bySequence) Less(a, b int) bool { return s.sequence[a] < s.sequence[b] }
+func (s bySequence) Swap(a, b int) {
+	s.stopTimes[a], s.stopTimes[b] = s.stopTimes[b], s.stopTimes[a]
+	s.sequence[a], s.sequence[b] = s.sequence[b], s.sequence[a]
+}
+
+// readGTFSFile calls read with every row of a GTFS file by column name, a file that is not
+// required may be missing.
+func readGTFSFile(dir string, name string, required bool, read func(row map[string]string) error) error {
+	file, err := os.Open(filepath.Join(dir, name))
+	if errors.Is(err, fs.ErrNotExist) && !required {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTimetable, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidTimetable, name, err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidTimetable, name, err)
+		}
+		row := make(map[string]string, len(header))
+		for i, value := range record {
+			if i < len(header) {
+				row[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		if err := read(row); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidTimetable, name, err)
+		}
+	}
+}
+
+// parseGTFSTime returns the seconds of a HH:MM:SS time from the midnight of its service day,
+// the hours of a trip running past midnight are 24 or more.
+func parseGTFSTime(value string) (int32, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	var seconds int32
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid time %q", value)
+		}
+		seconds = seconds*60 + int32(n)
+	}
+	return seconds, nil
+}
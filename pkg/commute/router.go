@@ -0,0 +1,179 @@
+// Package commute computes the public transport and walking travel times from a destination,
+// e.g. a workplace, to the cells of a grid, read from a GTFS timetable and a walking network.
+package commute
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"property-service/pkg/address"
+)
+
+const (
+	// CellSize is the side of a grid cell in degrees, about 110 metres north to south.
+	CellSize = 0.001
+	// WalkSpeed is the walking speed in metres per second, about 4.8 km/h.
+	WalkSpeed = 1.34
+	// MaxDuration is the longest travel time an isochrone is computed for.
+	MaxDuration = 2 * time.Hour
+)
+
+var (
+	// ErrInvalidTimetable : The GTFS timetable cannot be read.
+	ErrInvalidTimetable = errors.New("invalid GTFS timetable")
+	// ErrInvalidWalkingNetwork : The walking network cannot be read.
+	ErrInvalidWalkingNetwork = errors.New("invalid walking network")
+	// ErrUnavailable : No timetable is configured.
+	ErrUnavailable = errors.New("commute search is not configured")
+)
+
+// Router computes isochrones.
+type Router interface {
+	// Isochrone returns the travel times from the destination, leaving at the departure time,
+	// to the cells reached within maxDuration.
+	Isochrone(
+		ctx context.Context,
+		destination address.GeoJSONCoordinates,
+		departure time.Time,
+		maxDuration time.Duration,
+	) (*Isochrone, error)
+}
+
+// unavailableRouter is the Router of a service without a timetable.
+type unavailableRouter struct{}
+
+// NewUnavailableRouter creates a Router failing every call with ErrUnavailable.
+func NewUnavailableRouter() Router {
+	return unavailableRouter{}
+}
+
+// Isochrone implements Router.
+func (unavailableRouter) Isochrone(
+	context.Context,
+	address.GeoJSONCoordinates,
+	time.Time,
+	time.Duration,
+) (*Isochrone, error) {
+	return nil, ErrUnavailable
+}
+
+// Cell is a cell of the grid, the cell of a point is its latitude and longitude divided by
+// CellSize and rounded down.
+type Cell struct {
+	Row int32 // Latitude.
+	Col int32 // Longitude.
+}
+
+// CellOf returns the cell of a point.
+func CellOf(point address.GeoJSONCoordinates) Cell {
+	return Cell{
+		Row: int32(math.Floor(point.Coordinates[1] / CellSize)),
+		Col: int32(math.Floor(point.Coordinates[0] / CellSize)),
+	}
+}
+
+// Centre returns the point at the centre of the cell.
+func (c Cell) Centre() address.GeoJSONCoordinates {
+	return *address.NewPoint((float64(c.Row)+0.5)*CellSize, (float64(c.Col)+0.5)*CellSize)
+}
+
+// Isochrone holds the travel times from a destination to the cells of the grid reached
+// within its maximum duration.
+type Isochrone struct {
+	Destination address.GeoJSONCoordinates
+	Departure   time.Time
+	MaxDuration time.Duration
+	times       map[Cell]time.Duration
+}
+
+// newIsochrone returns an isochrone without reached cells.
+func newIsochrone(destination address.GeoJSONCoordinates, departure time.Time, maxDuration time.Duration) *Isochrone {
+	return &Isochrone{
+		Destination: destination,
+		Departure:   departure,
+		MaxDuration: maxDuration,
+		times:       map[Cell]time.Duration{},
+	}
+}
+
+// reach records the travel time to a cell unless it is already reached sooner.
+func (i *Isochrone) reach(cell Cell, travelTime time.Duration) {
+	if travelTime > i.MaxDuration {
+		return
+	}
+	if known, ok := i.times[cell]; !ok || travelTime < known {
+		i.times[cell] = travelTime
+	}
+}
+
+// Len returns the number of cells reached.
+func (i *Isochrone) Len() int {
+	return len(i.times)
+}
+
+// TravelTime returns the travel time to the cell of a point, false when it is not reached.
+func (i *Isochrone) TravelTime(point address.GeoJSONCoordinates) (time.Duration, bool) {
+	travelTime, ok := i.times[CellOf(point)]
+	return travelTime, ok
+}
+
+// Boxes returns the cells reached in at least from and less than to as bounding boxes, the
+// cells next to each other in a row share a box.
+func (i *Isochrone) Boxes(from time.Duration, to time.Duration) []address.BoundingBox {
+	cells := make([]Cell, 0, len(i.times))
+	for cell, travelTime := range i.times {
+		if travelTime >= from && travelTime < to {
+			cells = append(cells, cell)
+		}
+	}
+	sort.Slice(cells, func(a, b int) bool {
+		if cells[a].Row != cells[b].Row {
+			return cells[a].Row < cells[b].Row
+		}
+		return cells[a].Col < cells[b].Col
+	})
+	var boxes []address.BoundingBox
+	for start := 0; start < len(cells); {
+		end := start + 1
+		for end < len(cells) && cells[end].Row == cells[start].Row && cells[end].Col == cells[end-1].Col+1 {
+			end++
+		}
+		first, last := cells[start], cells[end-1]
+		boxes = append(boxes, address.BoundingBox{
+			BottomLeft: *address.NewPoint(float64(first.Row)*CellSize, float64(first.Col)*CellSize),
+			TopRight:   *address.NewPoint(float64(last.Row+1)*CellSize, float64(last.Col+1)*CellSize),
+		})
+		start = end
+	}
+	return boxes
+}
+
+// Area returns the cells reached in at least from and less than to as a multi polygon of
+// their boxes, without polygons when no cell is.
+func (i *Isochrone) Area(from time.Duration, to time.Duration) address.GeoJSONMultiPolygon {
+	boxes := i.Boxes(from, to)
+	polygons := make([]address.GeoJSONPolygon, 0, len(boxes))
+	for _, box := range boxes {
+		polygons = append(polygons, box.Polygon())
+	}
+	return address.NewMultiPolygon(polygons...)
+}
+
+// distance returns the great circle distance in metres between two [longitude, latitude]
+// positions.
+func distance(a [2]float64, b [2]float64) float64 {
+	const earthRadius = 6371008.8
+	lat1, lat2 := a[1]*math.Pi/180, b[1]*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b[0] - a[0]) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// walkTime returns the time to walk a distance in metres.
+func walkTime(metres float64) time.Duration {
+	return time.Duration(metres / WalkSpeed * float64(time.Second))
+}
@@ -0,0 +1,126 @@
+package commute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"property-service/pkg/address"
+	redis "property-service/pkg/infrastructure/cache"
+	"property-service/pkg/infrastructure/log"
+)
+
+// RouterPolicy configures the RouterDecorator, a zero field takes its default.
+type RouterPolicy struct {
+	CacheExpire   time.Duration // How long an isochrone is cached, default 1 day.
+	DepartureStep time.Duration // Step the departure times are rounded down to, default 5 minutes.
+}
+
+func (p RouterPolicy) withDefaults() RouterPolicy {
+	if p.CacheExpire <= 0 {
+		p.CacheExpire = 24 * time.Hour
+	}
+	if p.DepartureStep <= 0 {
+		p.DepartureStep = 5 * time.Minute
+	}
+	return p
+}
+
+// RouterDecorator is a decorator for Router that computes the isochrones from the centre of
+// the cell of the destination at the departure time rounded down to a step, so every
+// destination in a cell shares the isochrone cached in Redis. Cache errors do not fail a call.
+type RouterDecorator struct {
+	base   Router
+	cacher redis.Cacher
+	log    log.Logger
+	policy RouterPolicy
+}
+
+var _ Router = (*RouterDecorator)(nil)
+
+// NewRouterDecorator wraps a router with a cache per destination cell.
+func NewRouterDecorator(base Router, cacher redis.Cacher, logger log.Logger, policy RouterPolicy) *RouterDecorator {
+	return &RouterDecorator{
+		base:   base,
+		cacher: cacher,
+		log:    logger,
+		policy: policy.withDefaults(),
+	}
+}
+
+// cachedIsochrone is a cached isochrone, the travel times are in seconds.
+type cachedIsochrone struct {
+	Destination address.GeoJSONCoordinates `json:"destination"`
+	Departure   time.Time                  `json:"departure"`
+	MaxDuration time.Duration              `json:"maxDuration"`
+	Cells       []cachedCell               `json:"cells"`
+}
+
+type cachedCell struct {
+	Row     int32 `json:"r"`
+	Col     int32 `json:"c"`
+	Seconds int32 `json:"s"`
+}
+
+// Isochrone implements Router.
+func (d *RouterDecorator) Isochrone(
+	ctx context.Context,
+	destination address.GeoJSONCoordinates,
+	departure time.Time,
+	maxDuration time.Duration,
+) (*Isochrone, error) {
+	cell := CellOf(destination)
+	departure = departure.Truncate(d.policy.DepartureStep)
+	key := fmt.Sprintf("commute:%d:%d:%d:%d", cell.Row, cell.Col, departure.Unix(), int64(maxDuration.Seconds()))
+	if cached, ok := d.cached(ctx, key); ok {
+		return cached, nil
+	}
+
+	isochrone, err := d.base.Isochrone(ctx, cell.Centre(), departure, maxDuration)
+	if err != nil {
+		return nil, err
+	}
+	d.cache(ctx, key, isochrone)
+	return isochrone, nil
+}
+
+// cached returns a cached isochrone, false when it is not cached or cannot be read.
+func (d *RouterDecorator) cached(ctx context.Context, key string) (*Isochrone, bool) {
+	data, err := d.cacher.KeyGet(ctx, key)
+	if err != nil {
+		d.log.Debug("Failed to read the commute cache: %v", err)
+		return nil, false
+	}
+	var cached cachedIsochrone
+	if data == nil || json.Unmarshal(data, &cached) != nil {
+		return nil, false
+	}
+	isochrone := newIsochrone(cached.Destination, cached.Departure, cached.MaxDuration)
+	for _, cell := range cached.Cells {
+		isochrone.times[Cell{Row: cell.Row, Col: cell.Col}] = time.Duration(cell.Seconds) * time.Second
+	}
+	return isochrone, true
+}
+
+// cache stores an isochrone, a cache error is only logged.
+func (d *RouterDecorator) cache(ctx context.Context, key string, isochrone *Isochrone) {
+	cached := cachedIsochrone{
+		Destination: isochrone.Destination,
+		Departure:   isochrone.Departure,
+		MaxDuration: isochrone.MaxDuration,
+		Cells:       make([]cachedCell, 0, len(isochrone.times)),
+	}
+	for cell, travelTime := range isochrone.times {
+		cached.Cells = append(cached.Cells, cachedCell{
+			Row: cell.Row, Col: cell.Col, Seconds: int32(travelTime.Seconds()),
+		})
+	}
+	data, err := json.Marshal(cached)
+	if err == nil {
+		err = d.cacher.KeySet(ctx, key, data, d.policy.CacheExpire)
+	}
+	if err != nil {
+		d.log.Debug("Failed to write the commute cache: %v", err)
+	}
+}
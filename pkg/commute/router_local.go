@@ -0,0 +1,242 @@
+package commute
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"time"
+
+	"property-service/pkg/address"
+)
+
+const (
+	// maxAccessDistance is the furthest in metres a stop or the destination may be from the
+	// walking network, they are linked to its nearest node within that distance.
+	maxAccessDistance = 500
+	// maxCellWalk is the furthest in metres from a reached node or stop to the centre of a
+	// cell it reaches on foot.
+	maxCellWalk = 250
+)
+
+// localRouter is an implementation of Router on a GTFS timetable and a walking network read
+// from local files, the travel times are the earliest arrivals of a time dependent Dijkstra
+// search over the footpaths and the trips.
+type localRouter struct {
+	timetable *timetable
+	walking   *walkingNetwork
+	stopNodes []int     // Nearest walking node of every stop, -1 when there is none.
+	stopWalks []int32   // Seconds from every stop to its walking node.
+	nodeStops [][]int32 // Stops linked to every walking node.
+}
+
+var _ Router = (*localRouter)(nil)
+
+// NewLocalRouter creates a new Router from a directory holding a GTFS timetable and a
+// GeoJSON FeatureCollection of the footpaths.
+func NewLocalRouter(timetableDir string, walkingNetworkPath string) (Router, error) {
+	timetable, err := loadTimetable(timetableDir)
+	if err != nil {
+		return nil, err
+	}
+	walking, err := loadWalkingNetwork(walkingNetworkPath)
+	if err != nil {
+		return nil, err
+	}
+	router := &localRouter{
+		timetable: timetable,
+		walking:   walking,
+		stopNodes: make([]int, len(timetable.stops)),
+		stopWalks: make([]int32, len(timetable.stops)),
+		nodeStops: make([][]int32, len(walking.positions)),
+	}
+	for i, stop := range timetable.stops {
+		node, metres, ok := walking.nearest(stop.position, maxAccessDistance)
+		if !ok {
+			router.stopNodes[i] = -1
+			continue
+		}
+		router.stopNodes[i] = node
+		router.stopWalks[i] = int32(math.Ceil(walkTime(metres).Seconds()))
+		router.nodeStops[node] = append(router.nodeStops[node], int32(i))
+	}
+	return router, nil
+}
+
+// serviceDay is a day whose trips may be boarded, offset is the seconds from the midnight of
+// the departure day to its midnight.
+type serviceDay struct {
+	date    int // YYYYMMDD.
+	weekday time.Weekday
+	offset  int32
+	boarded []int32 // The first stop index every trip was boarded at.
+}
+
+// Isochrone implements Router.
+func (r *localRouter) Isochrone(
+	ctx context.Context,
+	destination address.GeoJSONCoordinates,
+	departure time.Time,
+	maxDuration time.Duration,
+) (*Isochrone, error) {
+	if maxDuration > MaxDuration {
+		maxDuration = MaxDuration
+	}
+	isochrone := newIsochrone(destination, departure, maxDuration)
+	local := departure.In(r.timetable.location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, r.timetable.location)
+	start := int32(local.Sub(midnight).Seconds())
+	limit := start + int32(maxDuration.Seconds())
+
+	// The trips of the day before run on past midnight.
+	days := make([]serviceDay, 0, 2)
+	for _, day := range []time.Time{midnight, midnight.AddDate(0, 0, -1)} {
+		boarded := make([]int32, len(r.timetable.trips))
+		for i := range boarded {
+			boarded[i] = math.MaxInt32
+		}
+		days = append(days, serviceDay{
+			date:    day.Year()*10000 + int(day.Month())*100 + day.Day(),
+			weekday: day.Weekday(),
+			offset:  int32(day.Sub(midnight).Round(time.Hour).Seconds()),
+			boarded: boarded,
+		})
+	}
+
+	// The walking nodes are followed by the stops.
+	nodeCount := len(r.walking.positions)
+	arrivals := make([]int32, nodeCount+len(r.timetable.stops))
+	for i := range arrivals {
+		arrivals[i] = math.MaxInt32
+	}
+	queue := &arrivalQueue{}
+	relax := func(node int, arrival int32) {
+		if arrival <= limit && arrival < arrivals[node] {
+			arrivals[node] = arrival
+			heap.Push(queue, nodeArrival{node: node, arrival: arrival})
+		}
+	}
+	if node, metres, ok := r.walking.nearest(destination.Coordinates, maxAccessDistance); ok {
+		relax(node, start+int32(math.Ceil(walkTime(metres).Seconds())))
+	}
+	for i, stop := range r.timetable.stops {
+		if metres := distance(destination.Coordinates, stop.position); metres <= maxAccessDistance {
+			relax(nodeCount+i, start+int32(math.Ceil(walkTime(metres).Seconds())))
+		}
+	}
+
+	for popped := 0; queue.Len() > 0; popped++ {
+		if popped%1024 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		current := heap.Pop(queue).(nodeArrival)
+		if current.arrival > arrivals[current.node] {
+			continue
+		}
+		if current.node < nodeCount {
+			for _, edge := range r.walking.edges[current.node] {
+				relax(edge.to, current.arrival+edge.duration)
+			}
+			for _, stop := range r.nodeStops[current.node] {
+				relax(nodeCount+int(stop), current.arrival+r.stopWalks[stop])
+			}
+			continue
+		}
+		stop := current.node - nodeCount
+		if node := r.stopNodes[stop]; node >= 0 {
+			relax(node, current.arrival+r.stopWalks[stop])
+		}
+		for d := range days {
+			r.ride(&days[d], stop, current.arrival, limit, func(stop int, arrival int32) {
+				relax(nodeCount+stop, arrival)
+			})
+		}
+	}
+
+	reach(isochrone, destination.Coordinates, 0)
+	for node, arrival := range arrivals {
+		if arrival > limit {
+			continue
+		}
+		var position [2]float64
+		if node < nodeCount {
+			position = r.walking.positions[node]
+		} else {
+			position = r.timetable.stops[node-nodeCount].position
+		}
+		reach(isochrone, position, time.Duration(arrival-start)*time.Second)
+	}
+	return isochrone, nil
+}
+
+// ride boards the trips of a service day leaving a stop from the arrival time until the
+// limit and calls alight with every later stop of the trips and its arrival time. A trip is
+// only ridden again from an earlier stop than before.
+func (r *localRouter) ride(day *serviceDay, stop int, arrival int32, limit int32, alight func(stop int, arrival int32)) {
+	departures := r.timetable.departures[stop]
+	first, last := 0, len(departures)
+	for first < last {
+		middle := (first + last) / 2
+		if departures[middle].time+day.offset < arrival {
+			first = middle + 1
+		} else {
+			last = middle
+		}
+	}
+	for _, departure := range departures[first:] {
+		if departure.time+day.offset > limit {
+			return
+		}
+		trip := r.timetable.trips[departure.trip]
+		boarded := day.boarded[departure.trip]
+		if departure.index >= boarded || !r.timetable.active(trip.service, day.date, day.weekday) {
+			continue
+		}
+		day.boarded[departure.trip] = departure.index
+		for index := departure.index + 1; index < int32(len(trip.stopTimes)) && index <= boarded; index++ {
+			stopTime := trip.stopTimes[index]
+			if stopTime.arrival+day.offset > limit {
+				break
+			}
+			alight(stopTime.stop, stopTime.arrival+day.offset)
+		}
+	}
+}
+
+// reach records the travel time to the cell of a position and the travel times on foot to
+// the cells whose centre is within maxCellWalk metres of it.
+func reach(isochrone *Isochrone, position [2]float64, travelTime time.Duration) {
+	point := address.GeoJSONCoordinates{Type: "Point", Coordinates: position}
+	centre := CellOf(point)
+	isochrone.reach(centre, travelTime)
+	radius := math.Min(maxCellWalk, (isochrone.MaxDuration-travelTime).Seconds()*WalkSpeed)
+	rows := int32(math.Ceil(radius / (CellSize * metresPerDegree)))
+	cols := int32(math.Ceil(radius / (CellSize * metresPerDegree * math.Max(math.Cos(position[1]*math.Pi/180), 0.01))))
+	for row := centre.Row - rows; row <= centre.Row+rows; row++ {
+		for col := centre.Col - cols; col <= centre.Col+cols; col++ {
+			cell := Cell{Row: row, Col: col}
+			if metres := distance(position, cell.Centre().Coordinates); metres <= radius {
+				isochrone.reach(cell, travelTime+walkTime(metres))
+			}
+		}
+	}
+}
+
+// nodeArrival is the arrival time at a node of the search.
+type nodeArrival struct {
+	node    int
+	arrival int32
+}
+
+// arrivalQueue is a priority queue of the nodes, the earliest arrival first.
+type arrivalQueue []nodeArrival
+
+func (q arrivalQueue) Len() int            { return len(q) }
+func (q arrivalQueue) Less(a, b int) bool  { return q[a].arrival < q[b].arrival }
+func (q arrivalQueue) Swap(a, b int)       { q[a], q[b] = q[b], q[a] }
+func (q *arrivalQueue) Push(x interface{}) { *q = append(*q, x.(nodeArrival)) }
+func (q *arrivalQueue) Pop() interface{} {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,143 @@
+package commute
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+)
+
+const (
+	// vertexPrecision is the number of decimals the positions of the walking network are
+	// rounded to when the lines sharing a vertex are joined, about 11 centimetres.
+	vertexPrecision = 1e6
+	// indexCellSize is the side in degrees of a cell of the index of the nearest vertices.
+	indexCellSize = 0.005
+	// metresPerDegree is the length of a degree of latitude.
+	metresPerDegree = 111195.0
+)
+
+// walkingNetwork is the graph of the footpaths, the vertices of the lines are its nodes.
+type walkingNetwork struct {
+	positions [][2]float64 // [longitude, latitude]
+	edges     [][]edge     // By node.
+	index     map[Cell][]int
+}
+
+// edge is a footpath to a node and the time it takes to walk it.
+type edge struct {
+	to       int
+	duration int32 // Seconds.
+}
+
+// geoJSONLines is a GeoJSON FeatureCollection of LineString and MultiLineString features.
+type geoJSONLines struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"features"`
+}
+
+// loadWalkingNetwork reads a GeoJSON FeatureCollection of the footpaths as LineString or
+// MultiLineString features, lines are joined at the vertices they share.
+func loadWalkingNetwork(path string) (*walkingNetwork, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWalkingNetwork, err)
+	}
+	var collection geoJSONLines
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWalkingNetwork, err)
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("%w: not a FeatureCollection", ErrInvalidWalkingNetwork)
+	}
+
+	network := &walkingNetwork{index: map[Cell][]int{}}
+	nodes := map[[2]int64]int{}
+	node := func(position [2]float64) int {
+		key := [2]int64{
+			int64(math.Round(position[0] * vertexPrecision)),
+			int64(math.Round(position[1] * vertexPrecision)),
+		}
+		if index, ok := nodes[key]; ok {
+			return index
+		}
+		index := len(network.positions)
+		nodes[key] = index
+		network.positions = append(network.positions, position)
+		network.edges = append(network.edges, nil)
+		cell := indexCell(position)
+		network.index[cell] = append(network.index[cell], index)
+		return index
+	}
+	for i, feature := range collection.Features {
+		var lines [][][2]float64
+		switch feature.Geometry.Type {
+		case "LineString":
+			var line [][2]float64
+			err = json.Unmarshal(feature.Geometry.Coordinates, &line)
+			lines = append(lines, line)
+		case "MultiLineString":
+			err = json.Unmarshal(feature.Geometry.Coordinates, &lines)
+		default:
+			// Other geometries, e.g. the points of a path, are not footpaths.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: feature %d: %v", ErrInvalidWalkingNetwork, i, err)
+		}
+		for _, line := range lines {
+			for j := 1; j < len(line); j++ {
+				from, to := node(line[j-1]), node(line[j])
+				if from == to {
+					continue
+				}
+				duration := int32(math.Ceil(walkTime(distance(line[j-1], line[j])).Seconds()))
+				network.edges[from] = append(network.edges[from], edge{to: to, duration: duration})
+				network.edges[to] = append(network.edges[to], edge{to: from, duration: duration})
+			}
+		}
+	}
+	if len(network.positions) == 0 {
+		return nil, fmt.Errorf("%w: no footpaths", ErrInvalidWalkingNetwork)
+	}
+	return network, nil
+}
+
+// nearest returns the node nearest to a position within radius metres and its distance,
+// false when there is none.
+func (n *walkingNetwork) nearest(position [2]float64, radius float64) (int, float64, bool) {
+	best, bestDistance := -1, math.Inf(1)
+	for _, candidate := range n.within(position, radius) {
+		if d := distance(position, n.positions[candidate]); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best, bestDistance, best >= 0 && bestDistance <= radius
+}
+
+// within returns the nodes in the index cells that may be within radius metres of a position.
+func (n *walkingNetwork) within(position [2]float64, radius float64) []int {
+	rows := int32(math.Ceil(radius / (indexCellSize * metresPerDegree)))
+	cols := int32(math.Ceil(radius / (indexCellSize * metresPerDegree * math.Max(math.Cos(position[1]*math.Pi/180), 0.01))))
+	centre := indexCell(position)
+	var nodes []int
+	for row := centre.Row - rows; row <= centre.Row+rows; row++ {
+		for col := centre.Col - cols; col <= centre.Col+cols; col++ {
+			nodes = append(nodes, n.index[Cell{Row: row, Col: col}]...)
+		}
+	}
+	return nodes
+}
+
+// indexCell returns the cell of the nearest vertex index a position is in.
+func indexCell(position [2]float64) Cell {
+	return Cell{
+		Row: int32(math.Floor(position[1] / indexCellSize)),
+		Col: int32(math.Floor(position[0] / indexCellSize)),
+	}
+}
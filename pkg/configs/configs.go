@@ -7,6 +7,9 @@ type Config struct {
 	SchemeVersion SchemeVersionStruct
 	Gcloud        GoogleCloudStruct
 	Caching       CachingStruct
+	Geocoding     GeocodingStruct
+	POIs          POIStruct
+	Commute       CommuteStruct
 }
 
 type SchemeVersionStruct struct {
@@ -26,10 +29,11 @@ type BackendStruct struct {
 }
 
 type DatabaseStruct struct {
-	URI      string
-	Username string
-	Password string
-	CSFLE    CSFLE
+	URI        string
+	Username   string
+	Password   string
+	Pagination string // "atlas" (default) for Atlas Search or "keyset" for normal indexes
+	CSFLE      CSFLE
 }
 
 type CSFLE struct {
@@ -49,6 +53,21 @@ type GoogleCloudStruct struct {
 	PrivateKey         []byte
 }
 
+type GeocodingStruct struct {
+	Provider     string // "google" (default) or "offline" for the local dataset
+	GoogleAPIKey string
+	DatasetPath  string // CSV or GeoJSON postcode dataset of the offline provider
+}
+
+type POIStruct struct {
+	DatasetPath string // GeoJSON points of interest loaded on start unless already loaded, none are loaded when empty
+}
+
+type CommuteStruct struct {
+	TimetablePath      string // Directory of the GTFS timetable, commute search is unavailable when empty
+	WalkingNetworkPath string // GeoJSON footpaths as LineString features
+}
+
 type CachingStruct struct {
 	Addr     string
 	Password string
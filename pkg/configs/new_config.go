@@ -12,6 +12,9 @@ func New() Config {
 		Gcloud:        createGoogleCloud(),
 		Caching:       createCaching(),
 		SchemeVersion: createSchemeVersion(),
+		Geocoding:     createGeocoding(),
+		POIs:          createPOIs(),
+		Commute:       createCommute(),
 	}
 }
 func createBackendConfig() BackendStruct {
@@ -27,9 +30,10 @@ func createBackendConfig() BackendStruct {
 
 func createDatabase() DatabaseStruct {
 	return DatabaseStruct{
-		URI:      os.Getenv("mongoDBURI"),
-		Username: os.Getenv("mongoUser"),
-		Password: os.Getenv("mongoPass"),
+		URI:        os.Getenv("mongoDBURI"),
+		Username:   os.Getenv("mongoUser"),
+		Password:   os.Getenv("mongoPass"),
+		Pagination: os.Getenv("mongoPagination"),
 		CSFLE: CSFLE{
 			Email:      os.Getenv("service_account_email"),
 			PrivateKey: os.Getenv("service_account_private_key"),
@@ -59,6 +63,27 @@ func createCaching() CachingStruct {
 	}
 }
 
+func createPOIs() POIStruct {
+	return POIStruct{
+		DatasetPath: os.Getenv("poiDataset"),
+	}
+}
+
+func createCommute() CommuteStruct {
+	return CommuteStruct{
+		TimetablePath:      os.Getenv("commuteTimetable"),
+		WalkingNetworkPath: os.Getenv("commuteWalkingNetwork"),
+	}
+}
+
+func createGeocoding() GeocodingStruct {
+	return GeocodingStruct{
+		Provider:     os.Getenv("geocoder"),
+		GoogleAPIKey: os.Getenv("googleMapsAPIKey"),
+		DatasetPath:  os.Getenv("geocoderDataset"),
+	}
+}
+
 func createSchemeVersion() SchemeVersionStruct {
 	return SchemeVersionStruct{
 		AuthenticationUser: os.Getenv("SchemeVAuthUser"),
@@ -39,12 +39,15 @@ func (d queryValidationDecorator[C, R]) Handle(c context.Context, cmd C,
 			reqValidationErr,
 		)
 	}
-	// Carry out the endpoint.
+	// Carry out the endpoint, an error of the handler is returned with its own code.
 	res, err := d.base.Handle(c, cmd)
+	if err != nil {
+		return res, err
+	}
 	// Validate the response being sent back.
 	if resValidationErr := d.validator.Struct(res); resValidationErr != nil {
 		d.logger.Error("Invalid response: %+v", resValidationErr.Error())
-		return *new(R), errors.NewInternalError(err)
+		return *new(R), errors.NewInternalError(resValidationErr)
 	}
 	// Return the  response
 	return res, err
@@ -0,0 +1,99 @@
+package decorator
+
+import (
+	"context"
+	"testing"
+
+	"property-service/pkg/errors"
+	"property-service/pkg/errors/codes"
+	"property-service/pkg/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/suite"
+)
+
+// quietLogger drops every message.
+type quietLogger struct{}
+
+func (quietLogger) Info(string, ...any)                {}
+func (quietLogger) Error(string, ...any)               {}
+func (quietLogger) Panic(string, ...any)               {}
+func (quietLogger) Fatal(string, ...any)               {}
+func (quietLogger) Debug(string, ...any)               {}
+func (quietLogger) InfoWithFields(string, log.Fields)  {}
+func (quietLogger) ErrorWithFields(string, log.Fields) {}
+func (quietLogger) PanicWithFields(string, log.Fields) {}
+func (quietLogger) FatalWithFields(string, log.Fields) {}
+func (quietLogger) DebugWithFields(string, log.Fields) {}
+
+type testQuery struct {
+	ID string `validate:"required"`
+}
+
+type testResult struct {
+	Name string `validate:"required"`
+}
+
+// testQueryHandler returns its result and error.
+type testQueryHandler struct {
+	result testResult
+	err    error
+}
+
+func (h testQueryHandler) Handle(context.Context, testQuery) (testResult, error) {
+	return h.result, h.err
+}
+
+// QueryValidationTestSuite is the test suite of the query validation decorator.
+type QueryValidationTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	validator *validator.Validate
+}
+
+func (s *QueryValidationTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.validator = validator.New()
+}
+
+// requireCode checks that the error is an application error with the code.
+func (s *QueryValidationTestSuite) requireCode(err error, code codes.Code) {
+	var appErr errors.AppError
+	s.Require().True(errors.AsAppError(err, &appErr), "Expected an application error")
+	s.Equal(code, appErr.Code(), "Expected a %s error", code)
+}
+
+// TestHandlerError tests that an error of the handler keeps its code although the empty
+// result it comes with is not a valid response.
+func (s *QueryValidationTestSuite) TestHandlerError() {
+	notFound := errors.NewHandlerError(errors.NewSimple("not found"), codes.NotFound)
+	handler := ApplyQueryDecorators[testQuery, testResult](
+		testQueryHandler{err: notFound}, quietLogger{}, s.validator,
+	)
+	_, err := handler.Handle(s.ctx, testQuery{ID: "1"})
+	s.requireCode(err, codes.NotFound)
+}
+
+// TestInvalidRequestAndResponse tests that an invalid request is an invalid argument and an
+// invalid response an internal error.
+func (s *QueryValidationTestSuite) TestInvalidRequestAndResponse() {
+	handler := ApplyQueryDecorators[testQuery, testResult](
+		testQueryHandler{}, quietLogger{}, s.validator,
+	)
+	_, err := handler.Handle(s.ctx, testQuery{})
+	s.requireCode(err, codes.InvalidArgument)
+
+	_, err = handler.Handle(s.ctx, testQuery{ID: "1"})
+	s.requireCode(err, codes.Internal)
+
+	handler = ApplyQueryDecorators[testQuery, testResult](
+		testQueryHandler{result: testResult{Name: "found"}}, quietLogger{}, s.validator,
+	)
+	res, err := handler.Handle(s.ctx, testQuery{ID: "1"})
+	s.Require().NoError(err)
+	s.Equal("found", res.Name)
+}
+
+func TestQueryValidationTestSuite(t *testing.T) {
+	suite.Run(t, &QueryValidationTestSuite{})
+}
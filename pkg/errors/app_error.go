@@ -22,6 +22,7 @@ const (
 
 	Authentication  Type = "Authentication"  // The authentication type is used for authentication related issues.
 	InvalidArgument Type = "InvalidArgument" // The Invalid argument type is used for invalid arguments.
+	Unavailable     Type = "Unavailable"     // The unavailable type is used when a dependency can not be called for now.
 )
 
 // AppError encapsulates error metadata using an underlying error,
@@ -104,3 +105,6 @@ func NewAuthenticationError(err error) AppError {
 func NewInvalidArgumentError(err error) AppError {
 	return NewCustom(err, InvalidArgument, codes.InvalidArgument)
 }
+func NewUnavailableError(err error) AppError {
+	return NewCustom(err, Unavailable, codes.Unavailable)
+}
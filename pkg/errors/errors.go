@@ -37,6 +37,26 @@ var (
 	ErrNoUpdate = NewSimple("nothing was updated")
 )
 
+// Pagination.
+var (
+	// ErrInvalidPageToken: The page token is malformed or its signature does not verify.
+	ErrInvalidPageToken = NewSimple("invalid page token")
+	// ErrPageTokenMismatch: The page token was issued for a different sort or filter.
+	ErrPageTokenMismatch = NewSimple("page token does not match the query")
+)
+
+// Resilience.
+var (
+	// ErrCircuitOpen: The dependency failed too often, calls are rejected until it recovers.
+	ErrCircuitOpen = NewSimple("circuit breaker is open")
+)
+
+// Transport.
+var (
+	// ErrValueOutOfRange: A request value does not fit the type it is converted into.
+	ErrValueOutOfRange = NewSimple("value is out of range")
+)
+
 /*****************
 *  Cryptography *
 *******************/
@@ -0,0 +1,14 @@
+package factory
+
+var _ Factory[any, any] = Identity[any]{}
+
+// Identity is a Factory for models that are stored as they are used, e.g. aggregation results.
+type Identity[Model any] struct{}
+
+func (Identity[Model]) ToDomain(model Model) (*Model, error) {
+	return &model, nil
+}
+
+func (Identity[Model]) ToDatabase(model Model) (*Model, error) {
+	return &model, nil
+}
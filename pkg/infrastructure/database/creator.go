@@ -9,4 +9,9 @@ var _ Creator = (*CreatorMongoImpl)(nil)
 type Creator interface {
 	CreateCollection(c context.Context, name string) error
 	CreateIndex(c context.Context, collection, key, index string) (string, error)
+	// CreateCompoundIndex creates an ascending index on the keys, in order.
+	CreateCompoundIndex(c context.Context, collection string, keys ...string) (string, error)
+	// CreateUniqueIndex creates an ascending index on the keys, in order, that rejects a
+	// second document with the same values.
+	CreateUniqueIndex(c context.Context, collection string, keys ...string) (string, error)
 }
@@ -48,3 +48,65 @@ func (cmi *CreatorMongoImpl) CreateIndex(c context.Context, collection, key, ind
 	}
 	return res, nil
 }
+
+func (cmi *CreatorMongoImpl) CreateCompoundIndex(c context.Context, collection string, keys ...string) (string, error) {
+	return cmi.createAscendingIndex(c, collection, keys, options.Index())
+}
+
+// Server error codes of an index that conflicts with an existing index on the same keys.
+const (
+	indexOptionsConflict  = 85
+	indexKeySpecsConflict = 86
+)
+
+// CreateUniqueIndex replaces an index on the same keys that is not unique, e.g. one created
+// by CreateCompoundIndex before the keys had to be unique.
+func (cmi *CreatorMongoImpl) CreateUniqueIndex(c context.Context, collection string, keys ...string) (string, error) {
+	res, err := cmi.createAscendingIndex(c, collection, keys, options.Index().SetUnique(true))
+	var appErr errors.AppError
+	if err == nil || !errors.AsAppError(err, &appErr) {
+		return res, err
+	}
+	cmdErr, ok := appErr.Unwrap().(mongo.CommandError)
+	if !ok || (cmdErr.Code != indexOptionsConflict && cmdErr.Code != indexKeySpecsConflict) {
+		return res, err
+	}
+	coll, err := cmi.connector.GetCollection(collection)
+	if err != nil {
+		return "", errors.NewDatabaseError(err)
+	}
+	name := ""
+	for i, key := range keys {
+		if i > 0 {
+			name += "_"
+		}
+		name += key + "_1"
+	}
+	cmi.log.Info("replacing the index %s of %s with a unique one", name, collection)
+	if _, err := coll.Indexes().DropOne(c, name); err != nil {
+		return "", errors.NewDatabaseError(err)
+	}
+	return cmi.createAscendingIndex(c, collection, keys, options.Index().SetUnique(true))
+}
+
+// createAscendingIndex creates an ascending index on the keys with the options.
+func (cmi *CreatorMongoImpl) createAscendingIndex(
+	c context.Context, collection string, keys []string, opts *options.IndexOptions,
+) (string, error) {
+	coll, err := cmi.connector.GetCollection(collection)
+	if err != nil {
+		return "", errors.NewDatabaseError(err)
+	}
+	indexKeys := bson.D{}
+	for _, key := range keys {
+		indexKeys = append(indexKeys, bson.E{Key: key, Value: 1})
+	}
+	res, err := coll.Indexes().CreateOne(c, mongo.IndexModel{
+		Keys:    indexKeys,
+		Options: opts,
+	})
+	if err != nil {
+		return "", errors.NewDatabaseError(err)
+	}
+	return res, nil
+}
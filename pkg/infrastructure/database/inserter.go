@@ -6,4 +6,7 @@ import (
 
 type Inserter[DomainModel any] interface {
 	InsertOne(c context.Context, data DomainModel) (string, error)
+	// InsertMany inserts every document in a single request and returns the number of
+	// documents inserted.
+	InsertMany(c context.Context, data []DomainModel) (int, error)
 }
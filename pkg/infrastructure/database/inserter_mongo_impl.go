@@ -64,3 +64,37 @@ func (imi *InserterMongoImpl[DatabaseModel, DomainModel]) InsertOne(
 	// Return the resulting ID.
 	return res.InsertedID.(primitive.ObjectID).Hex(), nil
 }
+
+// InsertMany: This will insert every document of type DomainModel in a single request and return how many were inserted.
+func (imi *InserterMongoImpl[DatabaseModel, DomainModel]) InsertMany(
+	c context.Context, data []DomainModel,
+) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	collection, collectionErr := imi.connector.GetCollection(imi.collection)
+	if collectionErr != nil {
+		imi.log.Error("Collection not found %s", imi.collection)
+		return 0, errors.ErrCollectionNotFound
+	}
+
+	// Map every document from domain to database before any is inserted.
+	documents := make([]interface{}, 0, len(data))
+	for _, document := range data {
+		database, mappingErr := imi.factory.ToDatabase(document)
+		if mappingErr != nil {
+			imi.log.Error("Failed To Map Document: %+v", document)
+			return 0, errors.NewInternalError(mappingErr)
+		}
+		documents = append(documents, database)
+	}
+
+	res, insertErr := collection.InsertMany(c, documents)
+	if insertErr != nil {
+		imi.log.Error("Error While inserting %+v", imi.collection)
+		return 0, insertErr
+	}
+	imi.log.Debug("Collection: %s Successfully Inserted %d Documents",
+		imi.collection, len(res.InsertedIDs))
+	return len(res.InsertedIDs), nil
+}
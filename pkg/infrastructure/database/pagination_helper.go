@@ -1,10 +1,40 @@
 package database
 
-import "go.mongodb.org/mongo-driver/bson"
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
 
-// PaginationHelper defines a contract for building a paginated Atlas Search filter.
+// SearchClause is a single Atlas Search operator on one path, e.g. equals, in or range.
+type SearchClause struct {
+	Operator string // Atlas Search operator name.
+	Path     string // Document path the operator is applied to.
+	Options  bson.D // Operator specific fields, e.g. value, query or gte.
+	Negate   bool   // Match the documents the operator does not match instead.
+}
+
+// FacetType is the kind of values of a facet.
+type FacetType string
+
+const (
+	FacetString  FacetType = "string"
+	FacetNumber  FacetType = "number"
+	FacetBoolean FacetType = "boolean"
+)
+
+// Facet is a document path to count the matched documents per value of.
+type Facet struct {
+	Name       string    // Name of the facet in the result.
+	Path       string    // Document path the values are read from.
+	Type       FacetType // Kind of the values.
+	Boundaries bson.A    // Bucket bounds of a number facet, each bucket is [bound, next bound).
+}
+
+// PaginationHelper defines a contract for building the stages of a paginated filter.
+// The search argument selects the page relative to the pagination token,
+// 0: no token, 1: the page after the token, 2: the page before the token.
 type PaginationHelper interface {
-	// PaginationHelper builds an Atlas Search filter including pagination settings.
+	// TextPaginationHelper builds a text filter including pagination settings.
 	TextPaginationHelper(
 		index string,
 		path string,
@@ -12,7 +42,21 @@ type PaginationHelper interface {
 		sort bson.D,
 		search uint8,
 		paginationToken string,
-	) (bson.D, error)
+	) (mongo.Pipeline, error)
+
+	// FuzzyTextPaginationHelper builds a fuzzy text filter over several paths, the matched
+	// passages of every document are added as its Highlights field. The documents must also
+	// satisfy every filter clause, which does not change the relevance. An empty sort orders
+	// by relevance where the database supports it.
+	FuzzyTextPaginationHelper(
+		index string,
+		paths []string,
+		value string,
+		filters []SearchClause,
+		sort bson.D,
+		search uint8,
+		paginationToken string,
+	) (mongo.Pipeline, error)
 
 	EqualsPaginationHelper(
 		index string,
@@ -21,5 +65,58 @@ type PaginationHelper interface {
 		sort bson.D,
 		search uint8,
 		paginationToken string,
-	) (bson.D, error)
+	) (mongo.Pipeline, error)
+
+	// GeoWithinPaginationHelper builds a geoWithin filter, the shape holds the box or
+	// geometry of the Atlas Search operator.
+	GeoWithinPaginationHelper(
+		index string,
+		path string,
+		shape bson.D,
+		sort bson.D,
+		search uint8,
+		paginationToken string,
+	) (mongo.Pipeline, error)
+
+	// CompoundPaginationHelper builds a filter that matches documents satisfying
+	// every clause.
+	CompoundPaginationHelper(
+		index string,
+		clauses []SearchClause,
+		sort bson.D,
+		search uint8,
+		paginationToken string,
+	) (mongo.Pipeline, error)
+
+	// PageStages returns the stages that follow the $limit stage, they put a page
+	// before the token back in sort order and add the PaginationToken field when the
+	// database provides it.
+	PageStages(sort bson.D, search uint8) mongo.Pipeline
+
+	// CountStages returns the stages counting the documents matched by filter stages built
+	// without a pagination token, the result is a single document with a count field.
+	CountStages(stages mongo.Pipeline) mongo.Pipeline
+
+	// FacetStages returns the stages counting the documents matched by filter stages built
+	// without a pagination token per facet value, the result is a single document with an
+	// array of {_id, count} buckets per facet name.
+	FacetStages(stages mongo.Pipeline, facets []Facet) mongo.Pipeline
+
+	// SuggestStages returns the stages collecting the distinct values of every facet path
+	// with a word starting with the prefix, case insensitively, of the documents satisfying
+	// every filter clause. The result is a single document with an array of
+	// {_id, value, count} suggestions per facet name, the most common first and at most
+	// limit each.
+	SuggestStages(
+		index string,
+		prefix string,
+		facets []Facet,
+		filters []SearchClause,
+		limit uint16,
+	) (mongo.Pipeline, error)
+
+	// PageToken returns the pagination token of a listed document, projected is the
+	// PaginationToken field read from the database and sortValues the values of the
+	// sort fields of the document.
+	PageToken(projected string, sortValues []interface{}, id string) (string, error)
 }
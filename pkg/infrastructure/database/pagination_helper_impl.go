@@ -1,6 +1,15 @@
 package database
 
-import "go.mongodb.org/mongo-driver/bson"
+import (
+	"regexp"
+	"strings"
+
+	"property-service/pkg/errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
 
 var _ PaginationHelper = (*PaginationHelperMongoImpl)(nil)
 
@@ -14,7 +23,7 @@ func (t *PaginationHelperMongoImpl) TextPaginationHelper(
 	sort bson.D, // changed to bson.D for ordered sort criteria
 	search uint8, // 0: no token, 1: searchAfter, 2: searchBefore
 	paginationToken string,
-) (bson.D, error) {
+) (mongo.Pipeline, error) {
 	// Build the inner $search stage as a bson.D to preserve order.
 	searchStage := bson.D{
 		{Key: "index", Value: index},
@@ -34,7 +43,56 @@ func (t *PaginationHelperMongoImpl) TextPaginationHelper(
 		}
 	}
 
-	return bson.D{{Key: "$search", Value: searchStage}}, nil
+	return mongo.Pipeline{{{Key: "$search", Value: searchStage}}}, nil
+}
+
+// FuzzyTextPaginationHelper is an implementation of PaginationHelper using the text operator
+// with fuzzy matching and highlighting, without a sort the documents are ordered by score.
+func (t *PaginationHelperMongoImpl) FuzzyTextPaginationHelper(
+	index string,
+	paths []string,
+	value string,
+	filters []SearchClause,
+	sort bson.D,
+	search uint8, // 0: no token, 1: searchAfter, 2: searchBefore
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	operator := bson.E{Key: "text", Value: bson.D{
+		{Key: "query", Value: value},
+		{Key: "path", Value: paths},
+		{Key: "fuzzy", Value: bson.D{{Key: "maxEdits", Value: 1}}},
+	}}
+	if len(filters) > 0 {
+		// The filter clauses of a compound operator are not scored.
+		operator = bson.E{Key: "compound", Value: bson.D{
+			{Key: "must", Value: bson.A{bson.D{operator}}},
+			{Key: "filter", Value: searchFilters(filters)},
+		}}
+	}
+	searchStage := bson.D{
+		{Key: "index", Value: index},
+		operator,
+		{Key: "highlight", Value: bson.D{{Key: "path", Value: paths}}},
+	}
+	if len(sort) > 0 {
+		searchStage = append(searchStage, bson.E{Key: "sort", Value: sort})
+	}
+
+	if paginationToken != "" {
+		switch search {
+		case 1:
+			searchStage = append(searchStage, bson.E{Key: "searchAfter", Value: paginationToken})
+		case 2:
+			searchStage = append(searchStage, bson.E{Key: "searchBefore", Value: paginationToken})
+		}
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$search", Value: searchStage}},
+		{{Key: "$addFields", Value: bson.D{
+			{Key: "Highlights", Value: bson.D{{Key: "$meta", Value: "searchHighlights"}}},
+		}}},
+	}, nil
 }
 
 // EqualsPaginationHelper is an implementation of PaginationHelper using the equals operator.
@@ -45,7 +103,7 @@ func (t *PaginationHelperMongoImpl) EqualsPaginationHelper(
 	sort bson.D,
 	search uint8, // 0: no token, 1: searchAfter, 2: searchBefore
 	paginationToken string,
-) (bson.D, error) {
+) (mongo.Pipeline, error) {
 	// Build the inner equals search stage as a bson.D.
 	searchStage := bson.D{
 		{Key: "index", Value: index},
@@ -65,5 +123,290 @@ func (t *PaginationHelperMongoImpl) EqualsPaginationHelper(
 		}
 	}
 
-	return bson.D{{Key: "$search", Value: searchStage}}, nil
+	return mongo.Pipeline{{{Key: "$search", Value: searchStage}}}, nil
+}
+
+// GeoWithinPaginationHelper is an implementation of PaginationHelper using the geoWithin operator.
+func (t *PaginationHelperMongoImpl) GeoWithinPaginationHelper(
+	index string,
+	path string,
+	shape bson.D, // box, circle or geometry
+	sort bson.D,
+	search uint8, // 0: no token, 1: searchAfter, 2: searchBefore
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	// Build the inner geoWithin search stage as a bson.D.
+	operator := append(bson.D{{Key: "path", Value: path}}, shape...)
+	searchStage := bson.D{
+		{Key: "index", Value: index},
+		{Key: "geoWithin", Value: operator},
+		{Key: "sort", Value: sort},
+	}
+
+	if paginationToken != "" {
+		switch search {
+		case 1:
+			searchStage = append(searchStage, bson.E{Key: "searchAfter", Value: paginationToken})
+		case 2:
+			searchStage = append(searchStage, bson.E{Key: "searchBefore", Value: paginationToken})
+		}
+	}
+
+	return mongo.Pipeline{{{Key: "$search", Value: searchStage}}}, nil
+}
+
+// CompoundPaginationHelper is an implementation of PaginationHelper using the compound operator,
+// every clause is added as a filter so they are combined with AND.
+func (t *PaginationHelperMongoImpl) CompoundPaginationHelper(
+	index string,
+	clauses []SearchClause,
+	sort bson.D,
+	search uint8, // 0: no token, 1: searchAfter, 2: searchBefore
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	if len(clauses) == 0 {
+		return nil, errors.New("compound search needs at least one clause")
+	}
+	searchStage := bson.D{
+		{Key: "index", Value: index},
+		{Key: "compound", Value: bson.D{
+			{Key: "filter", Value: searchFilters(clauses)},
+		}},
+		{Key: "sort", Value: sort},
+	}
+
+	if paginationToken != "" {
+		switch search {
+		case 1:
+			searchStage = append(searchStage, bson.E{Key: "searchAfter", Value: paginationToken})
+		case 2:
+			searchStage = append(searchStage, bson.E{Key: "searchBefore", Value: paginationToken})
+		}
+	}
+
+	return mongo.Pipeline{{{Key: "$search", Value: searchStage}}}, nil
+}
+
+// searchFilters converts the clauses into the operators of a compound filter, a negated
+// clause is a nested compound with the operator as its mustNot.
+func searchFilters(clauses []SearchClause) bson.A {
+	filters := make(bson.A, 0, len(clauses))
+	for _, clause := range clauses {
+		operator := append(bson.D{{Key: "path", Value: clause.Path}}, clause.Options...)
+		filter := bson.D{{Key: clause.Operator, Value: operator}}
+		if clause.Negate {
+			filter = bson.D{{Key: "compound", Value: bson.D{{Key: "mustNot", Value: bson.A{filter}}}}}
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// PageStages is an implementation of PaginationHelper, Atlas Search returns a page before
+// the token in reverse order and provides the token of every document.
+func (t *PaginationHelperMongoImpl) PageStages(sort bson.D, search uint8) mongo.Pipeline {
+	stages := mongo.Pipeline{}
+	switch {
+	case search == 2 && len(sort) > 0:
+		stages = append(stages, bson.D{{Key: "$sort", Value: sort}})
+	case search == 2:
+		// Without a sort the documents are in score order.
+		stages = append(stages,
+			bson.D{{Key: "$addFields", Value: bson.D{
+				{Key: "SearchScore", Value: bson.D{{Key: "$meta", Value: "searchScore"}}},
+			}}},
+			bson.D{{Key: "$sort", Value: bson.D{{Key: "SearchScore", Value: -1}}}},
+		)
+	}
+	return append(stages, bson.D{{Key: "$addFields", Value: bson.D{
+		{Key: "PaginationToken", Value: bson.D{{Key: "$meta", Value: "searchSequenceToken"}}},
+	}}})
+}
+
+// CountStages is an implementation of PaginationHelper, the $search stage is run as
+// $searchMeta with a total count.
+func (t *PaginationHelperMongoImpl) CountStages(stages mongo.Pipeline) mongo.Pipeline {
+	searchMeta := bson.D{}
+	for _, stage := range stages {
+		for _, e := range stage {
+			if e.Key != "$search" {
+				continue
+			}
+			searchStage, _ := e.Value.(bson.D)
+			for _, field := range searchStage {
+				if field.Key != "sort" && field.Key != "highlight" {
+					searchMeta = append(searchMeta, field)
+				}
+			}
+		}
+	}
+	searchMeta = append(searchMeta, bson.E{Key: "count", Value: bson.D{{Key: "type", Value: "total"}}})
+	return mongo.Pipeline{
+		bson.D{{Key: "$searchMeta", Value: searchMeta}},
+		bson.D{{Key: "$project", Value: bson.D{{Key: "count", Value: "$count.total"}}}},
+	}
+}
+
+// FacetStages is an implementation of PaginationHelper, string and number facets are
+// collected by the $search facet collector and read from $$SEARCH_META, boolean facets are
+// not supported by Atlas Search so they fall back to $group.
+func (t *PaginationHelperMongoImpl) FacetStages(stages mongo.Pipeline, facets []Facet) mongo.Pipeline {
+	index, operator := bson.D{}, bson.D{}
+	for _, stage := range stages {
+		for _, e := range stage {
+			if e.Key != "$search" {
+				continue
+			}
+			searchStage, _ := e.Value.(bson.D)
+			for _, field := range searchStage {
+				switch field.Key {
+				case "index":
+					index = append(index, field)
+				case "sort", "highlight", "searchAfter", "searchBefore":
+				default:
+					operator = append(operator, field)
+				}
+			}
+		}
+	}
+
+	collected := bson.D{}
+	grouped := bson.D{{Key: "meta", Value: bson.A{
+		bson.D{{Key: "$replaceWith", Value: "$$SEARCH_META"}},
+		bson.D{{Key: "$limit", Value: 1}},
+	}}}
+	result := bson.D{}
+	for _, facet := range facets {
+		switch facet.Type {
+		case FacetString:
+			collected = append(collected, bson.E{Key: facet.Name, Value: bson.D{
+				{Key: "type", Value: "string"},
+				{Key: "path", Value: facet.Path},
+			}})
+		case FacetNumber:
+			collected = append(collected, bson.E{Key: facet.Name, Value: bson.D{
+				{Key: "type", Value: "number"},
+				{Key: "path", Value: facet.Path},
+				{Key: "boundaries", Value: facet.Boundaries},
+			}})
+		default:
+			grouped = append(grouped, bson.E{Key: facet.Name, Value: groupFacet(facet)})
+			result = append(result, bson.E{Key: facet.Name, Value: "$" + facet.Name})
+			continue
+		}
+		result = append(result, bson.E{Key: facet.Name, Value: bson.D{
+			{Key: "$first", Value: "$meta.facet." + facet.Name + ".buckets"},
+		}})
+	}
+
+	searchStage := append(index, bson.E{Key: "facet", Value: bson.D{
+		{Key: "operator", Value: operator},
+		{Key: "facets", Value: collected},
+	}})
+	return mongo.Pipeline{
+		bson.D{{Key: "$search", Value: searchStage}},
+		bson.D{{Key: "$facet", Value: grouped}},
+		bson.D{{Key: "$project", Value: result}},
+	}
+}
+
+// PageToken is an implementation of PaginationHelper, the searchSequenceToken read from
+// the database is the token.
+func (t *PaginationHelperMongoImpl) PageToken(
+	projected string,
+	sortValues []interface{},
+	id string,
+) (string, error) {
+	return projected, nil
+}
+
+// SuggestStages is an implementation of PaginationHelper, the wildcard operator finds the
+// documents with a term starting with the first word of the prefix and every facet keeps
+// the values matching the whole prefix.
+func (t *PaginationHelperMongoImpl) SuggestStages(
+	index string,
+	prefix string,
+	facets []Facet,
+	filters []SearchClause,
+	limit uint16,
+) (mongo.Pipeline, error) {
+	words := strings.Fields(strings.ToLower(prefix))
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	should := make(bson.A, 0, len(facets))
+	for _, facet := range facets {
+		should = append(should, bson.D{{Key: "wildcard", Value: bson.D{
+			{Key: "query", Value: WildcardPrefix(words[0])},
+			{Key: "path", Value: facet.Path},
+			{Key: "allowAnalyzedField", Value: true},
+		}}})
+	}
+	compound := bson.D{
+		{Key: "should", Value: should},
+		{Key: "minimumShouldMatch", Value: 1},
+	}
+	if len(filters) > 0 {
+		compound = append(compound, bson.E{Key: "filter", Value: searchFilters(filters)})
+	}
+	return mongo.Pipeline{
+		bson.D{{Key: "$search", Value: bson.D{
+			{Key: "index", Value: index},
+			{Key: "compound", Value: compound},
+		}}},
+		bson.D{{Key: "$facet", Value: suggestFacets(prefix, facets, limit)}},
+	}, nil
+}
+
+// wildcardEscaper escapes the characters that have a meaning in an Atlas Search wildcard query.
+var wildcardEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+
+// WildcardPrefix returns the Atlas Search wildcard query matching the values starting with
+// the prefix, its wildcard characters are matched literally.
+func WildcardPrefix(prefix string) string {
+	return wildcardEscaper.Replace(prefix) + "*"
+}
+
+// suggestFacets returns the $facet stage value grouping the values of every facet path
+// with a word starting with the prefix, values differing only in case are one suggestion.
+func suggestFacets(prefix string, facets []Facet, limit uint16) bson.D {
+	pattern := suggestPattern(prefix)
+	suggested := bson.D{}
+	for _, facet := range facets {
+		suggested = append(suggested, bson.E{Key: facet.Name, Value: bson.A{
+			bson.D{{Key: "$match", Value: bson.D{{Key: facet.Path, Value: pattern}}}},
+			bson.D{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{{Key: "$toLower", Value: "$" + facet.Path}}},
+				{Key: "value", Value: bson.D{{Key: "$first", Value: "$" + facet.Path}}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}}},
+			bson.D{{Key: "$sort", Value: bson.D{
+				{Key: "count", Value: -1},
+				{Key: "_id", Value: 1},
+			}}},
+			bson.D{{Key: "$limit", Value: limit}},
+		}})
+	}
+	return suggested
+}
+
+// suggestPattern returns the case insensitive expression matching a value with a word
+// starting with the prefix.
+func suggestPattern(prefix string) primitive.Regex {
+	return primitive.Regex{Pattern: `(?:^|\s)` + regexp.QuoteMeta(strings.TrimSpace(prefix)), Options: "i"}
+}
+
+// groupFacet returns the $facet sub pipeline counting the documents per value of a facet,
+// the largest buckets first.
+func groupFacet(facet Facet) bson.A {
+	return bson.A{
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + facet.Path},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "count", Value: -1},
+			{Key: "_id", Value: 1},
+		}}},
+	}
 }
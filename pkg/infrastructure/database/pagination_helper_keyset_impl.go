@@ -0,0 +1,505 @@
+package database
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"property-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var _ PaginationHelper = (*KeysetPaginationHelperMongoImpl)(nil)
+
+// KeysetPaginationHelperMongoImpl is an implementation of PaginationHelper that uses normal
+// indexes, pages are read with $match and $sort on the sort fields and _id, and the token
+// holds the sort values and _id of the document at the edge of the page.
+type KeysetPaginationHelperMongoImpl struct{}
+
+// keysetToken is the content of a keyset pagination token.
+type keysetToken struct {
+	Values []interface{} `bson:"v"`
+	ID     uuid.UUID     `bson:"id"`
+}
+
+// TextPaginationHelper is an implementation of PaginationHelper matching the exact value.
+func (k *KeysetPaginationHelperMongoImpl) TextPaginationHelper(
+	index string,
+	path string,
+	value string,
+	sort bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	return k.stages(bson.D{{Key: path, Value: value}}, sort, search, paginationToken)
+}
+
+// FuzzyTextPaginationHelper is an implementation of PaginationHelper matching the value
+// case insensitively in any of the paths, fuzzy matching and relevance need Atlas Search so
+// the match is exact and an empty sort orders by _id. The first match in every path is
+// added as a highlight.
+func (k *KeysetPaginationHelperMongoImpl) FuzzyTextPaginationHelper(
+	index string,
+	paths []string,
+	value string,
+	filters []SearchClause,
+	sort bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	pattern := regexp.QuoteMeta(value)
+	matches := make(bson.A, 0, len(paths))
+	finds := make(bson.A, 0, len(paths))
+	for _, path := range paths {
+		matches = append(matches, bson.D{{Key: path, Value: primitive.Regex{Pattern: pattern, Options: "i"}}})
+		finds = append(finds, bson.D{
+			{Key: "path", Value: path},
+			{Key: "field", Value: "$" + path},
+			{Key: "match", Value: bson.D{{Key: "$regexFind", Value: bson.D{
+				{Key: "input", Value: "$" + path},
+				{Key: "regex", Value: pattern},
+				{Key: "options", Value: "i"},
+			}}}},
+		})
+	}
+	match := bson.D{{Key: "$or", Value: matches}}
+	if len(filters) > 0 {
+		clauses, err := keysetClauses(filters)
+		if err != nil {
+			return nil, err
+		}
+		match = bson.D{{Key: "$and", Value: append(bson.A{match}, clauses...)}}
+	}
+	stages, err := k.stages(match, sort, search, paginationToken)
+	if err != nil {
+		return nil, err
+	}
+	return append(stages, bson.D{{Key: "$addFields", Value: bson.D{
+		{Key: "Highlights", Value: keysetHighlights(finds)},
+	}}}), nil
+}
+
+// EqualsPaginationHelper is an implementation of PaginationHelper matching the exact value.
+func (k *KeysetPaginationHelperMongoImpl) EqualsPaginationHelper(
+	index string,
+	path string,
+	value string,
+	sort bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	return k.stages(bson.D{{Key: path, Value: value}}, sort, search, paginationToken)
+}
+
+// GeoWithinPaginationHelper is an implementation of PaginationHelper using $geoWithin,
+// a box shape is matched as the polygon through its corners.
+func (k *KeysetPaginationHelperMongoImpl) GeoWithinPaginationHelper(
+	index string,
+	path string,
+	shape bson.D,
+	sort bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	geometry, err := keysetGeometry(shape)
+	if err != nil {
+		return nil, err
+	}
+	match := bson.D{{Key: path, Value: bson.D{
+		{Key: "$geoWithin", Value: bson.D{{Key: "$geometry", Value: geometry}}},
+	}}}
+	return k.stages(match, sort, search, paginationToken)
+}
+
+// CompoundPaginationHelper is an implementation of PaginationHelper, every clause is
+// translated into its query operator equivalent and combined with AND.
+func (k *KeysetPaginationHelperMongoImpl) CompoundPaginationHelper(
+	index string,
+	clauses []SearchClause,
+	sort bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	if len(clauses) == 0 {
+		return nil, errors.New("compound search needs at least one clause")
+	}
+	filters, err := keysetClauses(clauses)
+	if err != nil {
+		return nil, err
+	}
+	return k.stages(bson.D{{Key: "$and", Value: filters}}, sort, search, paginationToken)
+}
+
+// PageStages is an implementation of PaginationHelper, a page before the token is read in
+// reverse order so it is sorted again.
+func (k *KeysetPaginationHelperMongoImpl) PageStages(sort bson.D, search uint8) mongo.Pipeline {
+	if search != 2 {
+		return mongo.Pipeline{}
+	}
+	return mongo.Pipeline{bson.D{{Key: "$sort", Value: keysetSort(sort, false)}}}
+}
+
+// CountStages is an implementation of PaginationHelper, the $match stage is followed by $count.
+func (k *KeysetPaginationHelperMongoImpl) CountStages(stages mongo.Pipeline) mongo.Pipeline {
+	count := mongo.Pipeline{}
+	for _, stage := range stages {
+		if len(stage) > 0 && stage[0].Key == "$match" {
+			count = append(count, stage)
+		}
+	}
+	return append(count, bson.D{{Key: "$count", Value: "count"}})
+}
+
+// SuggestStages is an implementation of PaginationHelper, the documents are matched by a
+// regular expression on every facet path.
+func (k *KeysetPaginationHelperMongoImpl) SuggestStages(
+	index string,
+	prefix string,
+	facets []Facet,
+	filters []SearchClause,
+	limit uint16,
+) (mongo.Pipeline, error) {
+	pattern := suggestPattern(prefix)
+	matches := make(bson.A, 0, len(facets))
+	for _, facet := range facets {
+		matches = append(matches, bson.D{{Key: facet.Path, Value: pattern}})
+	}
+	match := bson.D{{Key: "$or", Value: matches}}
+	if len(filters) > 0 {
+		clauses, err := keysetClauses(filters)
+		if err != nil {
+			return nil, err
+		}
+		match = bson.D{{Key: "$and", Value: append(bson.A{match}, clauses...)}}
+	}
+	return mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$facet", Value: suggestFacets(prefix, facets, limit)}},
+	}, nil
+}
+
+// FacetStages is an implementation of PaginationHelper, every facet is a $group in a $facet
+// stage.
+func (k *KeysetPaginationHelperMongoImpl) FacetStages(stages mongo.Pipeline, facets []Facet) mongo.Pipeline {
+	grouped := bson.D{}
+	for _, facet := range facets {
+		grouped = append(grouped, bson.E{Key: facet.Name, Value: groupFacet(facet)})
+	}
+	pipeline := mongo.Pipeline{}
+	for _, stage := range stages {
+		if len(stage) > 0 && stage[0].Key == "$match" {
+			pipeline = append(pipeline, stage)
+		}
+	}
+	return append(pipeline, bson.D{{Key: "$facet", Value: grouped}})
+}
+
+// PageToken is an implementation of PaginationHelper, the token is the URL safe base64
+// encoding of the sort values and id of the document.
+func (k *KeysetPaginationHelperMongoImpl) PageToken(
+	projected string,
+	sortValues []interface{},
+	id string,
+) (string, error) {
+	uid, err := StringToID(id)
+	if err != nil {
+		return "", errors.NewInternalError(err)
+	}
+	raw, err := bson.Marshal(keysetToken{Values: sortValues, ID: uid})
+	if err != nil {
+		return "", errors.NewInternalError(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// stages builds the $match and $sort stages of a page, the match is narrowed to the
+// documents after or before the token.
+func (k *KeysetPaginationHelperMongoImpl) stages(
+	match bson.D,
+	sort bson.D,
+	search uint8,
+	paginationToken string,
+) (mongo.Pipeline, error) {
+	keys := keysetSort(sort, search == 2)
+	if paginationToken != "" && search != 0 {
+		cursor, err := keysetCursor(keys, paginationToken)
+		if err != nil {
+			return nil, err
+		}
+		match = bson.D{{Key: "$and", Value: bson.A{match, cursor}}}
+	}
+	return mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$sort", Value: keys}},
+	}, nil
+}
+
+// keysetSort returns the sort with _id as the final tie breaker, a sort value of 1 is
+// ascending and any other value descending.
+func keysetSort(sort bson.D, reverse bool) bson.D {
+	keys := make(bson.D, 0, len(sort)+1)
+	idDirection := 1
+	if reverse {
+		idDirection = -1
+	}
+	for _, field := range sort {
+		direction := -1
+		if keysetAscending(field.Value) {
+			direction = 1
+		}
+		if reverse {
+			direction = -direction
+		}
+		if field.Key == "_id" {
+			idDirection = direction
+			continue
+		}
+		keys = append(keys, bson.E{Key: field.Key, Value: direction})
+	}
+	return append(keys, bson.E{Key: "_id", Value: idDirection})
+}
+
+// keysetAscending reports whether a sort value is 1.
+func keysetAscending(value interface{}) bool {
+	switch v := value.(type) {
+	case int:
+		return v == 1
+	case int32:
+		return v == 1
+	case int64:
+		return v == 1
+	case uint8:
+		return v == 1
+	case uint16:
+		return v == 1
+	}
+	return false
+}
+
+// keysetCursor builds the filter of the documents that come after the token in the order
+// of keys, e.g. (a > x) or (a = x and _id > y). A missing value sorts before any other, so
+// after a missing a come the documents that have one, (a != null), and none in descending order,
+// where the missing values come after every other, (a < x or a = null).
+func keysetCursor(keys bson.D, paginationToken string) (bson.D, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(paginationToken)
+	if err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	var token keysetToken
+	if err := bson.Unmarshal(raw, &token); err != nil {
+		return nil, errors.NewInvalidArgumentError(err)
+	}
+	if len(token.Values) != len(keys)-1 {
+		return nil, errors.NewInvalidArgumentError(
+			errors.New("pagination token does not match the sort"),
+		)
+	}
+	values := append(token.Values, token.ID)
+
+	or := make(bson.A, 0, len(keys))
+	for i, key := range keys {
+		condition := bson.D{}
+		for j := 0; j < i; j++ {
+			condition = append(condition, bson.E{Key: keys[j].Key, Value: values[j]})
+		}
+		operator := "$lt"
+		if key.Value == 1 {
+			operator = "$gt"
+		}
+		if values[i] == nil {
+			if operator == "$lt" {
+				continue
+			}
+			operator = "$ne"
+		}
+		bound := bson.D{{Key: key.Key, Value: bson.D{{Key: operator, Value: values[i]}}}}
+		if operator == "$lt" {
+			condition = append(condition, bson.E{Key: "$or", Value: bson.A{
+				bound,
+				bson.D{{Key: key.Key, Value: nil}},
+			}})
+		} else {
+			condition = append(condition, bound...)
+		}
+		or = append(or, condition)
+	}
+	return bson.D{{Key: "$or", Value: or}}, nil
+}
+
+// keysetGeometry converts the shape of an Atlas Search geoWithin operator into a GeoJSON geometry.
+func keysetGeometry(shape bson.D) (interface{}, error) {
+	for _, e := range shape {
+		switch e.Key {
+		case "geometry":
+			return e.Value, nil
+		case "box":
+			box, ok := e.Value.(bson.D)
+			if !ok {
+				return nil, errors.New("geoWithin box must be a document")
+			}
+			var bottomLeft, topRight [2]float64
+			for _, corner := range box {
+				point, err := keysetPoint(corner.Value)
+				if err != nil {
+					return nil, err
+				}
+				switch corner.Key {
+				case "bottomLeft":
+					bottomLeft = point
+				case "topRight":
+					topRight = point
+				}
+			}
+			return bson.D{
+				{Key: "type", Value: "Polygon"},
+				{Key: "coordinates", Value: [][][2]float64{{
+					bottomLeft,
+					{topRight[0], bottomLeft[1]},
+					topRight,
+					{bottomLeft[0], topRight[1]},
+					bottomLeft,
+				}}},
+			}, nil
+		}
+	}
+	return nil, errors.New("geoWithin needs a box or geometry shape")
+}
+
+// keysetPoint returns the coordinates of a GeoJSON point document.
+func keysetPoint(value interface{}) ([2]float64, error) {
+	point, ok := value.(bson.D)
+	if ok {
+		for _, e := range point {
+			if e.Key != "coordinates" {
+				continue
+			}
+			switch coordinates := e.Value.(type) {
+			case [2]float64:
+				return coordinates, nil
+			case []float64:
+				if len(coordinates) == 2 {
+					return [2]float64{coordinates[0], coordinates[1]}, nil
+				}
+			}
+		}
+	}
+	return [2]float64{}, errors.New("geoWithin box corners must be points")
+}
+
+// keysetClauses translates every clause into its query operator equivalent, a negated clause
+// is wrapped in $nor.
+func keysetClauses(clauses []SearchClause) (bson.A, error) {
+	filters := make(bson.A, 0, len(clauses))
+	for _, clause := range clauses {
+		filter, err := keysetClause(clause)
+		if err != nil {
+			return nil, err
+		}
+		if clause.Negate {
+			filter = bson.D{{Key: "$nor", Value: bson.A{filter}}}
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// keysetClause converts an Atlas Search clause into a query filter on the same path.
+func keysetClause(clause SearchClause) (bson.D, error) {
+	options := clause.Options.Map()
+	switch clause.Operator {
+	case "equals", "phrase", "text":
+		value, ok := options["value"]
+		if !ok {
+			value = options["query"]
+		}
+		return bson.D{{Key: clause.Path, Value: value}}, nil
+	case "in":
+		return bson.D{{Key: clause.Path, Value: bson.D{{Key: "$in", Value: options["value"]}}}}, nil
+	case "range":
+		bounds := bson.D{}
+		for _, e := range clause.Options {
+			bounds = append(bounds, bson.E{Key: "$" + e.Key, Value: e.Value})
+		}
+		return bson.D{{Key: clause.Path, Value: bounds}}, nil
+	case "wildcard":
+		query, _ := options["query"].(string)
+		return bson.D{{Key: clause.Path, Value: primitive.Regex{Pattern: wildcardPattern(query)}}}, nil
+	case "exists":
+		return bson.D{{Key: clause.Path, Value: bson.D{{Key: "$exists", Value: true}}}}, nil
+	case "geoWithin":
+		geometry, err := keysetGeometry(clause.Options)
+		if err != nil {
+			return nil, err
+		}
+		return bson.D{{Key: clause.Path, Value: bson.D{
+			{Key: "$geoWithin", Value: bson.D{{Key: "$geometry", Value: geometry}}},
+		}}}, nil
+	}
+	return nil, errors.New("unsupported search operator: " + clause.Operator)
+}
+
+// wildcardPattern converts an Atlas Search wildcard query into an anchored regular expression.
+func wildcardPattern(query string) string {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	escaped := false
+	for _, r := range query {
+		switch {
+		case escaped:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			pattern.WriteString(".*")
+		case r == '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+	// A trailing .* is dropped so a prefix query can use the index.
+	return strings.TrimSuffix(pattern.String(), ".*$")
+}
+
+// keysetHighlights builds the expression splitting the first match of every path into the
+// text before it, the hit and the text after it, the paths without a match are left out.
+func keysetHighlights(finds bson.A) bson.D {
+	return bson.D{{Key: "$map", Value: bson.D{
+		{Key: "input", Value: bson.D{{Key: "$filter", Value: bson.D{
+			{Key: "input", Value: finds},
+			{Key: "cond", Value: bson.D{{Key: "$ne", Value: bson.A{"$$this.match", nil}}}},
+		}}}},
+		{Key: "in", Value: bson.D{
+			{Key: "path", Value: "$$this.path"},
+			{Key: "texts", Value: bson.A{
+				bson.D{
+					{Key: "value", Value: bson.D{{Key: "$substrCP", Value: bson.A{
+						"$$this.field", 0, "$$this.match.idx",
+					}}}},
+					{Key: "type", Value: "text"},
+				},
+				bson.D{
+					{Key: "value", Value: "$$this.match.match"},
+					{Key: "type", Value: "hit"},
+				},
+				bson.D{
+					{Key: "value", Value: bson.D{{Key: "$substrCP", Value: bson.A{
+						"$$this.field",
+						bson.D{{Key: "$add", Value: bson.A{
+							"$$this.match.idx",
+							bson.D{{Key: "$strLenCP", Value: "$$this.match.match"}},
+						}}},
+						bson.D{{Key: "$strLenCP", Value: "$$this.field"}},
+					}}}},
+					{Key: "type", Value: "text"},
+				},
+			}},
+		}},
+	}}}
+}
@@ -7,4 +7,7 @@ import (
 type Remover[Filter any] interface {
 	DeleteOne(c context.Context, filter Filter) (int64, error)
 	DeleteOneByID(c context.Context, ID string) (int64, error)
+	// DeleteMany deletes every document matching the filter and returns the number of
+	// documents deleted.
+	DeleteMany(c context.Context, filter Filter) (int64, error)
 }
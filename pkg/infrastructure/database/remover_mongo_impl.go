@@ -49,6 +49,21 @@ func (rmi *RemoverMongoImpl[Filter]) DeleteOne(
 	return deleteResult.DeletedCount, deleteErr
 }
 
+func (rmi *RemoverMongoImpl[Filter]) DeleteMany(
+	c context.Context, filter Filter,
+) (int64, error) {
+	collection, collectionErr := rmi.connector.GetCollection(rmi.collection)
+	if collectionErr != nil {
+		rmi.log.Error("Collection not found %s", rmi.collection)
+		return 0, errors.ErrCollectionNotFound
+	}
+	deleteResult, deleteErr := collection.DeleteMany(c, filter)
+	if deleteErr != nil {
+		return 0, deleteErr
+	}
+	return deleteResult.DeletedCount, nil
+}
+
 func (rmi *RemoverMongoImpl[Filter]) DeleteOneByID(
 	c context.Context, id string,
 ) (int64, error) {
@@ -17,6 +17,14 @@ type Updater[Filter, Partial any, DomainModel any] interface {
 		data Partial,
 	) error
 
+	// UpdateMany updates every document matching the filter and returns the number of
+	// documents modified.
+	UpdateMany(
+		c context.Context,
+		filter Filter,
+		data Partial,
+	) (int64, error)
+
 	ReplaceOne(
 		c context.Context,
 		filter Filter,
@@ -28,4 +36,13 @@ type Updater[Filter, Partial any, DomainModel any] interface {
 		filter Filter,
 		data Partial,
 	) (*DomainModel, error)
+
+	// UpsertAndFind updates the document matching the filter, or inserts one made of the
+	// filter and the update when none matches, and returns the document as it was before the
+	// update, nil when it was inserted.
+	UpsertAndFind(
+		c context.Context,
+		filter Filter,
+		data Partial,
+	) (*DomainModel, error)
 }
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 var _ Updater[bson.M, bson.M, any] = (*UpdaterMongoImpl[bson.M, bson.M, any, any])(nil)
@@ -88,6 +89,26 @@ func (fmi *UpdaterMongoImpl[Filter, Partial, DomainModel, DatabaseModel]) Update
 	)
 }
 
+func (fmi *UpdaterMongoImpl[Filter, Partial, DomainModel, DatabaseModel]) UpdateMany(
+	c context.Context, filter Filter, data Partial,
+) (int64, error) {
+	collection, err := fmi.connector.GetCollection(
+		fmi.collection,
+	)
+	if err != nil {
+		return 0, err
+	}
+	res, err := collection.UpdateMany(
+		c,
+		bson.M(filter),
+		data,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
 func (fmi *UpdaterMongoImpl[
 	Filter, Partial, DomainModel, DatabaseModel,
 ]) ReplaceOne(
@@ -153,3 +174,44 @@ func (fmi *UpdaterMongoImpl[
 		dbModel,
 	)
 }
+
+func (fmi *UpdaterMongoImpl[
+	Filter,
+	Partial,
+	DomainModel,
+	DatabaseModel,
+]) UpsertAndFind(
+	c context.Context,
+
+	filter Filter,
+	data Partial,
+) (*DomainModel, error) {
+	collection, err := fmi.connector.GetCollection(
+		fmi.collection,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Upsert the model, the document before the update is returned.
+	result := collection.FindOneAndUpdate(
+		c,
+		filter,
+		data,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before),
+	)
+
+	// Decode the database result, there is none when the model was inserted.
+	var dbModel DatabaseModel
+	findErr := result.Decode(&dbModel)
+	if findErr == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if findErr != nil {
+		return nil, findErr
+	}
+
+	// Return the domain model.
+	return fmi.factory.ToDomain(
+		dbModel,
+	)
+}
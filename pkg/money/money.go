@@ -0,0 +1,26 @@
+// Package money holds amounts of money in the minor units of their ISO 4217 currency, so
+// prices are compared and summed without floating point rounding.
+package money
+
+import "strings"
+
+// Money : an amount in the minor units of its currency, e.g. 125000 GBP is £1,250.00.
+type Money struct {
+	Amount   int64  `bson:"Amount" json:"amount" validate:"gte=0"`
+	Currency string `bson:"Currency" json:"currency" validate:"required,iso4217"` // ISO 4217 code, e.g. "GBP".
+}
+
+// New returns the amount in the currency, the currency code is put in upper case.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: NormaliseCurrency(currency)}
+}
+
+// NormaliseCurrency returns a currency code trimmed and in upper case, e.g. " gbp" is "GBP".
+func NormaliseCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// IsZero reports whether the money has neither an amount nor a currency.
+func (m Money) IsZero() bool {
+	return m == Money{}
+}
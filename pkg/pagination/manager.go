@@ -0,0 +1,12 @@
+// Package pagination provides opaque, tamper-evident page tokens for list queries.
+package pagination
+
+// Manager encodes and decodes page tokens, a decoded token is guaranteed to have been
+// encoded by the manager.
+type Manager interface {
+	// Encode a token into an opaque page token.
+	Encode(token Token) (string, error)
+
+	// Decode a page token, verifying it has not been altered.
+	Decode(pageToken string) (*Token, error)
+}
@@ -0,0 +1,58 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"property-service/pkg/crypto/signing"
+	"property-service/pkg/errors"
+)
+
+var _ Manager = (*ManagerED25519Impl)(nil)
+
+// ManagerED25519Impl : A page token manager that signs the tokens with an Ed25519 key pair,
+// a page token is the base64 encoded token and its signature separated by a dot.
+type ManagerED25519Impl struct {
+	keys signing.Ed25519KeyPair
+}
+
+// NewED25519Manager : initialises the page token manager.
+func NewED25519Manager(keys signing.Ed25519KeyPair) *ManagerED25519Impl {
+	return &ManagerED25519Impl{
+		keys: keys,
+	}
+}
+
+// Encode : Signs and encodes a token.
+func (m *ManagerED25519Impl) Encode(token Token) (string, error) {
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return "", errors.NewInternalError(err)
+	}
+	signature := m.keys.SignMessage(payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Decode : Verifies and decodes a page token.
+func (m *ManagerED25519Impl) Decode(pageToken string) (*Token, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(pageToken, ".")
+	if !found {
+		return nil, errors.NewInvalidArgumentError(errors.ErrInvalidPageToken)
+	}
+	payload, payloadErr := base64.RawURLEncoding.DecodeString(encodedPayload)
+	signature, signatureErr := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if payloadErr != nil || signatureErr != nil || !m.keys.VerifySignature(payload, signature) {
+		return nil, errors.NewInvalidArgumentError(errors.ErrInvalidPageToken)
+	}
+
+	var token Token
+	if err := json.Unmarshal(payload, &token); err != nil {
+		return nil, errors.NewInvalidArgumentError(errors.ErrInvalidPageToken)
+	}
+	if token.Direction != After && token.Direction != Before {
+		return nil, errors.NewInvalidArgumentError(errors.ErrInvalidPageToken)
+	}
+	return &token, nil
+}
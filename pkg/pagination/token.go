@@ -0,0 +1,34 @@
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+
+	"property-service/pkg/errors"
+)
+
+// Direction of a page relative to the cursor of its token.
+const (
+	After  uint8 = 1 // The page after the cursor.
+	Before uint8 = 2 // The page before the cursor.
+)
+
+// Token : The content of a page token, it ties the cursor to the query it was issued for.
+type Token struct {
+	Cursor    string `json:"c"` // Database cursor of the document at the edge of the page.
+	Direction uint8  `json:"d"` // After or Before.
+	Sort      string `json:"s"` // Sort of the list.
+	Filter    string `json:"f"` // Fingerprint of the list filter.
+}
+
+// Fingerprint returns a short digest of a filter, used to check a token is reused with the
+// same filter.
+func Fingerprint(filter any) (string, error) {
+	raw, err := json.Marshal(filter)
+	if err != nil {
+		return "", errors.NewInternalError(err)
+	}
+	sum := sha256.Sum256(raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}